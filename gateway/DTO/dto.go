@@ -9,32 +9,316 @@ type (
 		UserID     uint
 		TrainID    uint
 		SeatNumber uint
+		Class      string
 		TravelDetailDTO
+		ExpiresAt        time.Time
+		CanceledAt       *time.Time
+		Price            int64
+		Currency         string
+		PaymentStatus    string
+		Status           string
+		History          []TicketHistoryDTO
+		BookingReference string
+		Passenger        *PassengerDTO
+	}
+
+	// TicketFilterDTO narrows a ticket listing, empty fields match every
+	// ticket. DepartureTo is exclusive.
+	TicketFilterDTO struct {
+		Timeframe     string
+		Statuses      []string
+		DepartureFrom *time.Time
+		DepartureTo   *time.Time
+		Origin        string
+		Destination   string
+		Canceled      *bool
+		Sort          string
+		Cursor        string
+		Limit         uint
+	}
+
+	TicketPageDTO struct {
+		Tickets    []TicketDTO
+		NextCursor string
+	}
+
+	PassengerDTO struct {
+		FirstName      string
+		LastName       string
+		DateOfBirth    *time.Time
+		DocumentType   string
+		DocumentNumber string
+		Category       string
+	}
+
+	BookingDTO struct {
+		ID        uint
+		Reference string
+		UserID    uint
+		TrainID   uint
+		Tickets   []TicketDTO
+		CreatedAt time.Time
+	}
+
+	CartLegDTO struct {
+		ID           uint
+		TrainID      uint
+		TicketNumber uint
+	}
+
+	CartDTO struct {
+		ID               uint
+		UserID           uint
+		Status           string
+		BookingReference string
+		Legs             []CartLegDTO
+		CreatedAt        time.Time
+	}
+
+	BookingCancellationDTO struct {
+		Reference     string
+		Cancellations []CancellationDTO
+	}
+
+	WaitlistEntryDTO struct {
+		ID             uint
+		UserID         uint
+		TrainID        uint
+		TicketNumber   uint
+		Status         string
+		Position       uint
+		HoldID         uint
+		OfferExpiresAt *time.Time
+	}
+
+	TicketTransferDTO struct {
+		ID         uint
+		TicketID   uint
+		FromUserID uint
+		ToUserID   uint
+		Status     string
 		ExpiresAt  time.Time
-		CanceledAt *time.Time
+		AcceptedAt *time.Time
+		CreatedAt  time.Time
+	}
+
+	FareDTO struct {
+		SeatID        uint
+		SeatNumber    uint
+		Class         string
+		Category      string
+		BaseFare      int64
+		Surcharge     int64
+		Discount      int64
+		PromoDiscount int64
+		Price         int64
+	}
+
+	QuoteDTO struct {
+		TrainID       uint
+		Fares         []FareDTO
+		PromoCode     string
+		PromoDiscount int64
+		Total         int64
+		Currency      string
+	}
+
+	PromoCodeDTO struct {
+		ID             uint
+		Code           string
+		DiscountType   string
+		Value          int64
+		MaxUses        uint
+		MaxUsesPerUser uint
+		Uses           uint
+		ValidFrom      time.Time
+		ValidUntil     *time.Time
+		Active         bool
+		Restrictions   []PromoRestrictionDTO
+		CreatedAt      time.Time
+	}
+
+	PromoRestrictionDTO struct {
+		TrainID     uint
+		Origin      string
+		Destination string
+	}
+
+	ExchangeDTO struct {
+		OldTicket      TicketDTO
+		NewTicket      TicketDTO
+		FareDifference int64
+		Currency       string
+	}
+
+	CancellationDTO struct {
+		TicketID     uint
+		RefundAmount int64
+		Currency     string
+	}
+
+	TicketHistoryDTO struct {
+		FromStatus string
+		ToStatus   string
+		Reason     string
+		CreatedAt  time.Time
 	}
 
 	TrainDTO struct {
-		ID       uint
-		Name     string
-		Capacity uint
-		IsFull   bool
-		Seats    []SeatDTO
+		ID             uint
+		Name           string
+		Capacity       uint
+		AvailableSeats uint
+		IsFull         bool
+		Seats          []SeatDTO
+		Coaches        []CoachDTO
+		Stops          []StopDTO
+		TimetableID    uint       // Timetable the train is a run of, 0 when created by hand
+		ServiceDate    *time.Time // Date of the run
 		TravelDetailDTO
 	}
 
+	// CoachDTO is a carriage of a train, its seats sit on a grid of Rows by
+	// Columns cells.
+	CoachDTO struct {
+		ID      uint
+		Number  uint
+		Class   string
+		Rows    uint
+		Columns uint
+	}
+
+	// LayoutTemplateDTO is a reusable line-up of coaches trains are created
+	// from.
+	LayoutTemplateDTO struct {
+		ID      uint
+		Name    string
+		Coaches []CoachTemplateDTO
+	}
+
+	CoachTemplateDTO struct {
+		Number  uint
+		Class   string
+		Rows    uint
+		Columns uint
+		Seats   []SeatTemplateDTO
+	}
+
+	// SeatTemplateDTO places a seat on a cell of the coach grid, rows and
+	// columns counted from 1.
+	SeatTemplateDTO struct {
+		Row        uint
+		Column     uint
+		Attributes []string
+	}
+
+	// StopDTO is a station on the route of a train, numbered from 0.
+	StopDTO struct {
+		Sequence      uint
+		Station       string
+		ArrivalTime   time.Time
+		DepartureTime time.Time
+	}
+
+	// TimetableDTO is a service pattern running the same route on its
+	// weekdays, lower case three letter names, between ValidFrom and
+	// ValidUntil. Exceptions win over both.
+	TimetableDTO struct {
+		ID               uint
+		Name             string
+		Capacity         uint
+		LayoutTemplateID uint
+		Price            int64
+		TimeZone         string
+		Weekdays         []string
+		ValidFrom        time.Time
+		ValidUntil       time.Time
+		Stops            []TimetableStopDTO
+		Exceptions       []TimetableExceptionDTO
+	}
+
+	// TimetableStopDTO times are minutes from midnight of the service day.
+	TimetableStopDTO struct {
+		Sequence        uint
+		Station         string
+		ArrivalMinute   uint
+		DepartureMinute uint
+	}
+
+	// TimetableExceptionDTO adds a run on a date, kind extra_service, or
+	// removes it, kind no_service.
+	TimetableExceptionDTO struct {
+		Date time.Time
+		Kind string
+	}
+
 	TravelDetailDTO struct {
 		Origin        string
 		Destination   string
 		DepartureTime time.Time
 		ArrivalTime   time.Time
+		Price         int64
+	}
+
+	// TrainFilterDTO narrows a train search, every field that is set must
+	// match. The To bounds of the time windows are exclusive.
+	TrainFilterDTO struct {
+		Name              string
+		Origin            string
+		Destination       string
+		MinAvailableSeats uint
+		Class             string
+		SeatAttributes    []string
+		DepartureFrom     *time.Time
+		DepartureTo       *time.Time
+		ArrivalFrom       *time.Time
+		ArrivalTo         *time.Time
+		Sort              string
+		Cursor            string
+		Limit             uint
+	}
+
+	TrainPageDTO struct {
+		Trains     []TrainDTO
+		NextCursor string
 	}
 
 	SeatDTO struct {
-		ID      uint
-		Number  uint
-		TrainID uint
-		UserID  uint
+		ID         uint
+		Number     uint
+		TrainID    uint
+		UserID     uint
+		Class      string
+		CoachID    uint
+		Row        uint
+		Column     uint
+		Attributes []string
+		State      string // free, held or booked
+		Booked     bool
+		Held       bool
+	}
+
+	// SeatMapDTO lays out every seat of a train in its coaches, with the
+	// state of each seat on the part of the route the train is shown for.
+	// Seats of trains without coaches are in Seats.
+	SeatMapDTO struct {
+		Train   TrainDTO
+		Coaches []SeatMapCoachDTO
+		Seats   []SeatDTO
+	}
+
+	SeatMapCoachDTO struct {
+		CoachDTO
+		Seats []SeatDTO
+	}
+
+	SeatHoldDTO struct {
+		ID        uint
+		UserID    uint
+		TrainID   uint
+		Status    string
+		ExpiresAt time.Time
+		Seats     []SeatDTO
 	}
 
 	UserDTO struct {
@@ -1,7 +1,9 @@
 package nats
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	dto "gateway/DTO"
 	"gateway/utils"
 	"strconv"
@@ -22,24 +24,68 @@ const (
 	SubjectRequestListTrains              = "request.list.trains"
 	SubjectRequestListTrainsFiltered      = "request.list.trains.filtered"
 	SubjectRequestGetTrainByID            = "request.get.train.byID"
+	SubjectRequestGetTrainSegment         = "request.get.train.segment"
 	SubjectRequestCreateTrain             = "request.create.train"
 	SubjectRequestUpdateTrain             = "request.update.train"
 	SubjectRequestUpdateTrainTravelDetail = "request.update.train.travel.detail"
+	SubjectRequestUpdateTrainStops        = "request.update.train.stops"
 	SubjectRequestDeleteTrainByID         = "request.delete.train.byID"
 
+	SubjectRequestCreateTimetable  = "request.create.timetable"
+	SubjectRequestGetTimetableByID = "request.get.timetable.byID"
+	SubjectRequestListTimetables   = "request.list.timetables"
+	SubjectRequestUpdateTimetable  = "request.update.timetable"
+
+	SubjectRequestCreateLayoutTemplate  = "request.create.layoutTemplate"
+	SubjectRequestGetLayoutTemplateByID = "request.get.layoutTemplate.byID"
+	SubjectRequestListLayoutTemplates   = "request.list.layoutTemplates"
+
 	SubjectRequestGetSeatByID        = "request.get.seat.byID"
 	SubjectRequestListSeatsByTrainID = "request.list.seats.byTrainID"
+	SubjectRequestGetSeatMap         = "request.get.train.seatmap"
 	SubjectRequestCreateSeat         = "request.create.seat"
 	SubjectRequestUpdateSeatNumber   = "request.update.seat.number"
 	SubjectRequestDeleteSeatByID     = "request.delete.seat.byID"
 
 	SubjectRequestGetTicketByID        = "request.get.ticket.byID"
+	SubjectRequestQuoteTicket          = "request.quote.ticket"
 	SubjectRequestBookTicket           = "request.book.ticket"
 	SubjectRequestCancelTicket         = "request.cancel.ticket"
 	SubjectRequestListTicketsByUserID  = "request.list.tickets.byUserID"
 	SubjectRequestListTicketsByTrainID = "request.list.tickets.byTrainID"
+	SubjectRequestHoldTicket           = "request.hold.ticket"
+	SubjectRequestConfirmTicketHold    = "request.confirm.ticket.hold"
+	SubjectRequestReleaseTicketHold    = "request.release.ticket.hold"
+	SubjectRequestIssueETicket         = "request.issue.eticket"
+	SubjectRequestRenderTicketPDF      = "request.render.ticket.pdf"
+	SubjectRequestVerifyETicket        = "request.verify.eticket"
+	SubjectRequestGetETicketPublicKey  = "request.get.eticket.publicKey"
+	SubjectRequestTransferTicket       = "request.transfer.ticket"
+	SubjectRequestExchangeTicket       = "request.exchange.ticket"
+	SubjectRequestGetTicketTransfer    = "request.get.ticket.transfer"
+	SubjectRequestAcceptTicketTransfer = "request.accept.ticket.transfer"
+
+	SubjectRequestGetBookingByReference = "request.get.booking.byReference"
+	SubjectRequestCancelBooking         = "request.cancel.booking"
+
+	SubjectRequestCreateCart    = "request.create.cart"
+	SubjectRequestGetCart       = "request.get.cart"
+	SubjectRequestAddCartLeg    = "request.add.cart.leg"
+	SubjectRequestRemoveCartLeg = "request.remove.cart.leg"
+	SubjectRequestCheckoutCart  = "request.checkout.cart"
+
+	SubjectRequestJoinWaitlist     = "request.join.waitlist"
+	SubjectRequestGetWaitlistEntry = "request.get.waitlist.entry"
+	SubjectRequestLeaveWaitlist    = "request.leave.waitlist"
+
+	SubjectRequestCreatePromoCode     = "request.create.promoCode"
+	SubjectRequestGetPromoCode        = "request.get.promoCode"
+	SubjectRequestListPromoCodes      = "request.list.promoCodes"
+	SubjectRequestDeactivatePromoCode = "request.deactivate.promoCode"
 )
 
+const IdempotencyKeyHeader = "Idempotency-Key"
+
 type NatsRequestSender struct {
 	nats *nats.Conn
 }
@@ -84,13 +130,13 @@ func (s *NatsRequestSender) GetUserByID(ctx context.Context, userID uint) (*dto.
 	return dtoUser, nil
 }
 
-func (s *NatsRequestSender) CreateUser(ctx context.Context, firstName string, lastName string, email string) error {
+func (s *NatsRequestSender) CreateUser(ctx context.Context, idempotencyKey string, firstName string, lastName string, email string) error {
 	requestData, err := utils.MarshalCreateUserRequest(firstName, lastName, email)
 	if err != nil {
 		return err
 	}
 
-	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestCreateUser, requestData)
+	replay, err := s.requestIdempotent(ctx, SubjectRequestCreateUser, requestData, idempotencyKey)
 	if err != nil {
 		return err
 	} else if err := utils.HandleError(replay.Data); err != nil {
@@ -145,8 +191,8 @@ func (s *NatsRequestSender) ListTrains(crx context.Context) ([]dto.TrainDTO, err
 	return trainDTOs, nil
 }
 
-func (s *NatsRequestSender) ListTrainsFiltered(ctx context.Context, trainFilters map[string]string) ([]dto.TrainDTO, error) {
-	requestData, err := utils.MarshalListTrainsFilteredRequest(trainFilters)
+func (s *NatsRequestSender) ListTrainsFiltered(ctx context.Context, filter *dto.TrainFilterDTO) (*dto.TrainPageDTO, error) {
+	requestData, err := utils.MarshalListTrainsFilteredRequest(filter)
 	if err != nil {
 		return nil, err
 	}
@@ -158,12 +204,7 @@ func (s *NatsRequestSender) ListTrainsFiltered(ctx context.Context, trainFilters
 		return nil, err
 	}
 
-	trainDTOs, err := utils.UnmarshalListTrainsReplay(replay.Data)
-	if err != nil {
-		return nil, err
-	}
-
-	return trainDTOs, nil
+	return utils.UnmarshalTrainPage(replay.Data)
 }
 
 func (s *NatsRequestSender) GetTrainByID(ctx context.Context, trainID uint) (*dto.TrainDTO, error) {
@@ -184,8 +225,44 @@ func (s *NatsRequestSender) GetTrainByID(ctx context.Context, trainID uint) (*dt
 	return dtoTrain, nil
 }
 
-func (s *NatsRequestSender) CreateTrain(ctx context.Context, name string, capacity uint) error {
-	requestData, err := utils.MarshalCreateTrainRequest(name, capacity)
+// GetTrainSegment gets the train between two of its stations, with the
+// seats free on that part of the route.
+func (s *NatsRequestSender) GetTrainSegment(ctx context.Context, trainID uint, origin, destination string) (*dto.TrainDTO, error) {
+	requestData, err := utils.MarshalTrainSegmentRequest(trainID, origin, destination)
+	if err != nil {
+		return nil, err
+	}
+
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestGetTrainSegment, requestData)
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalTrain(replay.Data)
+}
+
+// GetSeatMap gets every seat of the train laid out in its coaches, with
+// the state of each seat between two of its stations.
+func (s *NatsRequestSender) GetSeatMap(ctx context.Context, trainID uint, origin, destination string) (*dto.SeatMapDTO, error) {
+	requestData, err := utils.MarshalTrainSegmentRequest(trainID, origin, destination)
+	if err != nil {
+		return nil, err
+	}
+
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestGetSeatMap, requestData)
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalSeatMap(replay.Data)
+}
+
+func (s *NatsRequestSender) CreateTrain(ctx context.Context, name string, capacity, layoutTemplateID uint) error {
+	requestData, err := utils.MarshalCreateTrainRequest(name, capacity, layoutTemplateID)
 	if err != nil {
 		return err
 	}
@@ -216,7 +293,7 @@ func (s *NatsRequestSender) UpdateTrainName(ctx context.Context, trainID uint, n
 	return nil
 }
 
-func (s *NatsRequestSender) UpdateTrainTravelDetails(ctx context.Context, trainID uint, origin, destination, departureTime, arrivalTime string) error {
+func (s *NatsRequestSender) UpdateTrainTravelDetails(ctx context.Context, trainID uint, origin, destination, departureTime, arrivalTime string, price int64) error {
 
 	travelDetailsMap := map[string]string{
 		"origin":         origin,
@@ -225,7 +302,7 @@ func (s *NatsRequestSender) UpdateTrainTravelDetails(ctx context.Context, trainI
 		"arrival_time":   arrivalTime,
 	}
 
-	requestData, err := utils.MarshalUpdateTrainTravelDetailsRequest(trainID, travelDetailsMap)
+	requestData, err := utils.MarshalUpdateTrainTravelDetailsRequest(trainID, price, travelDetailsMap)
 	if err != nil {
 		return err
 	}
@@ -240,6 +317,22 @@ func (s *NatsRequestSender) UpdateTrainTravelDetails(ctx context.Context, trainI
 	return nil
 }
 
+func (s *NatsRequestSender) UpdateTrainStops(ctx context.Context, trainID uint, stops []dto.StopDTO) error {
+	requestData, err := utils.MarshalUpdateTrainStopsRequest(trainID, stops)
+	if err != nil {
+		return err
+	}
+
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestUpdateTrainStops, requestData)
+	if err != nil {
+		return err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (s *NatsRequestSender) DeleteTrainByID(ctx context.Context, trainID uint) error {
 	trainIDstr := strconv.Itoa(int(trainID))
 
@@ -253,6 +346,104 @@ func (s *NatsRequestSender) DeleteTrainByID(ctx context.Context, trainID uint) e
 	return nil
 }
 
+func (s *NatsRequestSender) CreateTimetable(ctx context.Context, timetable *dto.TimetableDTO) (*dto.TimetableDTO, error) {
+	requestData, err := utils.MarshalTimetable(timetable)
+	if err != nil {
+		return nil, err
+	}
+
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestCreateTimetable, requestData)
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalTimetable(replay.Data)
+}
+
+func (s *NatsRequestSender) GetTimetableByID(ctx context.Context, timetableID uint) (*dto.TimetableDTO, error) {
+	timetableIDstr := strconv.Itoa(int(timetableID))
+
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestGetTimetableByID, []byte(timetableIDstr))
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalTimetable(replay.Data)
+}
+
+func (s *NatsRequestSender) ListTimetables(ctx context.Context) ([]dto.TimetableDTO, error) {
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestListTimetables, nil)
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalTimetables(replay.Data)
+}
+
+// UpdateTimetable replaces the service pattern of a timetable, the runs
+// somebody booked keep their schedule.
+func (s *NatsRequestSender) UpdateTimetable(ctx context.Context, timetable *dto.TimetableDTO) (*dto.TimetableDTO, error) {
+	requestData, err := utils.MarshalTimetable(timetable)
+	if err != nil {
+		return nil, err
+	}
+
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestUpdateTimetable, requestData)
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalTimetable(replay.Data)
+}
+
+func (s *NatsRequestSender) CreateLayoutTemplate(ctx context.Context, layout *dto.LayoutTemplateDTO) (*dto.LayoutTemplateDTO, error) {
+	requestData, err := utils.MarshalLayoutTemplate(layout)
+	if err != nil {
+		return nil, err
+	}
+
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestCreateLayoutTemplate, requestData)
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalLayoutTemplate(replay.Data)
+}
+
+func (s *NatsRequestSender) GetLayoutTemplateByID(ctx context.Context, layoutTemplateID uint) (*dto.LayoutTemplateDTO, error) {
+	layoutTemplateIDstr := strconv.Itoa(int(layoutTemplateID))
+
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestGetLayoutTemplateByID, []byte(layoutTemplateIDstr))
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalLayoutTemplate(replay.Data)
+}
+
+func (s *NatsRequestSender) ListLayoutTemplates(ctx context.Context) ([]dto.LayoutTemplateDTO, error) {
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestListLayoutTemplates, nil)
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalLayoutTemplates(replay.Data)
+}
+
 func (s *NatsRequestSender) GetSeatByID(ctx context.Context, seatID uint) (*dto.SeatDTO, error) {
 	seatIDstr := strconv.Itoa(int(seatID))
 
@@ -289,8 +480,8 @@ func (s *NatsRequestSender) ListSeatsByTrainID(ctx context.Context, trainID uint
 	return dtoSeats, nil
 }
 
-func (s *NatsRequestSender) CreateSeat(ctx context.Context, trainID uint, seatNumber uint) error {
-	requestData, err := utils.MarshalCreateSeatRequest(trainID, seatNumber)
+func (s *NatsRequestSender) CreateSeat(ctx context.Context, trainID uint, seatNumber uint, class string) error {
+	requestData, err := utils.MarshalCreateSeatRequest(trainID, seatNumber, class)
 	if err != nil {
 		return err
 	}
@@ -352,68 +543,442 @@ func (s *NatsRequestSender) GetTicketByID(ctx context.Context, ticketID uint) (*
 	return dtoTicket, nil
 }
 
-func (s *NatsRequestSender) ListTicketsByUserID(ctx context.Context, userID uint) ([]dto.TicketDTO, error) {
-	userIDstr := strconv.Itoa(int(userID))
+func (s *NatsRequestSender) ListTicketsByUserID(ctx context.Context, userID uint, filter *dto.TicketFilterDTO) (*dto.TicketPageDTO, error) {
+	requestData, err := utils.MarshalListTicketsRequest(userID, 0, filter)
+	if err != nil {
+		return nil, err
+	}
 
-	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestListTicketsByUserID, []byte(userIDstr))
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestListTicketsByUserID, requestData)
 	if err != nil {
 		return nil, err
 	} else if err := utils.HandleError(replay.Data); err != nil {
 		return nil, err
 	}
 
-	dtoTicket, err := utils.UnmarshalTickets(replay.Data)
+	return utils.UnmarshalTicketPage(replay.Data)
+}
+
+func (s *NatsRequestSender) ListTicketsByTrainID(ctx context.Context, trainID uint, filter *dto.TicketFilterDTO) (*dto.TicketPageDTO, error) {
+	requestData, err := utils.MarshalListTicketsRequest(0, trainID, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	return dtoTicket, nil
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestListTicketsByTrainID, requestData)
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalTicketPage(replay.Data)
 }
 
-func (s *NatsRequestSender) ListTicketsByTrainID(ctx context.Context, trainID uint) ([]dto.TicketDTO, error) {
-	trainIDstr := strconv.Itoa(int(trainID))
+func (s *NatsRequestSender) BookTicket(ctx context.Context, idempotencyKey string, userID uint, trainID uint, ticketsNumber uint, seatIDs, seatNumbers []uint, passengers []dto.PassengerDTO, promoCode, origin, destination, class string) ([]dto.TicketDTO, error) {
+	requestData, err := utils.MarshalBookTicketRequest(trainID, userID, ticketsNumber, seatIDs, seatNumbers, passengers, promoCode, origin, destination, class)
+	if err != nil {
+		return nil, err
+	}
 
-	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestListTicketsByTrainID, []byte(trainIDstr))
+	replay, err := s.requestIdempotent(ctx, SubjectRequestBookTicket, requestData, idempotencyKey)
 	if err != nil {
 		return nil, err
 	} else if err := utils.HandleError(replay.Data); err != nil {
 		return nil, err
 	}
 
-	dtoTicket, err := utils.UnmarshalTickets(replay.Data)
+	ticketsDTO, err := utils.UnmarshalTickets(replay.Data)
 	if err != nil {
 		return nil, err
 	}
 
-	return dtoTicket, nil
+	return ticketsDTO, nil
+
 }
 
-func (s *NatsRequestSender) BookTicket(ctx context.Context, userID uint, trainID uint, ticketsNumber uint) ([]dto.TicketDTO, error) {
-	requestData, err := utils.MarshalBookTicketRequest(trainID, userID, ticketsNumber)
+func (s *NatsRequestSender) QuoteTicket(ctx context.Context, userID, trainID, ticketsNumber uint, seatIDs, seatNumbers []uint, passengers []dto.PassengerDTO, promoCode, origin, destination, class string) (*dto.QuoteDTO, error) {
+	requestData, err := utils.MarshalBookTicketRequest(trainID, userID, ticketsNumber, seatIDs, seatNumbers, passengers, promoCode, origin, destination, class)
 	if err != nil {
 		return nil, err
 	}
 
-	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestBookTicket, requestData)
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestQuoteTicket, requestData)
 	if err != nil {
 		return nil, err
 	} else if err := utils.HandleError(replay.Data); err != nil {
 		return nil, err
 	}
 
-	ticketsDTO, err := utils.UnmarshalTickets(replay.Data)
+	return utils.UnmarshalQuoteTicketReply(replay.Data)
+}
+
+func (s *NatsRequestSender) CancelTicket(ctx context.Context, idempotencyKey string, ticketID uint) (*dto.CancellationDTO, error) {
+	ticketIDstr := strconv.Itoa(int(ticketID))
+
+	replay, err := s.requestIdempotent(ctx, SubjectRequestCancelTicket, []byte(ticketIDstr), idempotencyKey)
 	if err != nil {
 		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
 	}
 
-	return ticketsDTO, nil
+	return utils.UnmarshalCancelTicketReply(replay.Data)
+}
 
+func (s *NatsRequestSender) ExchangeTicket(ctx context.Context, idempotencyKey string, ticketID, trainID, seatID, seatNumber uint) (*dto.ExchangeDTO, error) {
+	requestData, err := utils.MarshalExchangeTicketRequest(ticketID, trainID, seatID, seatNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	replay, err := s.requestIdempotent(ctx, SubjectRequestExchangeTicket, requestData, idempotencyKey)
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalExchangeTicketReply(replay.Data)
+}
+
+func (s *NatsRequestSender) IssueETicket(ctx context.Context, ticketID uint) (string, error) {
+	ticketIDstr := strconv.Itoa(int(ticketID))
+
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestIssueETicket, []byte(ticketIDstr))
+	if err != nil {
+		return "", err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return "", err
+	}
+
+	return string(replay.Data), nil
 }
 
-func (s *NatsRequestSender) CancelTicket(ctx context.Context, ticketID uint) error {
+// RenderTicketPDF returns the printable ticket. A PDF is binary, so the
+// reply is only checked for an error when it is not a document.
+func (s *NatsRequestSender) RenderTicketPDF(ctx context.Context, ticketID uint) ([]byte, error) {
 	ticketIDstr := strconv.Itoa(int(ticketID))
 
-	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestCancelTicket, []byte(ticketIDstr))
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestRenderTicketPDF, []byte(ticketIDstr))
+	if err != nil {
+		return nil, err
+	} else if !bytes.HasPrefix(replay.Data, []byte("%PDF-")) {
+		if err := utils.HandleError(replay.Data); err != nil {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("error unexpected reply rendering ticket %d pdf", ticketID)
+	}
+
+	return replay.Data, nil
+}
+
+func (s *NatsRequestSender) VerifyETicket(ctx context.Context, token string) (*dto.TicketDTO, error) {
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestVerifyETicket, []byte(token))
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalTicket(replay.Data)
+}
+
+func (s *NatsRequestSender) GetETicketPublicKey(ctx context.Context) (string, error) {
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestGetETicketPublicKey, nil)
+	if err != nil {
+		return "", err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return "", err
+	}
+
+	return string(replay.Data), nil
+}
+
+func (s *NatsRequestSender) TransferTicket(ctx context.Context, ticketID, fromUserID, toUserID uint, toEmail string) (*dto.TicketTransferDTO, error) {
+	requestData, err := utils.MarshalTransferTicketRequest(ticketID, fromUserID, toUserID, toEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestTransferTicket, requestData)
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalTicketTransfer(replay.Data)
+}
+
+func (s *NatsRequestSender) GetTicketTransfer(ctx context.Context, transferID uint) (*dto.TicketTransferDTO, error) {
+	transferIDstr := strconv.Itoa(int(transferID))
+
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestGetTicketTransfer, []byte(transferIDstr))
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalTicketTransfer(replay.Data)
+}
+
+func (s *NatsRequestSender) AcceptTicketTransfer(ctx context.Context, transferID, userID uint) (*dto.TicketTransferDTO, error) {
+	requestData, err := utils.MarshalAcceptTicketTransferRequest(transferID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestAcceptTicketTransfer, requestData)
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalTicketTransfer(replay.Data)
+}
+
+func (s *NatsRequestSender) HoldTicket(ctx context.Context, userID, trainID, ticketsNumber uint, seatIDs, seatNumbers []uint, passengers []dto.PassengerDTO, origin, destination, class string) (*dto.SeatHoldDTO, error) {
+	requestData, err := utils.MarshalBookTicketRequest(trainID, userID, ticketsNumber, seatIDs, seatNumbers, passengers, "", origin, destination, class)
+	if err != nil {
+		return nil, err
+	}
+
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestHoldTicket, requestData)
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalSeatHold(replay.Data)
+}
+
+func (s *NatsRequestSender) ConfirmTicketHold(ctx context.Context, holdID uint) ([]dto.TicketDTO, error) {
+	holdIDstr := strconv.Itoa(int(holdID))
+
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestConfirmTicketHold, []byte(holdIDstr))
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalTickets(replay.Data)
+}
+
+func (s *NatsRequestSender) ReleaseTicketHold(ctx context.Context, holdID uint) error {
+	holdIDstr := strconv.Itoa(int(holdID))
+
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestReleaseTicketHold, []byte(holdIDstr))
+	if err != nil {
+		return err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *NatsRequestSender) GetBookingByReference(ctx context.Context, reference string) (*dto.BookingDTO, error) {
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestGetBookingByReference, []byte(reference))
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalBooking(replay.Data)
+}
+
+func (s *NatsRequestSender) CancelBooking(ctx context.Context, idempotencyKey string, reference string, ticketIDs []uint) (*dto.BookingCancellationDTO, error) {
+	requestData, err := utils.MarshalCancelBookingRequest(reference, ticketIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	replay, err := s.requestIdempotent(ctx, SubjectRequestCancelBooking, requestData, idempotencyKey)
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalCancelBookingReply(replay.Data)
+}
+
+func (s *NatsRequestSender) CreateCart(ctx context.Context, idempotencyKey string, userID uint) (*dto.CartDTO, error) {
+	userIDstr := strconv.Itoa(int(userID))
+
+	replay, err := s.requestIdempotent(ctx, SubjectRequestCreateCart, []byte(userIDstr), idempotencyKey)
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalCart(replay.Data)
+}
+
+func (s *NatsRequestSender) GetCart(ctx context.Context, cartID uint) (*dto.CartDTO, error) {
+	cartIDstr := strconv.Itoa(int(cartID))
+
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestGetCart, []byte(cartIDstr))
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalCart(replay.Data)
+}
+
+func (s *NatsRequestSender) AddCartLeg(ctx context.Context, cartID, trainID, ticketNumber uint) (*dto.CartDTO, error) {
+	requestData, err := utils.MarshalAddCartLegRequest(cartID, trainID, ticketNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestAddCartLeg, requestData)
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalCart(replay.Data)
+}
+
+func (s *NatsRequestSender) RemoveCartLeg(ctx context.Context, cartID, legID uint) (*dto.CartDTO, error) {
+	requestData, err := utils.MarshalRemoveCartLegRequest(cartID, legID)
+	if err != nil {
+		return nil, err
+	}
+
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestRemoveCartLeg, requestData)
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalCart(replay.Data)
+}
+
+func (s *NatsRequestSender) CheckoutCart(ctx context.Context, idempotencyKey string, cartID uint, passengers []dto.PassengerDTO) (*dto.BookingDTO, error) {
+	requestData, err := utils.MarshalCheckoutCartRequest(cartID, passengers)
+	if err != nil {
+		return nil, err
+	}
+
+	replay, err := s.requestIdempotent(ctx, SubjectRequestCheckoutCart, requestData, idempotencyKey)
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalBooking(replay.Data)
+}
+
+func (s *NatsRequestSender) JoinWaitlist(ctx context.Context, userID, trainID, ticketNumber uint) (*dto.WaitlistEntryDTO, error) {
+	requestData, err := utils.MarshalJoinWaitlistRequest(userID, trainID, ticketNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestJoinWaitlist, requestData)
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalWaitlistEntry(replay.Data)
+}
+
+func (s *NatsRequestSender) GetWaitlistEntry(ctx context.Context, entryID uint) (*dto.WaitlistEntryDTO, error) {
+	entryIDstr := strconv.Itoa(int(entryID))
+
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestGetWaitlistEntry, []byte(entryIDstr))
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalWaitlistEntry(replay.Data)
+}
+
+func (s *NatsRequestSender) LeaveWaitlist(ctx context.Context, entryID uint) error {
+	entryIDstr := strconv.Itoa(int(entryID))
+
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestLeaveWaitlist, []byte(entryIDstr))
+	if err != nil {
+		return err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// requestIdempotent sends a request that carries the client's idempotency
+// key as a header, so the service can answer retries with the first reply.
+func (s *NatsRequestSender) requestIdempotent(ctx context.Context, subject string, data []byte, idempotencyKey string) (*nats.Msg, error) {
+	msg := nats.NewMsg(subject)
+	msg.Data = data
+
+	if idempotencyKey != "" {
+		msg.Header.Set(IdempotencyKeyHeader, idempotencyKey)
+	}
+
+	return s.nats.RequestMsgWithContext(ctx, msg)
+}
+
+func (s *NatsRequestSender) CreatePromoCode(ctx context.Context, promo *dto.PromoCodeDTO) (*dto.PromoCodeDTO, error) {
+	requestData, err := utils.MarshalPromoCode(promo)
+	if err != nil {
+		return nil, err
+	}
+
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestCreatePromoCode, requestData)
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalPromoCode(replay.Data)
+}
+
+func (s *NatsRequestSender) GetPromoCode(ctx context.Context, code string) (*dto.PromoCodeDTO, error) {
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestGetPromoCode, []byte(code))
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalPromoCode(replay.Data)
+}
+
+func (s *NatsRequestSender) ListPromoCodes(ctx context.Context) ([]dto.PromoCodeDTO, error) {
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestListPromoCodes, nil)
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(replay.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalPromoCodes(replay.Data)
+}
+
+func (s *NatsRequestSender) DeactivatePromoCode(ctx context.Context, code string) error {
+	replay, err := s.nats.RequestWithContext(ctx, SubjectRequestDeactivatePromoCode, []byte(code))
 	if err != nil {
 		return err
 	} else if err := utils.HandleError(replay.Data); err != nil {
@@ -8,27 +8,69 @@ import (
 type RequestSender interface {
 	ListUsers(ctx context.Context) ([]dto.UserDTO, error)
 	GetUserByID(ctx context.Context, userID uint) (*dto.UserDTO, error)
-	CreateUser(ctx context.Context, firstName, lastName, email string) error
+	CreateUser(ctx context.Context, idempotencyKey string, firstName, lastName, email string) error
 	UpdateUserByID(ctx context.Context, userID uint, firstName, lastName string) error
 	DeleteUserByID(ctx context.Context, userID uint) error
 
 	ListTrains(ctx context.Context) ([]dto.TrainDTO, error)
-	ListTrainsFiltered(ctx context.Context, trainFilters map[string]string) ([]dto.TrainDTO, error)
+	ListTrainsFiltered(ctx context.Context, filter *dto.TrainFilterDTO) (*dto.TrainPageDTO, error)
 	GetTrainByID(ctx context.Context, trainID uint) (*dto.TrainDTO, error)
-	CreateTrain(ctx context.Context, name string, capacity uint) error
+	GetTrainSegment(ctx context.Context, trainID uint, origin, destination string) (*dto.TrainDTO, error)
+	GetSeatMap(ctx context.Context, trainID uint, origin, destination string) (*dto.SeatMapDTO, error)
+	CreateTrain(ctx context.Context, name string, capacity, layoutTemplateID uint) error
 	UpdateTrainName(ctx context.Context, trainID uint, name string) error
-	UpdateTrainTravelDetails(ctx context.Context, trainID uint, origin, destination, departureTime, arrivalTime string) error
+	UpdateTrainTravelDetails(ctx context.Context, trainID uint, origin, destination, departureTime, arrivalTime string, price int64) error
+	UpdateTrainStops(ctx context.Context, trainID uint, stops []dto.StopDTO) error
 	DeleteTrainByID(ctx context.Context, trainID uint) error
 
+	CreateTimetable(ctx context.Context, timetable *dto.TimetableDTO) (*dto.TimetableDTO, error)
+	GetTimetableByID(ctx context.Context, timetableID uint) (*dto.TimetableDTO, error)
+	ListTimetables(ctx context.Context) ([]dto.TimetableDTO, error)
+	UpdateTimetable(ctx context.Context, timetable *dto.TimetableDTO) (*dto.TimetableDTO, error)
+
+	CreateLayoutTemplate(ctx context.Context, layout *dto.LayoutTemplateDTO) (*dto.LayoutTemplateDTO, error)
+	GetLayoutTemplateByID(ctx context.Context, layoutTemplateID uint) (*dto.LayoutTemplateDTO, error)
+	ListLayoutTemplates(ctx context.Context) ([]dto.LayoutTemplateDTO, error)
+
 	GetSeatByID(ctx context.Context, seatID uint) (*dto.SeatDTO, error)
 	ListSeatsByTrainID(ctx context.Context, trainID uint) ([]dto.SeatDTO, error)
-	CreateSeat(ctx context.Context, trainID, seatNumber uint) error
+	CreateSeat(ctx context.Context, trainID, seatNumber uint, class string) error
 	UpdateSeatNumberBySeatID(ctx context.Context, seatID, seatNumber uint) error
 	DeleteSeatBySeatID(ctx context.Context, seatID uint) error
 
 	GetTicketByID(ctx context.Context, ticketID uint) (*dto.TicketDTO, error)
-	ListTicketsByUserID(ctx context.Context,userID uint)([]dto.TicketDTO, error)
-	ListTicketsByTrainID(ctx context.Context,trainID uint)([]dto.TicketDTO,error)
-	BookTicket(ctx context.Context, userID, trainID, TicketsNumber uint) ([]dto.TicketDTO, error)
-	CancelTicket(ctx context.Context, ticketID uint) error
+	ListTicketsByUserID(ctx context.Context, userID uint, filter *dto.TicketFilterDTO) (*dto.TicketPageDTO, error)
+	ListTicketsByTrainID(ctx context.Context, trainID uint, filter *dto.TicketFilterDTO) (*dto.TicketPageDTO, error)
+	BookTicket(ctx context.Context, idempotencyKey string, userID, trainID, TicketsNumber uint, seatIDs, seatNumbers []uint, passengers []dto.PassengerDTO, promoCode, origin, destination, class string) ([]dto.TicketDTO, error)
+	QuoteTicket(ctx context.Context, userID, trainID, ticketsNumber uint, seatIDs, seatNumbers []uint, passengers []dto.PassengerDTO, promoCode, origin, destination, class string) (*dto.QuoteDTO, error)
+	CancelTicket(ctx context.Context, idempotencyKey string, ticketID uint) (*dto.CancellationDTO, error)
+	ExchangeTicket(ctx context.Context, idempotencyKey string, ticketID, trainID, seatID, seatNumber uint) (*dto.ExchangeDTO, error)
+	IssueETicket(ctx context.Context, ticketID uint) (string, error)
+	RenderTicketPDF(ctx context.Context, ticketID uint) ([]byte, error)
+	VerifyETicket(ctx context.Context, token string) (*dto.TicketDTO, error)
+	GetETicketPublicKey(ctx context.Context) (string, error)
+	TransferTicket(ctx context.Context, ticketID, fromUserID, toUserID uint, toEmail string) (*dto.TicketTransferDTO, error)
+	GetTicketTransfer(ctx context.Context, transferID uint) (*dto.TicketTransferDTO, error)
+	AcceptTicketTransfer(ctx context.Context, transferID, userID uint) (*dto.TicketTransferDTO, error)
+	HoldTicket(ctx context.Context, userID, trainID, ticketsNumber uint, seatIDs, seatNumbers []uint, passengers []dto.PassengerDTO, origin, destination, class string) (*dto.SeatHoldDTO, error)
+	ConfirmTicketHold(ctx context.Context, holdID uint) ([]dto.TicketDTO, error)
+	ReleaseTicketHold(ctx context.Context, holdID uint) error
+
+	GetBookingByReference(ctx context.Context, reference string) (*dto.BookingDTO, error)
+	CancelBooking(ctx context.Context, idempotencyKey string, reference string, ticketIDs []uint) (*dto.BookingCancellationDTO, error)
+
+	CreateCart(ctx context.Context, idempotencyKey string, userID uint) (*dto.CartDTO, error)
+	GetCart(ctx context.Context, cartID uint) (*dto.CartDTO, error)
+	AddCartLeg(ctx context.Context, cartID, trainID, ticketNumber uint) (*dto.CartDTO, error)
+	RemoveCartLeg(ctx context.Context, cartID, legID uint) (*dto.CartDTO, error)
+	CheckoutCart(ctx context.Context, idempotencyKey string, cartID uint, passengers []dto.PassengerDTO) (*dto.BookingDTO, error)
+
+	JoinWaitlist(ctx context.Context, userID, trainID, ticketNumber uint) (*dto.WaitlistEntryDTO, error)
+	GetWaitlistEntry(ctx context.Context, entryID uint) (*dto.WaitlistEntryDTO, error)
+	LeaveWaitlist(ctx context.Context, entryID uint) error
+
+	CreatePromoCode(ctx context.Context, promo *dto.PromoCodeDTO) (*dto.PromoCodeDTO, error)
+	GetPromoCode(ctx context.Context, code string) (*dto.PromoCodeDTO, error)
+	ListPromoCodes(ctx context.Context) ([]dto.PromoCodeDTO, error)
+	DeactivatePromoCode(ctx context.Context, code string) error
 }
@@ -26,15 +26,23 @@ type Train struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID            uint32                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Origin        string                 `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
-	Destination   string                 `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
-	Seats         []*Seat                `protobuf:"bytes,5,rep,name=seats,proto3" json:"seats,omitempty"`
-	Capacity      uint32                 `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	IsFull        bool                   `protobuf:"varint,7,opt,name=is_full,json=isFull,proto3" json:"is_full,omitempty"`
-	DepartureTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
-	ArrivalTime   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=arrival_time,json=arrivalTime,proto3" json:"arrival_time,omitempty"`
+	ID             uint32                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Origin         string                 `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+	Destination    string                 `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
+	Seats          []*Seat                `protobuf:"bytes,5,rep,name=seats,proto3" json:"seats,omitempty"`
+	Capacity       uint32                 `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	IsFull         bool                   `protobuf:"varint,7,opt,name=is_full,json=isFull,proto3" json:"is_full,omitempty"`
+	DepartureTime  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
+	ArrivalTime    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=arrival_time,json=arrivalTime,proto3" json:"arrival_time,omitempty"`
+	AvailableSeats uint32                 `protobuf:"varint,10,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
+	Price          int64                  `protobuf:"varint,11,opt,name=price,proto3" json:"price,omitempty"`
+	Stops          []*Stop                `protobuf:"bytes,12,rep,name=stops,proto3" json:"stops,omitempty"`
+	FromStop       uint32                 `protobuf:"varint,13,opt,name=from_stop,json=fromStop,proto3" json:"from_stop,omitempty"`
+	ToStop         uint32                 `protobuf:"varint,14,opt,name=to_stop,json=toStop,proto3" json:"to_stop,omitempty"`
+	TimetableId    uint32                 `protobuf:"varint,15,opt,name=timetable_id,json=timetableId,proto3" json:"timetable_id,omitempty"`
+	ServiceDate    *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=service_date,json=serviceDate,proto3" json:"service_date,omitempty"`
+	Coaches        []*Coach               `protobuf:"bytes,17,rep,name=coaches,proto3" json:"coaches,omitempty"`
 }
 
 func (x *Train) Reset() {
@@ -132,36 +140,851 @@ func (x *Train) GetArrivalTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Train) GetAvailableSeats() uint32 {
+	if x != nil {
+		return x.AvailableSeats
+	}
+	return 0
+}
+
+func (x *Train) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Train) GetStops() []*Stop {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+func (x *Train) GetFromStop() uint32 {
+	if x != nil {
+		return x.FromStop
+	}
+	return 0
+}
+
+func (x *Train) GetToStop() uint32 {
+	if x != nil {
+		return x.ToStop
+	}
+	return 0
+}
+
+func (x *Train) GetTimetableId() uint32 {
+	if x != nil {
+		return x.TimetableId
+	}
+	return 0
+}
+
+func (x *Train) GetServiceDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ServiceDate
+	}
+	return nil
+}
+
+func (x *Train) GetCoaches() []*Coach {
+	if x != nil {
+		return x.Coaches
+	}
+	return nil
+}
+
+type Coach struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID      uint32 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Number  uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	Class   string `protobuf:"bytes,3,opt,name=class,proto3" json:"class,omitempty"`
+	Rows    uint32 `protobuf:"varint,4,opt,name=rows,proto3" json:"rows,omitempty"`
+	Columns uint32 `protobuf:"varint,5,opt,name=columns,proto3" json:"columns,omitempty"`
+}
+
+func (x *Coach) Reset() {
+	*x = Coach{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_train_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Coach) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coach) ProtoMessage() {}
+
+func (x *Coach) ProtoReflect() protoreflect.Message {
+	mi := &file_train_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coach.ProtoReflect.Descriptor instead.
+func (*Coach) Descriptor() ([]byte, []int) {
+	return file_train_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Coach) GetID() uint32 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Coach) GetNumber() uint32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Coach) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *Coach) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *Coach) GetColumns() uint32 {
+	if x != nil {
+		return x.Columns
+	}
+	return 0
+}
+
+type LayoutTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID      uint32           `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name    string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Coaches []*CoachTemplate `protobuf:"bytes,3,rep,name=coaches,proto3" json:"coaches,omitempty"`
+}
+
+func (x *LayoutTemplate) Reset() {
+	*x = LayoutTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_train_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LayoutTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LayoutTemplate) ProtoMessage() {}
+
+func (x *LayoutTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_train_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LayoutTemplate.ProtoReflect.Descriptor instead.
+func (*LayoutTemplate) Descriptor() ([]byte, []int) {
+	return file_train_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LayoutTemplate) GetID() uint32 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *LayoutTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LayoutTemplate) GetCoaches() []*CoachTemplate {
+	if x != nil {
+		return x.Coaches
+	}
+	return nil
+}
+
+type CoachTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number  uint32          `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Class   string          `protobuf:"bytes,2,opt,name=class,proto3" json:"class,omitempty"`
+	Rows    uint32          `protobuf:"varint,3,opt,name=rows,proto3" json:"rows,omitempty"`
+	Columns uint32          `protobuf:"varint,4,opt,name=columns,proto3" json:"columns,omitempty"`
+	Seats   []*SeatTemplate `protobuf:"bytes,5,rep,name=seats,proto3" json:"seats,omitempty"`
+}
+
+func (x *CoachTemplate) Reset() {
+	*x = CoachTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_train_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoachTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoachTemplate) ProtoMessage() {}
+
+func (x *CoachTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_train_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoachTemplate.ProtoReflect.Descriptor instead.
+func (*CoachTemplate) Descriptor() ([]byte, []int) {
+	return file_train_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CoachTemplate) GetNumber() uint32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *CoachTemplate) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *CoachTemplate) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *CoachTemplate) GetColumns() uint32 {
+	if x != nil {
+		return x.Columns
+	}
+	return 0
+}
+
+func (x *CoachTemplate) GetSeats() []*SeatTemplate {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+type SeatTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Row        uint32   `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Column     uint32   `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"`
+	Attributes []string `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *SeatTemplate) Reset() {
+	*x = SeatTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_train_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeatTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatTemplate) ProtoMessage() {}
+
+func (x *SeatTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_train_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatTemplate.ProtoReflect.Descriptor instead.
+func (*SeatTemplate) Descriptor() ([]byte, []int) {
+	return file_train_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SeatTemplate) GetRow() uint32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *SeatTemplate) GetColumn() uint32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *SeatTemplate) GetAttributes() []string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type Stop struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence      uint32                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Station       string                 `protobuf:"bytes,2,opt,name=station,proto3" json:"station,omitempty"`
+	ArrivalTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=arrival_time,json=arrivalTime,proto3" json:"arrival_time,omitempty"`
+	DepartureTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
+}
+
+func (x *Stop) Reset() {
+	*x = Stop{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_train_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Stop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stop) ProtoMessage() {}
+
+func (x *Stop) ProtoReflect() protoreflect.Message {
+	mi := &file_train_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stop.ProtoReflect.Descriptor instead.
+func (*Stop) Descriptor() ([]byte, []int) {
+	return file_train_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Stop) GetSequence() uint32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Stop) GetStation() string {
+	if x != nil {
+		return x.Station
+	}
+	return ""
+}
+
+func (x *Stop) GetArrivalTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArrivalTime
+	}
+	return nil
+}
+
+func (x *Stop) GetDepartureTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepartureTime
+	}
+	return nil
+}
+
+type Timetable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID               uint32                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity         uint32                 `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Price            int64                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	TimeZone         string                 `protobuf:"bytes,5,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Weekdays         uint32                 `protobuf:"varint,6,opt,name=weekdays,proto3" json:"weekdays,omitempty"`
+	ValidFrom        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidUntil       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	Stops            []*TimetableStop       `protobuf:"bytes,9,rep,name=stops,proto3" json:"stops,omitempty"`
+	Exceptions       []*TimetableException  `protobuf:"bytes,10,rep,name=exceptions,proto3" json:"exceptions,omitempty"`
+	LayoutTemplateId uint32                 `protobuf:"varint,11,opt,name=layout_template_id,json=layoutTemplateId,proto3" json:"layout_template_id,omitempty"`
+}
+
+func (x *Timetable) Reset() {
+	*x = Timetable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_train_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Timetable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Timetable) ProtoMessage() {}
+
+func (x *Timetable) ProtoReflect() protoreflect.Message {
+	mi := &file_train_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Timetable.ProtoReflect.Descriptor instead.
+func (*Timetable) Descriptor() ([]byte, []int) {
+	return file_train_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Timetable) GetID() uint32 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Timetable) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Timetable) GetCapacity() uint32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Timetable) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Timetable) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Timetable) GetWeekdays() uint32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return 0
+}
+
+func (x *Timetable) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *Timetable) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+func (x *Timetable) GetStops() []*TimetableStop {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+func (x *Timetable) GetExceptions() []*TimetableException {
+	if x != nil {
+		return x.Exceptions
+	}
+	return nil
+}
+
+func (x *Timetable) GetLayoutTemplateId() uint32 {
+	if x != nil {
+		return x.LayoutTemplateId
+	}
+	return 0
+}
+
+type TimetableStop struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence        uint32 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Station         string `protobuf:"bytes,2,opt,name=station,proto3" json:"station,omitempty"`
+	ArrivalMinute   uint32 `protobuf:"varint,3,opt,name=arrival_minute,json=arrivalMinute,proto3" json:"arrival_minute,omitempty"`
+	DepartureMinute uint32 `protobuf:"varint,4,opt,name=departure_minute,json=departureMinute,proto3" json:"departure_minute,omitempty"`
+}
+
+func (x *TimetableStop) Reset() {
+	*x = TimetableStop{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_train_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimetableStop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimetableStop) ProtoMessage() {}
+
+func (x *TimetableStop) ProtoReflect() protoreflect.Message {
+	mi := &file_train_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimetableStop.ProtoReflect.Descriptor instead.
+func (*TimetableStop) Descriptor() ([]byte, []int) {
+	return file_train_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TimetableStop) GetSequence() uint32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *TimetableStop) GetStation() string {
+	if x != nil {
+		return x.Station
+	}
+	return ""
+}
+
+func (x *TimetableStop) GetArrivalMinute() uint32 {
+	if x != nil {
+		return x.ArrivalMinute
+	}
+	return 0
+}
+
+func (x *TimetableStop) GetDepartureMinute() uint32 {
+	if x != nil {
+		return x.DepartureMinute
+	}
+	return 0
+}
+
+type TimetableException struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Kind string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+}
+
+func (x *TimetableException) Reset() {
+	*x = TimetableException{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_train_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimetableException) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimetableException) ProtoMessage() {}
+
+func (x *TimetableException) ProtoReflect() protoreflect.Message {
+	mi := &file_train_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimetableException.ProtoReflect.Descriptor instead.
+func (*TimetableException) Descriptor() ([]byte, []int) {
+	return file_train_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TimetableException) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *TimetableException) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
 type TrainFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Origin         string                 `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
-	Destination    string                 `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
-	AvailableSeats uint32                 `protobuf:"varint,4,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
-	DepartureTime  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
-	ArrivalTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=arrival_time,json=arrivalTime,proto3" json:"arrival_time,omitempty"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Origin            string                 `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+	Destination       string                 `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	MinAvailableSeats uint32                 `protobuf:"varint,4,opt,name=min_available_seats,json=minAvailableSeats,proto3" json:"min_available_seats,omitempty"`
+	DepartureFrom     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=departure_from,json=departureFrom,proto3" json:"departure_from,omitempty"`
+	DepartureTo       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=departure_to,json=departureTo,proto3" json:"departure_to,omitempty"`
+	ArrivalFrom       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=arrival_from,json=arrivalFrom,proto3" json:"arrival_from,omitempty"`
+	ArrivalTo         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=arrival_to,json=arrivalTo,proto3" json:"arrival_to,omitempty"`
+	Sort              string                 `protobuf:"bytes,9,opt,name=sort,proto3" json:"sort,omitempty"`
+	Cursor            string                 `protobuf:"bytes,10,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit             uint32                 `protobuf:"varint,11,opt,name=limit,proto3" json:"limit,omitempty"`
+	Class             string                 `protobuf:"bytes,12,opt,name=class,proto3" json:"class,omitempty"`
+	SeatAttributes    []string               `protobuf:"bytes,13,rep,name=seat_attributes,json=seatAttributes,proto3" json:"seat_attributes,omitempty"`
+}
+
+func (x *TrainFilter) Reset() {
+	*x = TrainFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_train_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrainFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrainFilter) ProtoMessage() {}
+
+func (x *TrainFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_train_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrainFilter.ProtoReflect.Descriptor instead.
+func (*TrainFilter) Descriptor() ([]byte, []int) {
+	return file_train_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TrainFilter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrainFilter) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *TrainFilter) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *TrainFilter) GetMinAvailableSeats() uint32 {
+	if x != nil {
+		return x.MinAvailableSeats
+	}
+	return 0
+}
+
+func (x *TrainFilter) GetDepartureFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepartureFrom
+	}
+	return nil
+}
+
+func (x *TrainFilter) GetDepartureTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepartureTo
+	}
+	return nil
+}
+
+func (x *TrainFilter) GetArrivalFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArrivalFrom
+	}
+	return nil
+}
+
+func (x *TrainFilter) GetArrivalTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArrivalTo
+	}
+	return nil
+}
+
+func (x *TrainFilter) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *TrainFilter) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *TrainFilter) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *TrainFilter) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *TrainFilter) GetSeatAttributes() []string {
+	if x != nil {
+		return x.SeatAttributes
+	}
+	return nil
+}
+
+type Seat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         uint32   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	TrainId    uint32   `protobuf:"varint,2,opt,name=train_id,json=trainId,proto3" json:"train_id,omitempty"`
+	UserId     uint32   `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SeatNumber uint32   `protobuf:"varint,4,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	Booked     bool     `protobuf:"varint,5,opt,name=booked,proto3" json:"booked,omitempty"`
+	Held       bool     `protobuf:"varint,6,opt,name=held,proto3" json:"held,omitempty"`
+	Class      string   `protobuf:"bytes,7,opt,name=class,proto3" json:"class,omitempty"`
+	FromStop   uint32   `protobuf:"varint,8,opt,name=from_stop,json=fromStop,proto3" json:"from_stop,omitempty"`
+	ToStop     uint32   `protobuf:"varint,9,opt,name=to_stop,json=toStop,proto3" json:"to_stop,omitempty"`
+	CoachId    uint32   `protobuf:"varint,10,opt,name=coach_id,json=coachId,proto3" json:"coach_id,omitempty"`
+	Row        uint32   `protobuf:"varint,11,opt,name=row,proto3" json:"row,omitempty"`
+	Column     uint32   `protobuf:"varint,12,opt,name=column,proto3" json:"column,omitempty"`
+	Attributes []string `protobuf:"bytes,13,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	State      string   `protobuf:"bytes,14,opt,name=state,proto3" json:"state,omitempty"`
 }
 
-func (x *TrainFilter) Reset() {
-	*x = TrainFilter{}
+func (x *Seat) Reset() {
+	*x = Seat{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_train_proto_msgTypes[1]
+		mi := &file_train_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TrainFilter) String() string {
+func (x *Seat) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrainFilter) ProtoMessage() {}
+func (*Seat) ProtoMessage() {}
 
-func (x *TrainFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_train_proto_msgTypes[1]
+func (x *Seat) ProtoReflect() protoreflect.Message {
+	mi := &file_train_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -172,81 +995,136 @@ func (x *TrainFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrainFilter.ProtoReflect.Descriptor instead.
-func (*TrainFilter) Descriptor() ([]byte, []int) {
-	return file_train_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Seat.ProtoReflect.Descriptor instead.
+func (*Seat) Descriptor() ([]byte, []int) {
+	return file_train_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *TrainFilter) GetName() string {
+func (x *Seat) GetID() uint32 {
 	if x != nil {
-		return x.Name
+		return x.ID
 	}
-	return ""
+	return 0
 }
 
-func (x *TrainFilter) GetOrigin() string {
+func (x *Seat) GetTrainId() uint32 {
 	if x != nil {
-		return x.Origin
+		return x.TrainId
 	}
-	return ""
+	return 0
 }
 
-func (x *TrainFilter) GetDestination() string {
+func (x *Seat) GetUserId() uint32 {
 	if x != nil {
-		return x.Destination
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Seat) GetSeatNumber() uint32 {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return 0
+}
+
+func (x *Seat) GetBooked() bool {
+	if x != nil {
+		return x.Booked
+	}
+	return false
+}
+
+func (x *Seat) GetHeld() bool {
+	if x != nil {
+		return x.Held
+	}
+	return false
+}
+
+func (x *Seat) GetClass() string {
+	if x != nil {
+		return x.Class
 	}
 	return ""
 }
 
-func (x *TrainFilter) GetAvailableSeats() uint32 {
+func (x *Seat) GetFromStop() uint32 {
 	if x != nil {
-		return x.AvailableSeats
+		return x.FromStop
 	}
 	return 0
 }
 
-func (x *TrainFilter) GetDepartureTime() *timestamppb.Timestamp {
+func (x *Seat) GetToStop() uint32 {
 	if x != nil {
-		return x.DepartureTime
+		return x.ToStop
 	}
-	return nil
+	return 0
 }
 
-func (x *TrainFilter) GetArrivalTime() *timestamppb.Timestamp {
+func (x *Seat) GetCoachId() uint32 {
 	if x != nil {
-		return x.ArrivalTime
+		return x.CoachId
+	}
+	return 0
+}
+
+func (x *Seat) GetRow() uint32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *Seat) GetColumn() uint32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *Seat) GetAttributes() []string {
+	if x != nil {
+		return x.Attributes
 	}
 	return nil
 }
 
-type Seat struct {
+func (x *Seat) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type SeatMap struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID         uint32 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	TrainId    uint32 `protobuf:"varint,2,opt,name=train_id,json=trainId,proto3" json:"train_id,omitempty"`
-	UserId     uint32 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	SeatNumber uint32 `protobuf:"varint,4,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	Train   *Train          `protobuf:"bytes,1,opt,name=train,proto3" json:"train,omitempty"`
+	Coaches []*SeatMapCoach `protobuf:"bytes,2,rep,name=coaches,proto3" json:"coaches,omitempty"`
+	Seats   []*Seat         `protobuf:"bytes,3,rep,name=seats,proto3" json:"seats,omitempty"`
 }
 
-func (x *Seat) Reset() {
-	*x = Seat{}
+func (x *SeatMap) Reset() {
+	*x = SeatMap{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_train_proto_msgTypes[2]
+		mi := &file_train_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Seat) String() string {
+func (x *SeatMap) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Seat) ProtoMessage() {}
+func (*SeatMap) ProtoMessage() {}
 
-func (x *Seat) ProtoReflect() protoreflect.Message {
-	mi := &file_train_proto_msgTypes[2]
+func (x *SeatMap) ProtoReflect() protoreflect.Message {
+	mi := &file_train_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -257,37 +1135,85 @@ func (x *Seat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Seat.ProtoReflect.Descriptor instead.
-func (*Seat) Descriptor() ([]byte, []int) {
-	return file_train_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use SeatMap.ProtoReflect.Descriptor instead.
+func (*SeatMap) Descriptor() ([]byte, []int) {
+	return file_train_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Seat) GetID() uint32 {
+func (x *SeatMap) GetTrain() *Train {
 	if x != nil {
-		return x.ID
+		return x.Train
 	}
-	return 0
+	return nil
 }
 
-func (x *Seat) GetTrainId() uint32 {
+func (x *SeatMap) GetCoaches() []*SeatMapCoach {
 	if x != nil {
-		return x.TrainId
+		return x.Coaches
 	}
-	return 0
+	return nil
 }
 
-func (x *Seat) GetUserId() uint32 {
+func (x *SeatMap) GetSeats() []*Seat {
 	if x != nil {
-		return x.UserId
+		return x.Seats
 	}
-	return 0
+	return nil
 }
 
-func (x *Seat) GetSeatNumber() uint32 {
+type SeatMapCoach struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Coach *Coach  `protobuf:"bytes,1,opt,name=coach,proto3" json:"coach,omitempty"`
+	Seats []*Seat `protobuf:"bytes,2,rep,name=seats,proto3" json:"seats,omitempty"`
+}
+
+func (x *SeatMapCoach) Reset() {
+	*x = SeatMapCoach{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_train_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeatMapCoach) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatMapCoach) ProtoMessage() {}
+
+func (x *SeatMapCoach) ProtoReflect() protoreflect.Message {
+	mi := &file_train_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatMapCoach.ProtoReflect.Descriptor instead.
+func (*SeatMapCoach) Descriptor() ([]byte, []int) {
+	return file_train_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SeatMapCoach) GetCoach() *Coach {
 	if x != nil {
-		return x.SeatNumber
+		return x.Coach
 	}
-	return 0
+	return nil
+}
+
+func (x *SeatMapCoach) GetSeats() []*Seat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
 }
 
 var File_train_proto protoreflect.FileDescriptor
@@ -295,8 +1221,8 @@ var File_train_proto protoreflect.FileDescriptor
 var file_train_proto_rawDesc = []byte{
 	0x0a, 0x0b, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb9,
-	0x02, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcf,
+	0x04, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
 	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72,
@@ -315,32 +1241,173 @@ var file_train_proto_rawDesc = []byte{
 	0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x61,
-	0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x86, 0x02, 0x0a, 0x0b, 0x54,
-	0x72, 0x61, 0x69, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74,
-	0x73, 0x12, 0x41, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54,
-	0x69, 0x6d, 0x65, 0x22, 0x6b, 0x0a, 0x04, 0x53, 0x65, 0x61, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x49, 0x44, 0x12, 0x19, 0x0a, 0x08, 0x74,
-	0x72, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74,
-	0x72, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f, 0x67, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65,
+	0x61, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x05, 0x73, 0x74, 0x6f,
+	0x70, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52,
+	0x05, 0x73, 0x74, 0x6f, 0x70, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73,
+	0x74, 0x6f, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x53,
+	0x74, 0x6f, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x74, 0x6f, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x21, 0x0a, 0x0c,
+	0x74, 0x69, 0x6d, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12,
+	0x3d, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20,
+	0x0a, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x06, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x65, 0x73,
+	0x22, 0x73, 0x0a, 0x05, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0x5e, 0x0a, 0x0e, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x63,
+	0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x43,
+	0x6f, 0x61, 0x63, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x07, 0x63, 0x6f,
+	0x61, 0x63, 0x68, 0x65, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x22, 0x58, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x74,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x41, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x9b, 0x03, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73,
+	0x12, 0x39, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x3b, 0x0a, 0x0b, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x70,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x70, 0x73, 0x12, 0x33,
+	0x0a, 0x0a, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x78,
+	0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x10, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49,
+	0x64, 0x22, 0x97, 0x01, 0x0a, 0x0d, 0x54, 0x69, 0x6d, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53,
+	0x74, 0x6f, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x72, 0x72,
+	0x69, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65,
+	0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x6d, 0x69,
+	0x6e, 0x75, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x22, 0x58, 0x0a, 0x12, 0x54,
+	0x69, 0x6d, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x88, 0x04, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x11, 0x6d, 0x69, 0x6e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65,
+	0x61, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x3d, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x54, 0x6f, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c,
+	0x46, 0x72, 0x6f, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f,
+	0x74, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54, 0x6f, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73,
+	0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x61, 0x74, 0x5f,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x73, 0x65, 0x61, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x22, 0xde, 0x02, 0x0a, 0x04, 0x53, 0x65, 0x61, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x49, 0x44, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x62, 0x6f, 0x6f, 0x6b, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x65, 0x6c, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x17, 0x0a,
+	0x07, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x74, 0x6f, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f,
+	0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x49,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x72, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x22, 0x6d, 0x0a, 0x07, 0x53, 0x65, 0x61, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x1c, 0x0a, 0x05,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x54, 0x72,
+	0x61, 0x69, 0x6e, 0x52, 0x05, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x12, 0x27, 0x0a, 0x07, 0x63, 0x6f,
+	0x61, 0x63, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x53, 0x65,
+	0x61, 0x74, 0x4d, 0x61, 0x70, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x07, 0x63, 0x6f, 0x61, 0x63,
+	0x68, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73,
+	0x22, 0x49, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x74, 0x4d, 0x61, 0x70, 0x43, 0x6f, 0x61, 0x63, 0x68,
+	0x12, 0x1c, 0x0a, 0x05, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x06, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x05, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x12, 0x1b,
+	0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e,
+	0x53, 0x65, 0x61, 0x74, 0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x42, 0x07, 0x5a, 0x05, 0x2e,
+	0x2f, 0x67, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -355,24 +1422,53 @@ func file_train_proto_rawDescGZIP() []byte {
 	return file_train_proto_rawDescData
 }
 
-var file_train_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_train_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_train_proto_goTypes = []any{
 	(*Train)(nil),                 // 0: Train
-	(*TrainFilter)(nil),           // 1: TrainFilter
-	(*Seat)(nil),                  // 2: Seat
-	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(*Coach)(nil),                 // 1: Coach
+	(*LayoutTemplate)(nil),        // 2: LayoutTemplate
+	(*CoachTemplate)(nil),         // 3: CoachTemplate
+	(*SeatTemplate)(nil),          // 4: SeatTemplate
+	(*Stop)(nil),                  // 5: Stop
+	(*Timetable)(nil),             // 6: Timetable
+	(*TimetableStop)(nil),         // 7: TimetableStop
+	(*TimetableException)(nil),    // 8: TimetableException
+	(*TrainFilter)(nil),           // 9: TrainFilter
+	(*Seat)(nil),                  // 10: Seat
+	(*SeatMap)(nil),               // 11: SeatMap
+	(*SeatMapCoach)(nil),          // 12: SeatMapCoach
+	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
 }
 var file_train_proto_depIdxs = []int32{
-	2, // 0: Train.seats:type_name -> Seat
-	3, // 1: Train.departure_time:type_name -> google.protobuf.Timestamp
-	3, // 2: Train.arrival_time:type_name -> google.protobuf.Timestamp
-	3, // 3: TrainFilter.departure_time:type_name -> google.protobuf.Timestamp
-	3, // 4: TrainFilter.arrival_time:type_name -> google.protobuf.Timestamp
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	10, // 0: Train.seats:type_name -> Seat
+	13, // 1: Train.departure_time:type_name -> google.protobuf.Timestamp
+	13, // 2: Train.arrival_time:type_name -> google.protobuf.Timestamp
+	5,  // 3: Train.stops:type_name -> Stop
+	13, // 4: Train.service_date:type_name -> google.protobuf.Timestamp
+	1,  // 5: Train.coaches:type_name -> Coach
+	3,  // 6: LayoutTemplate.coaches:type_name -> CoachTemplate
+	4,  // 7: CoachTemplate.seats:type_name -> SeatTemplate
+	13, // 8: Stop.arrival_time:type_name -> google.protobuf.Timestamp
+	13, // 9: Stop.departure_time:type_name -> google.protobuf.Timestamp
+	13, // 10: Timetable.valid_from:type_name -> google.protobuf.Timestamp
+	13, // 11: Timetable.valid_until:type_name -> google.protobuf.Timestamp
+	7,  // 12: Timetable.stops:type_name -> TimetableStop
+	8,  // 13: Timetable.exceptions:type_name -> TimetableException
+	13, // 14: TimetableException.date:type_name -> google.protobuf.Timestamp
+	13, // 15: TrainFilter.departure_from:type_name -> google.protobuf.Timestamp
+	13, // 16: TrainFilter.departure_to:type_name -> google.protobuf.Timestamp
+	13, // 17: TrainFilter.arrival_from:type_name -> google.protobuf.Timestamp
+	13, // 18: TrainFilter.arrival_to:type_name -> google.protobuf.Timestamp
+	0,  // 19: SeatMap.train:type_name -> Train
+	12, // 20: SeatMap.coaches:type_name -> SeatMapCoach
+	10, // 21: SeatMap.seats:type_name -> Seat
+	1,  // 22: SeatMapCoach.coach:type_name -> Coach
+	10, // 23: SeatMapCoach.seats:type_name -> Seat
+	24, // [24:24] is the sub-list for method output_type
+	24, // [24:24] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_train_proto_init() }
@@ -394,7 +1490,7 @@ func file_train_proto_init() {
 			}
 		}
 		file_train_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*TrainFilter); i {
+			switch v := v.(*Coach); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -406,6 +1502,102 @@ func file_train_proto_init() {
 			}
 		}
 		file_train_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*LayoutTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_train_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*CoachTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_train_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*SeatTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_train_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*Stop); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_train_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*Timetable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_train_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*TimetableStop); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_train_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*TimetableException); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_train_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*TrainFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_train_proto_msgTypes[10].Exporter = func(v any, i int) any {
 			switch v := v.(*Seat); i {
 			case 0:
 				return &v.state
@@ -417,6 +1609,30 @@ func file_train_proto_init() {
 				return nil
 			}
 		}
+		file_train_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*SeatMap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_train_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*SeatMapCoach); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -424,7 +1640,7 @@ func file_train_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_train_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -26,7 +26,8 @@ type ListTrainsReplay struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Trains []*Train `protobuf:"bytes,1,rep,name=trains,proto3" json:"trains,omitempty"`
+	Trains     []*Train `protobuf:"bytes,1,rep,name=trains,proto3" json:"trains,omitempty"`
+	NextCursor string   `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 }
 
 func (x *ListTrainsReplay) Reset() {
@@ -68,6 +69,107 @@ func (x *ListTrainsReplay) GetTrains() []*Train {
 	return nil
 }
 
+func (x *ListTrainsReplay) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ListTimetablesReplay struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timetables []*Timetable `protobuf:"bytes,1,rep,name=timetables,proto3" json:"timetables,omitempty"`
+}
+
+func (x *ListTimetablesReplay) Reset() {
+	*x = ListTimetablesReplay{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_train_req_rep_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTimetablesReplay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTimetablesReplay) ProtoMessage() {}
+
+func (x *ListTimetablesReplay) ProtoReflect() protoreflect.Message {
+	mi := &file_train_req_rep_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTimetablesReplay.ProtoReflect.Descriptor instead.
+func (*ListTimetablesReplay) Descriptor() ([]byte, []int) {
+	return file_train_req_rep_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListTimetablesReplay) GetTimetables() []*Timetable {
+	if x != nil {
+		return x.Timetables
+	}
+	return nil
+}
+
+type ListLayoutTemplatesReplay struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LayoutTemplates []*LayoutTemplate `protobuf:"bytes,1,rep,name=layout_templates,json=layoutTemplates,proto3" json:"layout_templates,omitempty"`
+}
+
+func (x *ListLayoutTemplatesReplay) Reset() {
+	*x = ListLayoutTemplatesReplay{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_train_req_rep_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLayoutTemplatesReplay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLayoutTemplatesReplay) ProtoMessage() {}
+
+func (x *ListLayoutTemplatesReplay) ProtoReflect() protoreflect.Message {
+	mi := &file_train_req_rep_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLayoutTemplatesReplay.ProtoReflect.Descriptor instead.
+func (*ListLayoutTemplatesReplay) Descriptor() ([]byte, []int) {
+	return file_train_req_rep_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListLayoutTemplatesReplay) GetLayoutTemplates() []*LayoutTemplate {
+	if x != nil {
+		return x.LayoutTemplates
+	}
+	return nil
+}
+
 type ListSeatsReplay struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -79,7 +181,7 @@ type ListSeatsReplay struct {
 func (x *ListSeatsReplay) Reset() {
 	*x = ListSeatsReplay{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_train_req_rep_proto_msgTypes[1]
+		mi := &file_train_req_rep_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -92,7 +194,7 @@ func (x *ListSeatsReplay) String() string {
 func (*ListSeatsReplay) ProtoMessage() {}
 
 func (x *ListSeatsReplay) ProtoReflect() protoreflect.Message {
-	mi := &file_train_req_rep_proto_msgTypes[1]
+	mi := &file_train_req_rep_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -105,7 +207,7 @@ func (x *ListSeatsReplay) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSeatsReplay.ProtoReflect.Descriptor instead.
 func (*ListSeatsReplay) Descriptor() ([]byte, []int) {
-	return file_train_req_rep_proto_rawDescGZIP(), []int{1}
+	return file_train_req_rep_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ListSeatsReplay) GetSeats() []*Seat {
@@ -120,14 +222,15 @@ type CreateTrainRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Capacity uint32 `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Name             string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity         uint32 `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	LayoutTemplateId uint32 `protobuf:"varint,3,opt,name=layout_template_id,json=layoutTemplateId,proto3" json:"layout_template_id,omitempty"`
 }
 
 func (x *CreateTrainRequest) Reset() {
 	*x = CreateTrainRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_train_req_rep_proto_msgTypes[2]
+		mi := &file_train_req_rep_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -140,7 +243,7 @@ func (x *CreateTrainRequest) String() string {
 func (*CreateTrainRequest) ProtoMessage() {}
 
 func (x *CreateTrainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_train_req_rep_proto_msgTypes[2]
+	mi := &file_train_req_rep_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -153,7 +256,7 @@ func (x *CreateTrainRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTrainRequest.ProtoReflect.Descriptor instead.
 func (*CreateTrainRequest) Descriptor() ([]byte, []int) {
-	return file_train_req_rep_proto_rawDescGZIP(), []int{2}
+	return file_train_req_rep_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateTrainRequest) GetName() string {
@@ -170,6 +273,13 @@ func (x *CreateTrainRequest) GetCapacity() uint32 {
 	return 0
 }
 
+func (x *CreateTrainRequest) GetLayoutTemplateId() uint32 {
+	if x != nil {
+		return x.LayoutTemplateId
+	}
+	return 0
+}
+
 type UpdateTrainNameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -182,7 +292,7 @@ type UpdateTrainNameRequest struct {
 func (x *UpdateTrainNameRequest) Reset() {
 	*x = UpdateTrainNameRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_train_req_rep_proto_msgTypes[3]
+		mi := &file_train_req_rep_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -195,7 +305,7 @@ func (x *UpdateTrainNameRequest) String() string {
 func (*UpdateTrainNameRequest) ProtoMessage() {}
 
 func (x *UpdateTrainNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_train_req_rep_proto_msgTypes[3]
+	mi := &file_train_req_rep_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -208,7 +318,7 @@ func (x *UpdateTrainNameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTrainNameRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTrainNameRequest) Descriptor() ([]byte, []int) {
-	return file_train_req_rep_proto_rawDescGZIP(), []int{3}
+	return file_train_req_rep_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UpdateTrainNameRequest) GetID() uint32 {
@@ -235,12 +345,13 @@ type UpdateTrainTravelDetailsRequest struct {
 	Destination   string                 `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
 	DepartureTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
 	ArrivalTime   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=arrival_time,json=arrivalTime,proto3" json:"arrival_time,omitempty"`
+	Price         int64                  `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
 }
 
 func (x *UpdateTrainTravelDetailsRequest) Reset() {
 	*x = UpdateTrainTravelDetailsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_train_req_rep_proto_msgTypes[4]
+		mi := &file_train_req_rep_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -253,7 +364,7 @@ func (x *UpdateTrainTravelDetailsRequest) String() string {
 func (*UpdateTrainTravelDetailsRequest) ProtoMessage() {}
 
 func (x *UpdateTrainTravelDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_train_req_rep_proto_msgTypes[4]
+	mi := &file_train_req_rep_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -266,7 +377,7 @@ func (x *UpdateTrainTravelDetailsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTrainTravelDetailsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTrainTravelDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_train_req_rep_proto_rawDescGZIP(), []int{4}
+	return file_train_req_rep_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateTrainTravelDetailsRequest) GetID() uint32 {
@@ -304,6 +415,194 @@ func (x *UpdateTrainTravelDetailsRequest) GetArrivalTime() *timestamppb.Timestam
 	return nil
 }
 
+func (x *UpdateTrainTravelDetailsRequest) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type UpdateTrainStopsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrainId uint32  `protobuf:"varint,1,opt,name=train_id,json=trainId,proto3" json:"train_id,omitempty"`
+	Stops   []*Stop `protobuf:"bytes,2,rep,name=stops,proto3" json:"stops,omitempty"`
+}
+
+func (x *UpdateTrainStopsRequest) Reset() {
+	*x = UpdateTrainStopsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_train_req_rep_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTrainStopsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTrainStopsRequest) ProtoMessage() {}
+
+func (x *UpdateTrainStopsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_train_req_rep_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTrainStopsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTrainStopsRequest) Descriptor() ([]byte, []int) {
+	return file_train_req_rep_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateTrainStopsRequest) GetTrainId() uint32 {
+	if x != nil {
+		return x.TrainId
+	}
+	return 0
+}
+
+func (x *UpdateTrainStopsRequest) GetStops() []*Stop {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+type TrainSegmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrainId     uint32 `protobuf:"varint,1,opt,name=train_id,json=trainId,proto3" json:"train_id,omitempty"`
+	Origin      string `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+}
+
+func (x *TrainSegmentRequest) Reset() {
+	*x = TrainSegmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_train_req_rep_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrainSegmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrainSegmentRequest) ProtoMessage() {}
+
+func (x *TrainSegmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_train_req_rep_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrainSegmentRequest.ProtoReflect.Descriptor instead.
+func (*TrainSegmentRequest) Descriptor() ([]byte, []int) {
+	return file_train_req_rep_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TrainSegmentRequest) GetTrainId() uint32 {
+	if x != nil {
+		return x.TrainId
+	}
+	return 0
+}
+
+func (x *TrainSegmentRequest) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *TrainSegmentRequest) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+type ListSegmentSeatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrainId  uint32 `protobuf:"varint,1,opt,name=train_id,json=trainId,proto3" json:"train_id,omitempty"`
+	FromStop uint32 `protobuf:"varint,2,opt,name=from_stop,json=fromStop,proto3" json:"from_stop,omitempty"`
+	ToStop   uint32 `protobuf:"varint,3,opt,name=to_stop,json=toStop,proto3" json:"to_stop,omitempty"`
+}
+
+func (x *ListSegmentSeatsRequest) Reset() {
+	*x = ListSegmentSeatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_train_req_rep_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSegmentSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSegmentSeatsRequest) ProtoMessage() {}
+
+func (x *ListSegmentSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_train_req_rep_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSegmentSeatsRequest.ProtoReflect.Descriptor instead.
+func (*ListSegmentSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_train_req_rep_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListSegmentSeatsRequest) GetTrainId() uint32 {
+	if x != nil {
+		return x.TrainId
+	}
+	return 0
+}
+
+func (x *ListSegmentSeatsRequest) GetFromStop() uint32 {
+	if x != nil {
+		return x.FromStop
+	}
+	return 0
+}
+
+func (x *ListSegmentSeatsRequest) GetToStop() uint32 {
+	if x != nil {
+		return x.ToStop
+	}
+	return 0
+}
+
 type CreateSeatRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -311,12 +610,13 @@ type CreateSeatRequest struct {
 
 	TrainId uint32 `protobuf:"varint,1,opt,name=train_id,json=trainId,proto3" json:"train_id,omitempty"`
 	Number  uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	Class   string `protobuf:"bytes,3,opt,name=class,proto3" json:"class,omitempty"`
 }
 
 func (x *CreateSeatRequest) Reset() {
 	*x = CreateSeatRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_train_req_rep_proto_msgTypes[5]
+		mi := &file_train_req_rep_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -329,7 +629,7 @@ func (x *CreateSeatRequest) String() string {
 func (*CreateSeatRequest) ProtoMessage() {}
 
 func (x *CreateSeatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_train_req_rep_proto_msgTypes[5]
+	mi := &file_train_req_rep_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -342,7 +642,7 @@ func (x *CreateSeatRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSeatRequest.ProtoReflect.Descriptor instead.
 func (*CreateSeatRequest) Descriptor() ([]byte, []int) {
-	return file_train_req_rep_proto_rawDescGZIP(), []int{5}
+	return file_train_req_rep_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CreateSeatRequest) GetTrainId() uint32 {
@@ -359,6 +659,13 @@ func (x *CreateSeatRequest) GetNumber() uint32 {
 	return 0
 }
 
+func (x *CreateSeatRequest) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
 type UpdateSeatNumberRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -371,7 +678,7 @@ type UpdateSeatNumberRequest struct {
 func (x *UpdateSeatNumberRequest) Reset() {
 	*x = UpdateSeatNumberRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_train_req_rep_proto_msgTypes[6]
+		mi := &file_train_req_rep_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -384,7 +691,7 @@ func (x *UpdateSeatNumberRequest) String() string {
 func (*UpdateSeatNumberRequest) ProtoMessage() {}
 
 func (x *UpdateSeatNumberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_train_req_rep_proto_msgTypes[6]
+	mi := &file_train_req_rep_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -397,7 +704,7 @@ func (x *UpdateSeatNumberRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSeatNumberRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSeatNumberRequest) Descriptor() ([]byte, []int) {
-	return file_train_req_rep_proto_rawDescGZIP(), []int{6}
+	return file_train_req_rep_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateSeatNumberRequest) GetID() uint32 {
@@ -414,6 +721,93 @@ func (x *UpdateSeatNumberRequest) GetNumber() uint32 {
 	return 0
 }
 
+type HoldSeatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TrainId   uint32                 `protobuf:"varint,2,opt,name=train_id,json=trainId,proto3" json:"train_id,omitempty"`
+	SeatIds   []uint32               `protobuf:"varint,3,rep,packed,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	HeldUntil *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=held_until,json=heldUntil,proto3" json:"held_until,omitempty"`
+	FromStop  uint32                 `protobuf:"varint,5,opt,name=from_stop,json=fromStop,proto3" json:"from_stop,omitempty"`
+	ToStop    uint32                 `protobuf:"varint,6,opt,name=to_stop,json=toStop,proto3" json:"to_stop,omitempty"`
+}
+
+func (x *HoldSeatsRequest) Reset() {
+	*x = HoldSeatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_train_req_rep_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HoldSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldSeatsRequest) ProtoMessage() {}
+
+func (x *HoldSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_train_req_rep_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldSeatsRequest.ProtoReflect.Descriptor instead.
+func (*HoldSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_train_req_rep_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *HoldSeatsRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *HoldSeatsRequest) GetTrainId() uint32 {
+	if x != nil {
+		return x.TrainId
+	}
+	return 0
+}
+
+func (x *HoldSeatsRequest) GetSeatIds() []uint32 {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *HoldSeatsRequest) GetHeldUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HeldUntil
+	}
+	return nil
+}
+
+func (x *HoldSeatsRequest) GetFromStop() uint32 {
+	if x != nil {
+		return x.FromStop
+	}
+	return 0
+}
+
+func (x *HoldSeatsRequest) GetToStop() uint32 {
+	if x != nil {
+		return x.ToStop
+	}
+	return 0
+}
+
 var File_train_req_rep_proto protoreflect.FileDescriptor
 
 var file_train_req_rep_proto_rawDesc = []byte{
@@ -421,46 +815,95 @@ var file_train_req_rep_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x32, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6e,
+	0x6f, 0x74, 0x6f, 0x22, 0x53, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6e,
 	0x73, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x1e, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x69, 0x6e,
 	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x52,
-	0x06, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x2e, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x53,
-	0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x1b, 0x0a, 0x05, 0x73, 0x65,
-	0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x53, 0x65, 0x61, 0x74,
-	0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x22, 0x44, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x22, 0x3c, 0x0a,
-	0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xed, 0x01, 0x0a, 0x1f,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x76, 0x65,
-	0x6c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x49, 0x44, 0x12,
-	0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x0e, 0x64, 0x65, 0x70,
-	0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x06, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65,
+	0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x42, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x12, 0x2a, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x57, 0x0a, 0x19,
+	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x3a, 0x0a, 0x10, 0x6c, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x0f, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x22, 0x2e, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x1b, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x05,
+	0x73, 0x65, 0x61, 0x74, 0x73, 0x22, 0x72, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x6c,
+	0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x3c, 0x0a, 0x16, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x83, 0x02, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x72, 0x72, 0x69,
+	0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x69,
+	0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x51, 0x0a,
+	0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x70,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x70, 0x73,
+	0x22, 0x6a, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6a, 0x0a, 0x17,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x6f, 0x70, 0x12,
+	0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x74, 0x6f, 0x53, 0x74, 0x6f, 0x70, 0x22, 0x5c, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x22, 0x41, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xd2, 0x01, 0x0a, 0x10, 0x48, 0x6f,
+	0x6c, 0x64, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x65, 0x61, 0x74, 0x49, 0x64, 0x73, 0x12, 0x39, 0x0a,
+	0x0a, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x64,
-	0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0c,
-	0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
-	0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x46, 0x0a, 0x11, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x07, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x22, 0x41, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x61,
-	0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16,
-	0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f, 0x67, 0x65, 0x6e, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x68,
+	0x65, 0x6c, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x66, 0x72, 0x6f,
+	0x6d, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x6f, 0x70,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x74, 0x6f, 0x53, 0x74, 0x6f, 0x70, 0x42, 0x07,
+	0x5a, 0x05, 0x2e, 0x2f, 0x67, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -475,29 +918,42 @@ func file_train_req_rep_proto_rawDescGZIP() []byte {
 	return file_train_req_rep_proto_rawDescData
 }
 
-var file_train_req_rep_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_train_req_rep_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_train_req_rep_proto_goTypes = []any{
 	(*ListTrainsReplay)(nil),                // 0: ListTrainsReplay
-	(*ListSeatsReplay)(nil),                 // 1: ListSeatsReplay
-	(*CreateTrainRequest)(nil),              // 2: CreateTrainRequest
-	(*UpdateTrainNameRequest)(nil),          // 3: UpdateTrainNameRequest
-	(*UpdateTrainTravelDetailsRequest)(nil), // 4: UpdateTrainTravelDetailsRequest
-	(*CreateSeatRequest)(nil),               // 5: CreateSeatRequest
-	(*UpdateSeatNumberRequest)(nil),         // 6: UpdateSeatNumberRequest
-	(*Train)(nil),                           // 7: Train
-	(*Seat)(nil),                            // 8: Seat
-	(*timestamppb.Timestamp)(nil),           // 9: google.protobuf.Timestamp
+	(*ListTimetablesReplay)(nil),            // 1: ListTimetablesReplay
+	(*ListLayoutTemplatesReplay)(nil),       // 2: ListLayoutTemplatesReplay
+	(*ListSeatsReplay)(nil),                 // 3: ListSeatsReplay
+	(*CreateTrainRequest)(nil),              // 4: CreateTrainRequest
+	(*UpdateTrainNameRequest)(nil),          // 5: UpdateTrainNameRequest
+	(*UpdateTrainTravelDetailsRequest)(nil), // 6: UpdateTrainTravelDetailsRequest
+	(*UpdateTrainStopsRequest)(nil),         // 7: UpdateTrainStopsRequest
+	(*TrainSegmentRequest)(nil),             // 8: TrainSegmentRequest
+	(*ListSegmentSeatsRequest)(nil),         // 9: ListSegmentSeatsRequest
+	(*CreateSeatRequest)(nil),               // 10: CreateSeatRequest
+	(*UpdateSeatNumberRequest)(nil),         // 11: UpdateSeatNumberRequest
+	(*HoldSeatsRequest)(nil),                // 12: HoldSeatsRequest
+	(*Train)(nil),                           // 13: Train
+	(*Timetable)(nil),                       // 14: Timetable
+	(*LayoutTemplate)(nil),                  // 15: LayoutTemplate
+	(*Seat)(nil),                            // 16: Seat
+	(*timestamppb.Timestamp)(nil),           // 17: google.protobuf.Timestamp
+	(*Stop)(nil),                            // 18: Stop
 }
 var file_train_req_rep_proto_depIdxs = []int32{
-	7, // 0: ListTrainsReplay.trains:type_name -> Train
-	8, // 1: ListSeatsReplay.seats:type_name -> Seat
-	9, // 2: UpdateTrainTravelDetailsRequest.departure_time:type_name -> google.protobuf.Timestamp
-	9, // 3: UpdateTrainTravelDetailsRequest.arrival_time:type_name -> google.protobuf.Timestamp
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	13, // 0: ListTrainsReplay.trains:type_name -> Train
+	14, // 1: ListTimetablesReplay.timetables:type_name -> Timetable
+	15, // 2: ListLayoutTemplatesReplay.layout_templates:type_name -> LayoutTemplate
+	16, // 3: ListSeatsReplay.seats:type_name -> Seat
+	17, // 4: UpdateTrainTravelDetailsRequest.departure_time:type_name -> google.protobuf.Timestamp
+	17, // 5: UpdateTrainTravelDetailsRequest.arrival_time:type_name -> google.protobuf.Timestamp
+	18, // 6: UpdateTrainStopsRequest.stops:type_name -> Stop
+	17, // 7: HoldSeatsRequest.held_until:type_name -> google.protobuf.Timestamp
+	8,  // [8:8] is the sub-list for method output_type
+	8,  // [8:8] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_train_req_rep_proto_init() }
@@ -520,7 +976,7 @@ func file_train_req_rep_proto_init() {
 			}
 		}
 		file_train_req_rep_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*ListSeatsReplay); i {
+			switch v := v.(*ListTimetablesReplay); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -532,7 +988,7 @@ func file_train_req_rep_proto_init() {
 			}
 		}
 		file_train_req_rep_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateTrainRequest); i {
+			switch v := v.(*ListLayoutTemplatesReplay); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -544,7 +1000,7 @@ func file_train_req_rep_proto_init() {
 			}
 		}
 		file_train_req_rep_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateTrainNameRequest); i {
+			switch v := v.(*ListSeatsReplay); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -556,7 +1012,7 @@ func file_train_req_rep_proto_init() {
 			}
 		}
 		file_train_req_rep_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateTrainTravelDetailsRequest); i {
+			switch v := v.(*CreateTrainRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -568,7 +1024,7 @@ func file_train_req_rep_proto_init() {
 			}
 		}
 		file_train_req_rep_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateSeatRequest); i {
+			switch v := v.(*UpdateTrainNameRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -580,6 +1036,66 @@ func file_train_req_rep_proto_init() {
 			}
 		}
 		file_train_req_rep_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateTrainTravelDetailsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_train_req_rep_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateTrainStopsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_train_req_rep_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*TrainSegmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_train_req_rep_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ListSegmentSeatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_train_req_rep_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateSeatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_train_req_rep_proto_msgTypes[11].Exporter = func(v any, i int) any {
 			switch v := v.(*UpdateSeatNumberRequest); i {
 			case 0:
 				return &v.state
@@ -591,6 +1107,18 @@ func file_train_req_rep_proto_init() {
 				return nil
 			}
 		}
+		file_train_req_rep_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*HoldSeatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -598,7 +1126,7 @@ func file_train_req_rep_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_train_req_rep_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"gateway/events"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type BookingHandler struct {
+	requestHandler events.RequestSender
+}
+
+type CancelBookingRequest struct {
+	TicketIDs []uint `json:"ticket_ids"`
+}
+
+func NewBookingHandler(requestSender events.RequestSender) *BookingHandler {
+	return &BookingHandler{
+		requestHandler: requestSender,
+	}
+}
+
+func (h *BookingHandler) GetBookingByReference(ctx *fiber.Ctx) error {
+	reference := ctx.Params("reference")
+
+	booking, err := h.requestHandler.GetBookingByReference(ctx.Context(), reference)
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to get booking: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(booking)
+}
+
+// CancelBooking cancels the selected tickets of a booking, or every ticket
+// of it when the body lists none.
+func (h *BookingHandler) CancelBooking(ctx *fiber.Ctx) error {
+	var cancelBookingRequest = &CancelBookingRequest{}
+
+	if len(ctx.Body()) > 0 {
+		err := ctx.BodyParser(cancelBookingRequest)
+		if err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Failed to parse request body: " + err.Error(),
+			})
+		}
+	}
+
+	cancellation, err := h.requestHandler.CancelBooking(ctx.Context(), ctx.Get(idempotencyKeyHeader), ctx.Params("reference"), cancelBookingRequest.TicketIDs)
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to cancel booking: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(cancellation)
+}
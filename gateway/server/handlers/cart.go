@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"gateway/events"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CartHandler struct {
+	requestHandler events.RequestSender
+}
+
+type CreateCartRequest struct {
+	UserID uint `json:"user_id"`
+}
+
+type AddCartLegRequest struct {
+	TrainID      uint `json:"train_id"`
+	TicketNumber uint `json:"ticket_number"`
+}
+
+// CheckoutCartRequest names the passengers travelling on every leg of the
+// cart. Without passengers the tickets are booked without their details.
+type CheckoutCartRequest struct {
+	Passengers []PassengerRequest `json:"passengers"`
+}
+
+func NewCartHandler(requestSender events.RequestSender) *CartHandler {
+	return &CartHandler{
+		requestHandler: requestSender,
+	}
+}
+
+func (h *CartHandler) CreateCart(ctx *fiber.Ctx) error {
+	var createCartRequest = &CreateCartRequest{}
+	err := ctx.BodyParser(createCartRequest)
+
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Failed to parse request body: " + err.Error(),
+		})
+	}
+
+	cart, err := h.requestHandler.CreateCart(ctx.Context(), ctx.Get(idempotencyKeyHeader), createCartRequest.UserID)
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to create cart: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(cart)
+}
+
+func (h *CartHandler) GetCart(ctx *fiber.Ctx) error {
+	ID, err := ctx.ParamsInt("id")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid ID: " + err.Error(),
+		})
+	}
+
+	cart, err := h.requestHandler.GetCart(ctx.Context(), uint(ID))
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to get cart: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(cart)
+}
+
+func (h *CartHandler) AddCartLeg(ctx *fiber.Ctx) error {
+	ID, err := ctx.ParamsInt("id")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid ID: " + err.Error(),
+		})
+	}
+
+	var addCartLegRequest = &AddCartLegRequest{}
+	err = ctx.BodyParser(addCartLegRequest)
+
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Failed to parse request body: " + err.Error(),
+		})
+	}
+
+	cart, err := h.requestHandler.AddCartLeg(ctx.Context(), uint(ID), addCartLegRequest.TrainID, addCartLegRequest.TicketNumber)
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to add cart leg: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(cart)
+}
+
+func (h *CartHandler) RemoveCartLeg(ctx *fiber.Ctx) error {
+	ID, err := ctx.ParamsInt("id")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid ID: " + err.Error(),
+		})
+	}
+
+	legID, err := ctx.ParamsInt("legID")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid leg ID: " + err.Error(),
+		})
+	}
+
+	cart, err := h.requestHandler.RemoveCartLeg(ctx.Context(), uint(ID), uint(legID))
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to remove cart leg: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(cart)
+}
+
+// CheckoutCart books every leg of the cart as one booking, or none of them.
+func (h *CartHandler) CheckoutCart(ctx *fiber.Ctx) error {
+	ID, err := ctx.ParamsInt("id")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid ID: " + err.Error(),
+		})
+	}
+
+	var checkoutCartRequest = &CheckoutCartRequest{}
+
+	if len(ctx.Body()) > 0 {
+		err := ctx.BodyParser(checkoutCartRequest)
+		if err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Failed to parse request body: " + err.Error(),
+			})
+		}
+	}
+
+	passengers, err := passengersToDTO(checkoutCartRequest.Passengers)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid passenger date of birth: " + err.Error(),
+		})
+	}
+
+	booking, err := h.requestHandler.CheckoutCart(ctx.Context(), ctx.Get(idempotencyKeyHeader), uint(ID), passengers)
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to checkout cart: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(booking)
+}
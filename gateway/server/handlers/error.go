@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"errors"
+	"gateway/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func errorStatus(err error) int {
+	if errors.Is(err, utils.ErrConflict) {
+		return fiber.StatusConflict
+	}
+
+	if errors.Is(err, utils.ErrNotAllowed) {
+		return fiber.StatusUnprocessableEntity
+	}
+
+	return fiber.StatusInternalServerError
+}
@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+const eTicketQRCodeSize = 320
+
+type VerifyETicketRequest struct {
+	Token string `json:"token"`
+}
+
+func (h *TicketHandler) GetETicket(ctx *fiber.Ctx) error {
+	ID, err := ctx.ParamsInt("id")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid ID: " + err.Error(),
+		})
+	}
+
+	token, err := h.requestHandler.IssueETicket(ctx.Context(), uint(ID))
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to issue e-ticket: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"ticket_id": ID,
+		"token":     token,
+	})
+}
+
+// GetETicketQRCode renders the e-ticket token as a PNG QR code for the
+// passenger to show on board.
+func (h *TicketHandler) GetETicketQRCode(ctx *fiber.Ctx) error {
+	ID, err := ctx.ParamsInt("id")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid ID: " + err.Error(),
+		})
+	}
+
+	token, err := h.requestHandler.IssueETicket(ctx.Context(), uint(ID))
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to issue e-ticket: " + err.Error(),
+		})
+	}
+
+	png, err := qrcode.Encode(token, qrcode.Medium, eTicketQRCodeSize)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to render e-ticket QR code: " + err.Error(),
+		})
+	}
+
+	ctx.Set(fiber.HeaderContentType, "image/png")
+
+	return ctx.Status(fiber.StatusOK).Send(png)
+}
+
+// GetTicketPDF serves a printable copy of the ticket with its e-ticket QR
+// code.
+func (h *TicketHandler) GetTicketPDF(ctx *fiber.Ctx) error {
+	ID, err := ctx.ParamsInt("id")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid ID: " + err.Error(),
+		})
+	}
+
+	document, err := h.requestHandler.RenderTicketPDF(ctx.Context(), uint(ID))
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to render ticket pdf: " + err.Error(),
+		})
+	}
+
+	ctx.Set(fiber.HeaderContentType, "application/pdf")
+	ctx.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`inline; filename="ticket-%d.pdf"`, ID))
+
+	return ctx.Status(fiber.StatusOK).Send(document)
+}
+
+// VerifyETicket is called by conductors with a scanned token. A valid token
+// checks the ticket in, so scanning it again is rejected.
+func (h *TicketHandler) VerifyETicket(ctx *fiber.Ctx) error {
+	var verifyETicketRequest = &VerifyETicketRequest{}
+	err := ctx.BodyParser(verifyETicketRequest)
+
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Failed to parse request body: " + err.Error(),
+		})
+	}
+
+	ticket, err := h.requestHandler.VerifyETicket(ctx.Context(), verifyETicketRequest.Token)
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to verify e-ticket: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "ticket checked in successfully",
+		"ticket":  ticket,
+	})
+}
+
+// GetETicketPublicKey returns the key conductor devices cache to check
+// token signatures while offline.
+func (h *TicketHandler) GetETicketPublicKey(ctx *fiber.Ctx) error {
+	publicKey, err := h.requestHandler.GetETicketPublicKey(ctx.Context())
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to get e-ticket public key: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"algorithm":  "Ed25519",
+		"public_key": publicKey,
+	})
+}
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// ExchangeTicketRequest names the target train and optionally a seat of it,
+// by ID or by number.
+type ExchangeTicketRequest struct {
+	TrainID    uint `json:"train_id"`
+	SeatID     uint `json:"seat_id"`
+	SeatNumber uint `json:"seat_number"`
+}
+
+func (h *TicketHandler) ExchangeTicket(ctx *fiber.Ctx) error {
+	ID, err := ctx.ParamsInt("id")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid ID: " + err.Error(),
+		})
+	}
+
+	var exchangeTicketRequest = &ExchangeTicketRequest{}
+	err = ctx.BodyParser(exchangeTicketRequest)
+
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Failed to parse request body: " + err.Error(),
+		})
+	}
+
+	exchange, err := h.requestHandler.ExchangeTicket(ctx.Context(), ctx.Get(idempotencyKeyHeader), uint(ID), exchangeTicketRequest.TrainID, exchangeTicketRequest.SeatID, exchangeTicketRequest.SeatNumber)
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to exchange ticket: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(exchange)
+}
@@ -0,0 +1,5 @@
+package handlers
+
+// idempotencyKeyHeader lets clients retry a request without repeating
+// its effect.
+const idempotencyKeyHeader = "Idempotency-Key"
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	dto "gateway/DTO"
+	"gateway/events"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type LayoutTemplateHandler struct {
+	requestHandler events.RequestSender
+}
+
+func NewLayoutTemplateHandler(requestSender events.RequestSender) *LayoutTemplateHandler {
+	return &LayoutTemplateHandler{
+		requestHandler: requestSender,
+	}
+}
+
+// LayoutTemplateRequest is a line-up of coaches in train order. Each coach
+// has a class, first, second or sleeper, and a grid of rows by columns
+// cells its seats are placed on, counted from 1.
+type LayoutTemplateRequest struct {
+	Name    string                 `json:"name"`
+	Coaches []CoachTemplateRequest `json:"coaches"`
+}
+
+type CoachTemplateRequest struct {
+	Class   string                `json:"class"`
+	Rows    uint                  `json:"rows"`
+	Columns uint                  `json:"columns"`
+	Seats   []SeatTemplateRequest `json:"seats"`
+}
+
+// SeatTemplateRequest places a seat on the grid. Attributes are any of
+// window, aisle, table, power_socket and accessible.
+type SeatTemplateRequest struct {
+	Row        uint     `json:"row"`
+	Column     uint     `json:"column"`
+	Attributes []string `json:"attributes"`
+}
+
+func (r *LayoutTemplateRequest) toDTO() *dto.LayoutTemplateDTO {
+	layout := &dto.LayoutTemplateDTO{
+		Name: r.Name,
+	}
+
+	for _, coach := range r.Coaches {
+		coachDTO := dto.CoachTemplateDTO{
+			Class:   coach.Class,
+			Rows:    coach.Rows,
+			Columns: coach.Columns,
+		}
+
+		for _, seat := range coach.Seats {
+			coachDTO.Seats = append(coachDTO.Seats, dto.SeatTemplateDTO{
+				Row:        seat.Row,
+				Column:     seat.Column,
+				Attributes: seat.Attributes,
+			})
+		}
+
+		layout.Coaches = append(layout.Coaches, coachDTO)
+	}
+
+	return layout
+}
+
+func (h *LayoutTemplateHandler) ListLayoutTemplates(ctx *fiber.Ctx) error {
+	layouts, err := h.requestHandler.ListLayoutTemplates(ctx.Context())
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to list layout templates: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(layouts)
+}
+
+func (h *LayoutTemplateHandler) GetLayoutTemplateByID(ctx *fiber.Ctx) error {
+	ID, err := ctx.ParamsInt("id")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid layout template ID: " + err.Error(),
+		})
+	}
+
+	layout, err := h.requestHandler.GetLayoutTemplateByID(ctx.Context(), uint(ID))
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to get layout template: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(layout)
+}
+
+// CreateLayoutTemplate saves a layout trains and timetables can be created
+// from.
+func (h *LayoutTemplateHandler) CreateLayoutTemplate(ctx *fiber.Ctx) error {
+	var layoutRequest = &LayoutTemplateRequest{}
+	err := ctx.BodyParser(layoutRequest)
+
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Failed to parse request body: " + err.Error(),
+		})
+	}
+
+	layout, err := h.requestHandler.CreateLayoutTemplate(ctx.Context(), layoutRequest.toDTO())
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to create layout template: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(layout)
+}
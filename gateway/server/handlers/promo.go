@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	dto "gateway/DTO"
+	"gateway/events"
+	"gateway/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PromoCodeHandler struct {
+	requestHandler events.RequestSender
+}
+
+// CreatePromoCodeRequest takes a percentage from 1 to 100 or a fixed amount
+// in minor currency units as value. Times use the "2006-01-02 15:04:05"
+// layout, the code never expires without valid_until.
+type CreatePromoCodeRequest struct {
+	Code           string                    `json:"code"`
+	DiscountType   string                    `json:"discount_type"`
+	Value          int64                     `json:"value"`
+	MaxUses        uint                      `json:"max_uses"`
+	MaxUsesPerUser uint                      `json:"max_uses_per_user"`
+	ValidFrom      string                    `json:"valid_from"`
+	ValidUntil     string                    `json:"valid_until"`
+	Restrictions   []PromoRestrictionRequest `json:"restrictions"`
+}
+
+type PromoRestrictionRequest struct {
+	TrainID     uint   `json:"train_id"`
+	Origin      string `json:"origin"`
+	Destination string `json:"destination"`
+}
+
+func NewPromoCodeHandler(requestSender events.RequestSender) *PromoCodeHandler {
+	return &PromoCodeHandler{
+		requestHandler: requestSender,
+	}
+}
+
+func (r *CreatePromoCodeRequest) toDTO() (*dto.PromoCodeDTO, error) {
+	promo := &dto.PromoCodeDTO{
+		Code:           r.Code,
+		DiscountType:   r.DiscountType,
+		Value:          r.Value,
+		MaxUses:        r.MaxUses,
+		MaxUsesPerUser: r.MaxUsesPerUser,
+		Restrictions:   []dto.PromoRestrictionDTO{},
+	}
+
+	if r.ValidFrom != "" {
+		validFrom, err := utils.ParseTime(r.ValidFrom)
+		if err != nil {
+			return nil, err
+		}
+
+		promo.ValidFrom = *validFrom
+	}
+
+	if r.ValidUntil != "" {
+		validUntil, err := utils.ParseTime(r.ValidUntil)
+		if err != nil {
+			return nil, err
+		}
+
+		promo.ValidUntil = validUntil
+	}
+
+	for _, restriction := range r.Restrictions {
+		promo.Restrictions = append(promo.Restrictions, dto.PromoRestrictionDTO{
+			TrainID:     restriction.TrainID,
+			Origin:      restriction.Origin,
+			Destination: restriction.Destination,
+		})
+	}
+
+	return promo, nil
+}
+
+func (h *PromoCodeHandler) CreatePromoCode(ctx *fiber.Ctx) error {
+	var createPromoCodeRequest = &CreatePromoCodeRequest{}
+	err := ctx.BodyParser(createPromoCodeRequest)
+
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Failed to parse request body: " + err.Error(),
+		})
+	}
+
+	promo, err := createPromoCodeRequest.toDTO()
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid validity window: " + err.Error(),
+		})
+	}
+
+	promo, err = h.requestHandler.CreatePromoCode(ctx.Context(), promo)
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to create promo code: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(promo)
+}
+
+func (h *PromoCodeHandler) ListPromoCodes(ctx *fiber.Ctx) error {
+	promos, err := h.requestHandler.ListPromoCodes(ctx.Context())
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to list promo codes: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(promos)
+}
+
+func (h *PromoCodeHandler) GetPromoCode(ctx *fiber.Ctx) error {
+	promo, err := h.requestHandler.GetPromoCode(ctx.Context(), ctx.Params("code"))
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to get promo code: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(promo)
+}
+
+// DeactivatePromoCode stops further redemptions of the code.
+func (h *PromoCodeHandler) DeactivatePromoCode(ctx *fiber.Ctx) error {
+	err := h.requestHandler.DeactivatePromoCode(ctx.Context(), ctx.Params("code"))
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to deactivate promo code: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "promo code deactivated successfully",
+	})
+}
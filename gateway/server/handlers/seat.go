@@ -11,8 +11,9 @@ type SeatHandler struct {
 }
 
 type CreateSeatRequest struct {
-	TrainID    uint `json:"train_id"`
-	SeatNumber uint `json:"seat_number"`
+	TrainID    uint   `json:"train_id"`
+	SeatNumber uint   `json:"seat_number"`
+	Class      string `json:"class"`
 }
 
 type UpdateSeatRequest struct {
@@ -36,9 +37,9 @@ func (h *SeatHandler) CreateSeat(ctx *fiber.Ctx) error {
 		})
 	}
 
-	err = h.requestHandler.CreateSeat(ctx.Context(), createSeatRequest.TrainID, createSeatRequest.SeatNumber)
+	err = h.requestHandler.CreateSeat(ctx.Context(), createSeatRequest.TrainID, createSeatRequest.SeatNumber, createSeatRequest.Class)
 	if err != nil {
-		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
 			"error": "Failed to create seat: " + err.Error(),
 		})
 	}
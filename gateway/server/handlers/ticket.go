@@ -1,7 +1,9 @@
 package handlers
 
 import (
+	dto "gateway/DTO"
 	"gateway/events"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -17,9 +19,66 @@ func NewTicketHandler(requestHandler events.RequestSender) *TicketHandler {
 }
 
 type BookTicketRequest struct {
-	UserID       uint `json:"user_id"`
-	TrainID      uint `json:"train_id"`
-	TicketNumber uint `json:"ticket_number"`
+	UserID       uint               `json:"user_id"`
+	TrainID      uint               `json:"train_id"`
+	TicketNumber uint               `json:"ticket_number"`
+	SeatIDs      []uint             `json:"seat_ids"`
+	SeatNumbers  []uint             `json:"seat_numbers"`
+	Passengers   []PassengerRequest `json:"passengers"`
+	PromoCode    string             `json:"promo_code"`
+	Origin       string             `json:"origin"`      // Boarding station, the first stop when empty
+	Destination  string             `json:"destination"` // Alighting station, the last stop when empty
+	Class        string             `json:"class"`       // Seat class without chosen seats, any when empty
+}
+
+type PassengerRequest struct {
+	FirstName      string `json:"first_name"`
+	LastName       string `json:"last_name"`
+	DateOfBirth    string `json:"date_of_birth"`
+	DocumentType   string `json:"document_type"`
+	DocumentNumber string `json:"document_number"`
+	Category       string `json:"category"`
+}
+
+// toDTO converts the passenger, reading the date of birth as YYYY-MM-DD.
+func (r *PassengerRequest) toDTO() (*dto.PassengerDTO, error) {
+	passenger := &dto.PassengerDTO{
+		FirstName:      r.FirstName,
+		LastName:       r.LastName,
+		DocumentType:   r.DocumentType,
+		DocumentNumber: r.DocumentNumber,
+		Category:       r.Category,
+	}
+
+	if r.DateOfBirth != "" {
+		dateOfBirth, err := time.Parse(time.DateOnly, r.DateOfBirth)
+		if err != nil {
+			return nil, err
+		}
+
+		passenger.DateOfBirth = &dateOfBirth
+	}
+
+	return passenger, nil
+}
+
+func (r *BookTicketRequest) passengers() ([]dto.PassengerDTO, error) {
+	return passengersToDTO(r.Passengers)
+}
+
+func passengersToDTO(requests []PassengerRequest) ([]dto.PassengerDTO, error) {
+	passengers := make([]dto.PassengerDTO, 0, len(requests))
+
+	for i := range requests {
+		passenger, err := requests[i].toDTO()
+		if err != nil {
+			return nil, err
+		}
+
+		passengers = append(passengers, *passenger)
+	}
+
+	return passengers, nil
 }
 
 type CancelTicketRequest struct {
@@ -54,9 +113,16 @@ func (h *TicketHandler) BookTicket(ctx *fiber.Ctx) error {
 		})
 	}
 
-	tickets, err := h.requestHandler.BookTicket(ctx.Context(), bookTicketRequest.UserID, bookTicketRequest.TrainID, bookTicketRequest.TicketNumber)
+	passengers, err := bookTicketRequest.passengers()
 	if err != nil {
-		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid passenger date of birth: " + err.Error(),
+		})
+	}
+
+	tickets, err := h.requestHandler.BookTicket(ctx.Context(), ctx.Get(idempotencyKeyHeader), bookTicketRequest.UserID, bookTicketRequest.TrainID, bookTicketRequest.TicketNumber, bookTicketRequest.SeatIDs, bookTicketRequest.SeatNumbers, passengers, bookTicketRequest.PromoCode, bookTicketRequest.Origin, bookTicketRequest.Destination, bookTicketRequest.Class)
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
 			"error": "Failed to book ticket: " + err.Error(),
 		})
 	}
@@ -67,6 +133,34 @@ func (h *TicketHandler) BookTicket(ctx *fiber.Ctx) error {
 	})
 }
 
+// QuoteTicket prices a book request without booking any seat.
+func (h *TicketHandler) QuoteTicket(ctx *fiber.Ctx) error {
+	var bookTicketRequest = &BookTicketRequest{}
+	err := ctx.BodyParser(bookTicketRequest)
+
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Failed to parse request body: " + err.Error(),
+		})
+	}
+
+	passengers, err := bookTicketRequest.passengers()
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid passenger date of birth: " + err.Error(),
+		})
+	}
+
+	quote, err := h.requestHandler.QuoteTicket(ctx.Context(), bookTicketRequest.UserID, bookTicketRequest.TrainID, bookTicketRequest.TicketNumber, bookTicketRequest.SeatIDs, bookTicketRequest.SeatNumbers, passengers, bookTicketRequest.PromoCode, bookTicketRequest.Origin, bookTicketRequest.Destination, bookTicketRequest.Class)
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to quote ticket: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(quote)
+}
+
 func (h *TicketHandler) CancelTicket(ctx *fiber.Ctx) error {
 	var cancelTicketRequest = &CancelTicketRequest{}
 	err := ctx.BodyParser(cancelTicketRequest)
@@ -77,16 +171,89 @@ func (h *TicketHandler) CancelTicket(ctx *fiber.Ctx) error {
 		})
 	}
 
-	err = h.requestHandler.CancelTicket(ctx.Context(), cancelTicketRequest.TicketID)
+	cancellation, err := h.requestHandler.CancelTicket(ctx.Context(), ctx.Get(idempotencyKeyHeader), cancelTicketRequest.TicketID)
 	if err != nil {
-		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
 			"error": "Failed to cancel ticket: " + err.Error(),
 		})
 	}
 
 	return ctx.Status(fiber.StatusOK).JSON(
 		fiber.Map{
-			"message": "ticket canceled successfully",
+			"message":       "ticket canceled successfully",
+			"refund_amount": cancellation.RefundAmount,
+			"currency":      cancellation.Currency,
 		},
 	)
 }
+
+func (h *TicketHandler) HoldTicket(ctx *fiber.Ctx) error {
+	var holdTicketRequest = &BookTicketRequest{}
+	err := ctx.BodyParser(holdTicketRequest)
+
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Failed to parse request body: " + err.Error(),
+		})
+	}
+
+	passengers, err := holdTicketRequest.passengers()
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid passenger date of birth: " + err.Error(),
+		})
+	}
+
+	hold, err := h.requestHandler.HoldTicket(ctx.Context(), holdTicketRequest.UserID, holdTicketRequest.TrainID, holdTicketRequest.TicketNumber, holdTicketRequest.SeatIDs, holdTicketRequest.SeatNumbers, passengers, holdTicketRequest.Origin, holdTicketRequest.Destination, holdTicketRequest.Class)
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to hold seats: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "seats held successfully",
+		"hold":    hold,
+	})
+}
+
+func (h *TicketHandler) ConfirmTicketHold(ctx *fiber.Ctx) error {
+	ID, err := ctx.ParamsInt("id")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid ID: " + err.Error(),
+		})
+	}
+
+	tickets, err := h.requestHandler.ConfirmTicketHold(ctx.Context(), uint(ID))
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to confirm hold: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "tickets booked successfully",
+		"tickets": tickets,
+	})
+}
+
+func (h *TicketHandler) ReleaseTicketHold(ctx *fiber.Ctx) error {
+	ID, err := ctx.ParamsInt("id")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid ID: " + err.Error(),
+		})
+	}
+
+	err = h.requestHandler.ReleaseTicketHold(ctx.Context(), uint(ID))
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to release hold: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "seat hold released successfully",
+	})
+}
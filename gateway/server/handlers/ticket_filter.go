@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"fmt"
+	dto "gateway/DTO"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// parseTicketFilter reads the query of a ticket listing:
+//
+//	timeframe        upcoming or past
+//	status           comma separated ticket statuses
+//	departure_from   YYYY-MM-DD or YYYY-MM-DD HH:MM:SS, inclusive
+//	departure_to     YYYY-MM-DD or YYYY-MM-DD HH:MM:SS, a date includes the whole day
+//	origin           departure station
+//	destination      arrival station
+//	canceled         true or false
+//	sort             departure, -departure, created or -created
+//	cursor           next_cursor of the previous page
+//	limit            page size
+func parseTicketFilter(ctx *fiber.Ctx) (*dto.TicketFilterDTO, error) {
+	filter := &dto.TicketFilterDTO{
+		Timeframe:   ctx.Query("timeframe"),
+		Origin:      ctx.Query("origin"),
+		Destination: ctx.Query("destination"),
+		Sort:        ctx.Query("sort"),
+		Cursor:      ctx.Query("cursor"),
+	}
+
+	for _, status := range strings.Split(ctx.Query("status"), ",") {
+		if status = strings.TrimSpace(status); status != "" {
+			filter.Statuses = append(filter.Statuses, status)
+		}
+	}
+
+	if departureFrom := ctx.Query("departure_from"); departureFrom != "" {
+		from, _, err := parseTimeBound(departureFrom)
+		if err != nil {
+			return nil, fmt.Errorf("departure_from: %w", err)
+		}
+
+		filter.DepartureFrom = &from
+	}
+
+	if departureTo := ctx.Query("departure_to"); departureTo != "" {
+		to, dateOnly, err := parseTimeBound(departureTo)
+		if err != nil {
+			return nil, fmt.Errorf("departure_to: %w", err)
+		}
+
+		if dateOnly {
+			to = to.AddDate(0, 0, 1)
+		}
+
+		filter.DepartureTo = &to
+	}
+
+	if canceled := ctx.Query("canceled"); canceled != "" {
+		value, err := strconv.ParseBool(canceled)
+		if err != nil {
+			return nil, fmt.Errorf("canceled: %w", err)
+		}
+
+		filter.Canceled = &value
+	}
+
+	if limit := ctx.Query("limit"); limit != "" {
+		value, err := strconv.ParseUint(limit, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("limit: %w", err)
+		}
+
+		filter.Limit = uint(value)
+	}
+
+	return filter, nil
+}
+
+func parseTimeBound(value string) (time.Time, bool, error) {
+	bound, err := time.Parse(time.DateOnly, value)
+	if err == nil {
+		return bound, true, nil
+	}
+
+	bound, err = time.Parse(time.DateTime, value)
+
+	return bound, false, err
+}
@@ -0,0 +1,241 @@
+package handlers
+
+import (
+	"fmt"
+	dto "gateway/DTO"
+	"gateway/events"
+	"gateway/utils"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type TimetableHandler struct {
+	requestHandler events.RequestSender
+}
+
+func NewTimetableHandler(requestSender events.RequestSender) *TimetableHandler {
+	return &TimetableHandler{
+		requestHandler: requestSender,
+	}
+}
+
+// TimetableRequest is a service pattern generating a train run on each of
+// its weekdays, names like "mon", from valid_from to valid_until. Dates are
+// YYYY-MM-DD and stop times HH:MM from midnight of the service day in
+// time_zone, hours past 23 falling on the next days. The first stop needs
+// no arrival and the last no departure. Runs are laid out by the layout
+// template when one is given instead of a capacity.
+type TimetableRequest struct {
+	Name             string                      `json:"name"`
+	Capacity         uint                        `json:"capacity"`
+	LayoutTemplateID uint                        `json:"layout_template_id"`
+	Price            int64                       `json:"price"`
+	TimeZone         string                      `json:"time_zone"`
+	Weekdays         []string                    `json:"weekdays"`
+	ValidFrom        string                      `json:"valid_from"`
+	ValidUntil       string                      `json:"valid_until"`
+	Stops            []TimetableStopRequest      `json:"stops"`
+	Exceptions       []TimetableExceptionRequest `json:"exceptions"`
+}
+
+type TimetableStopRequest struct {
+	Station   string `json:"station"`
+	Arrival   string `json:"arrival"`
+	Departure string `json:"departure"`
+}
+
+// TimetableExceptionRequest adds a run on the date with kind extra_service
+// and removes it with kind no_service.
+type TimetableExceptionRequest struct {
+	Date string `json:"date"`
+	Kind string `json:"kind"`
+}
+
+func (r *TimetableRequest) toDTO() (*dto.TimetableDTO, error) {
+	weekdays, err := utils.ParseWeekdays(r.Weekdays)
+	if err != nil {
+		return nil, err
+	}
+
+	validFrom, err := time.Parse(time.DateOnly, r.ValidFrom)
+	if err != nil {
+		return nil, fmt.Errorf("valid_from: %w", err)
+	}
+
+	validUntil, err := time.Parse(time.DateOnly, r.ValidUntil)
+	if err != nil {
+		return nil, fmt.Errorf("valid_until: %w", err)
+	}
+
+	timetable := &dto.TimetableDTO{
+		Name:             r.Name,
+		Capacity:         r.Capacity,
+		LayoutTemplateID: r.LayoutTemplateID,
+		Price:            r.Price,
+		TimeZone:         r.TimeZone,
+		Weekdays:         weekdays,
+		ValidFrom:        validFrom,
+		ValidUntil:       validUntil,
+	}
+
+	for i, stop := range r.Stops {
+		arrival, departure := stop.Arrival, stop.Departure
+		if arrival == "" {
+			arrival = departure
+		}
+
+		if departure == "" {
+			departure = arrival
+		}
+
+		arrivalMinute, err := parseServiceMinute(arrival)
+		if err != nil {
+			return nil, fmt.Errorf("stop %d arrival: %w", i, err)
+		}
+
+		departureMinute, err := parseServiceMinute(departure)
+		if err != nil {
+			return nil, fmt.Errorf("stop %d departure: %w", i, err)
+		}
+
+		timetable.Stops = append(timetable.Stops, dto.TimetableStopDTO{
+			Station:         stop.Station,
+			ArrivalMinute:   arrivalMinute,
+			DepartureMinute: departureMinute,
+		})
+	}
+
+	for i, exception := range r.Exceptions {
+		date, err := time.Parse(time.DateOnly, exception.Date)
+		if err != nil {
+			return nil, fmt.Errorf("exception %d date: %w", i, err)
+		}
+
+		timetable.Exceptions = append(timetable.Exceptions, dto.TimetableExceptionDTO{
+			Date: date,
+			Kind: exception.Kind,
+		})
+	}
+
+	return timetable, nil
+}
+
+// parseServiceMinute reads HH:MM as minutes from midnight, allowing hours
+// past 23.
+func parseServiceMinute(str string) (uint, error) {
+	hours, minutes, found := strings.Cut(str, ":")
+	if !found {
+		return 0, fmt.Errorf("%q is not HH:MM", str)
+	}
+
+	hour, err := strconv.ParseUint(hours, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not HH:MM", str)
+	}
+
+	minute, err := strconv.ParseUint(minutes, 10, 32)
+	if err != nil || minute > 59 {
+		return 0, fmt.Errorf("%q is not HH:MM", str)
+	}
+
+	return uint(hour*60 + minute), nil
+}
+
+func (h *TimetableHandler) ListTimetables(ctx *fiber.Ctx) error {
+	timetables, err := h.requestHandler.ListTimetables(ctx.Context())
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to list timetables: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(timetables)
+}
+
+func (h *TimetableHandler) GetTimetableByID(ctx *fiber.Ctx) error {
+	ID, err := ctx.ParamsInt("id")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid timetable ID: " + err.Error(),
+		})
+	}
+
+	timetable, err := h.requestHandler.GetTimetableByID(ctx.Context(), uint(ID))
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to get timetable: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(timetable)
+}
+
+// CreateTimetable saves the timetable, its runs within the horizon are
+// generated right away.
+func (h *TimetableHandler) CreateTimetable(ctx *fiber.Ctx) error {
+	var timetableRequest = &TimetableRequest{}
+	err := ctx.BodyParser(timetableRequest)
+
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Failed to parse request body: " + err.Error(),
+		})
+	}
+
+	timetable, err := timetableRequest.toDTO()
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid timetable: " + err.Error(),
+		})
+	}
+
+	timetable, err = h.requestHandler.CreateTimetable(ctx.Context(), timetable)
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to create timetable: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(timetable)
+}
+
+// UpdateTimetable replaces the timetable and generates its upcoming runs
+// again, except the runs that already have bookings.
+func (h *TimetableHandler) UpdateTimetable(ctx *fiber.Ctx) error {
+	ID, err := ctx.ParamsInt("id")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid timetable ID: " + err.Error(),
+		})
+	}
+
+	var timetableRequest = &TimetableRequest{}
+	err = ctx.BodyParser(timetableRequest)
+
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Failed to parse request body: " + err.Error(),
+		})
+	}
+
+	timetable, err := timetableRequest.toDTO()
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid timetable: " + err.Error(),
+		})
+	}
+
+	timetable.ID = uint(ID)
+
+	timetable, err = h.requestHandler.UpdateTimetable(ctx.Context(), timetable)
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to update timetable: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(timetable)
+}
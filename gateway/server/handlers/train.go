@@ -1,7 +1,10 @@
 package handlers
 
 import (
+	"fmt"
+	dto "gateway/DTO"
 	"gateway/events"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -21,8 +24,11 @@ type Train struct {
 	Capacity uint   `json:"capacity"`
 }
 
+// CreateTrainRequest lays the train out by the layout template when one is
+// given, the capacity then being the seats of the layout.
 type CreateTrainRequest struct {
 	Train
+	LayoutTemplateID uint `json:"layout_template_id"`
 }
 
 type UpdateTrainRequest struct {
@@ -36,6 +42,20 @@ type TrainTravelDetailsRequest struct {
 	Origin        string `json:"origin"`
 	DepartureTime string `json:"departure_time"`
 	ArrivalTime   string `json:"arrival_time"`
+	Price         int64  `json:"price"`
+}
+
+// TrainStopsRequest is the route of a train in travel order. Times are
+// YYYY-MM-DD HH:MM:SS, the first stop needs no arrival and the last no
+// departure.
+type TrainStopsRequest struct {
+	Stops []StopRequest `json:"stops"`
+}
+
+type StopRequest struct {
+	Station       string `json:"station"`
+	ArrivalTime   string `json:"arrival_time"`
+	DepartureTime string `json:"departure_time"`
 }
 
 func (h *TrainHandler) ListTrains(ctx *fiber.Ctx) error {
@@ -53,15 +73,21 @@ func (h *TrainHandler) ListTrains(ctx *fiber.Ctx) error {
 		return ctx.Status(fiber.StatusOK).JSON(trains)
 	}
 
-	trains, err := h.requestHandler.ListTrainsFiltered(ctx.Context(), filters)
+	filter, err := parseTrainFilter(ctx)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid train filter: " + err.Error(),
+		})
+	}
 
+	page, err := h.requestHandler.ListTrainsFiltered(ctx.Context(), filter)
 	if err != nil {
-		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
 			"error": "Failed to list filtered trains: " + err.Error(),
 		})
 	}
 
-	return ctx.Status(fiber.StatusOK).JSON(trains)
+	return ctx.Status(fiber.StatusOK).JSON(page)
 }
 
 func (h *TrainHandler) ListTrainsSeats(ctx *fiber.Ctx) error {
@@ -82,6 +108,27 @@ func (h *TrainHandler) ListTrainsSeats(ctx *fiber.Ctx) error {
 	return ctx.Status(fiber.StatusOK).JSON(seats)
 }
 
+// GetTrainSeatMap shows every seat of the train in its coaches with its
+// position, attributes and whether it is free, held or booked. Between an
+// origin and a destination the states are those on that part of the route.
+func (h *TrainHandler) GetTrainSeatMap(ctx *fiber.Ctx) error {
+	trainID, err := ctx.ParamsInt("id")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid train ID: " + err.Error(),
+		})
+	}
+
+	seatMap, err := h.requestHandler.GetSeatMap(ctx.Context(), uint(trainID), ctx.Query("origin"), ctx.Query("destination"))
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to get seat map: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(seatMap)
+}
+
 func (h *TrainHandler) GetTrainByID(ctx *fiber.Ctx) error {
 	ID, err := ctx.ParamsInt("id")
 	if err != nil {
@@ -90,6 +137,20 @@ func (h *TrainHandler) GetTrainByID(ctx *fiber.Ctx) error {
 		})
 	}
 
+	// Between two stations the train is shown with the times of that part
+	// of the route and the seats free on all of it
+	origin, destination := ctx.Query("origin"), ctx.Query("destination")
+	if origin != "" || destination != "" {
+		train, err := h.requestHandler.GetTrainSegment(ctx.Context(), uint(ID), origin, destination)
+		if err != nil {
+			return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+				"error": "Failed to get train: " + err.Error(),
+			})
+		}
+
+		return ctx.Status(fiber.StatusOK).JSON(train)
+	}
+
 	train, err := h.requestHandler.GetTrainByID(ctx.Context(), uint(ID))
 
 	if err != nil {
@@ -109,10 +170,17 @@ func (h *TrainHandler) ListTrainTickets(ctx *fiber.Ctx) error {
 		})
 	}
 
-	tickets, err := h.requestHandler.ListTicketsByTrainID(ctx.Context(), uint(ID))
+	filter, err := parseTicketFilter(ctx)
 	if err != nil {
-		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": "Failed to get user tickets: " + err.Error(),
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid ticket filter: " + err.Error(),
+		})
+	}
+
+	tickets, err := h.requestHandler.ListTicketsByTrainID(ctx.Context(), uint(ID), filter)
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to get train tickets: " + err.Error(),
 		})
 	}
 
@@ -129,7 +197,7 @@ func (h *TrainHandler) CreateTrain(ctx *fiber.Ctx) error {
 		})
 	}
 
-	err = h.requestHandler.CreateTrain(ctx.Context(), createTrainRequest.Name, createTrainRequest.Capacity)
+	err = h.requestHandler.CreateTrain(ctx.Context(), createTrainRequest.Name, createTrainRequest.Capacity, createTrainRequest.LayoutTemplateID)
 	if err != nil {
 		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error": "Failed to create train: " + err.Error(),
@@ -175,7 +243,7 @@ func (h *TrainHandler) UpdateTrainTravelDetails(ctx *fiber.Ctx) error {
 		})
 	}
 
-	err = h.requestHandler.UpdateTrainTravelDetails(ctx.Context(), travelDetailsRequest.TrainID, travelDetailsRequest.Destination, travelDetailsRequest.Origin, travelDetailsRequest.DepartureTime, travelDetailsRequest.ArrivalTime)
+	err = h.requestHandler.UpdateTrainTravelDetails(ctx.Context(), travelDetailsRequest.TrainID, travelDetailsRequest.Destination, travelDetailsRequest.Origin, travelDetailsRequest.DepartureTime, travelDetailsRequest.ArrivalTime, travelDetailsRequest.Price)
 	if err != nil {
 		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error": "Failed to update train travel details: " + err.Error(),
@@ -189,6 +257,46 @@ func (h *TrainHandler) UpdateTrainTravelDetails(ctx *fiber.Ctx) error {
 	)
 }
 
+// UpdateTrainStops replaces the route of a train whose seats are not
+// booked yet.
+func (h *TrainHandler) UpdateTrainStops(ctx *fiber.Ctx) error {
+	ID, err := ctx.ParamsInt("id")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid train ID: " + err.Error(),
+		})
+	}
+
+	var trainStopsRequest = &TrainStopsRequest{}
+	err = ctx.BodyParser(trainStopsRequest)
+
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Failed to parse request body: " + err.Error(),
+		})
+	}
+
+	stops, err := trainStopsRequest.toDTO()
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid stop time: " + err.Error(),
+		})
+	}
+
+	err = h.requestHandler.UpdateTrainStops(ctx.Context(), uint(ID), stops)
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to update train stops: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(
+		fiber.Map{
+			"message": "Train stops updated successfully",
+		},
+	)
+}
+
 func (h *TrainHandler) DeleteTrain(ctx *fiber.Ctx) error {
 	ID, err := ctx.ParamsInt("id")
 	if err != nil {
@@ -210,3 +318,38 @@ func (h *TrainHandler) DeleteTrain(ctx *fiber.Ctx) error {
 		},
 	)
 }
+
+// toDTO reads the stop times. A missing arrival is the departure and a
+// missing departure the arrival.
+func (r *TrainStopsRequest) toDTO() ([]dto.StopDTO, error) {
+	stops := make([]dto.StopDTO, 0, len(r.Stops))
+
+	for i, stop := range r.Stops {
+		arrivalTime, departureTime := stop.ArrivalTime, stop.DepartureTime
+		if arrivalTime == "" {
+			arrivalTime = departureTime
+		}
+
+		if departureTime == "" {
+			departureTime = arrivalTime
+		}
+
+		arrival, err := time.Parse(time.DateTime, arrivalTime)
+		if err != nil {
+			return nil, fmt.Errorf("stop %d arrival_time: %w", i, err)
+		}
+
+		departure, err := time.Parse(time.DateTime, departureTime)
+		if err != nil {
+			return nil, fmt.Errorf("stop %d departure_time: %w", i, err)
+		}
+
+		stops = append(stops, dto.StopDTO{
+			Station:       stop.Station,
+			ArrivalTime:   arrival,
+			DepartureTime: departure,
+		})
+	}
+
+	return stops, nil
+}
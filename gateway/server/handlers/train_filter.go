@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"fmt"
+	dto "gateway/DTO"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// parseTrainFilter reads the query of a train search:
+//
+//	name                  part of the train name
+//	origin                station to board at, any stop of the route
+//	destination           station to leave at, a later stop of the route
+//	min_available_seats   trains with at least this many free seats
+//	class                 first, second or sleeper, seats of the class only
+//	seat_attributes       comma separated, seats with all of window, aisle, table, power_socket and accessible given
+//	departure_from        YYYY-MM-DD or YYYY-MM-DD HH:MM:SS, inclusive
+//	departure_to          YYYY-MM-DD or YYYY-MM-DD HH:MM:SS, a date includes the whole day
+//	arrival_from          like departure_from
+//	arrival_to            like departure_to
+//	sort                  departure or duration, a leading - reverses it
+//	cursor                next_cursor of the previous page
+//	limit                 page size
+//
+// Between an origin and a destination the trains are listed with the times
+// and free seats of that part of their route. With a class or seat
+// attributes only the free seats matching them are counted.
+func parseTrainFilter(ctx *fiber.Ctx) (*dto.TrainFilterDTO, error) {
+	filter := &dto.TrainFilterDTO{
+		Name:        ctx.Query("name"),
+		Origin:      ctx.Query("origin"),
+		Destination: ctx.Query("destination"),
+		Class:       ctx.Query("class"),
+		Sort:        ctx.Query("sort"),
+		Cursor:      ctx.Query("cursor"),
+	}
+
+	var err error
+
+	filter.DepartureFrom, filter.DepartureTo, err = parseTimeWindow(ctx, "departure_from", "departure_to")
+	if err != nil {
+		return nil, err
+	}
+
+	filter.ArrivalFrom, filter.ArrivalTo, err = parseTimeWindow(ctx, "arrival_from", "arrival_to")
+	if err != nil {
+		return nil, err
+	}
+
+	if minAvailableSeats := ctx.Query("min_available_seats"); minAvailableSeats != "" {
+		value, err := strconv.ParseUint(minAvailableSeats, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("min_available_seats: %w", err)
+		}
+
+		filter.MinAvailableSeats = uint(value)
+	}
+
+	if seatAttributes := ctx.Query("seat_attributes"); seatAttributes != "" {
+		filter.SeatAttributes = strings.Split(seatAttributes, ",")
+	}
+
+	if limit := ctx.Query("limit"); limit != "" {
+		value, err := strconv.ParseUint(limit, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("limit: %w", err)
+		}
+
+		filter.Limit = uint(value)
+	}
+
+	return filter, nil
+}
+
+// parseTimeWindow reads the bounds of a time window from the query. A date
+// as upper bound includes the whole day.
+func parseTimeWindow(ctx *fiber.Ctx, fromKey, toKey string) (*time.Time, *time.Time, error) {
+	var from, to *time.Time
+
+	if value := ctx.Query(fromKey); value != "" {
+		bound, _, err := parseTimeBound(value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", fromKey, err)
+		}
+
+		from = &bound
+	}
+
+	if value := ctx.Query(toKey); value != "" {
+		bound, dateOnly, err := parseTimeBound(value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", toKey, err)
+		}
+
+		if dateOnly {
+			bound = bound.AddDate(0, 0, 1)
+		}
+
+		to = &bound
+	}
+
+	return from, to, nil
+}
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"gateway/events"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type TransferHandler struct {
+	requestHandler events.RequestSender
+}
+
+// TransferTicketRequest names the recipient either by user ID or by email.
+type TransferTicketRequest struct {
+	FromUserID uint   `json:"from_user_id"`
+	ToUserID   uint   `json:"to_user_id"`
+	ToEmail    string `json:"to_email"`
+}
+
+type AcceptTicketTransferRequest struct {
+	UserID uint `json:"user_id"`
+}
+
+func NewTransferHandler(requestSender events.RequestSender) *TransferHandler {
+	return &TransferHandler{
+		requestHandler: requestSender,
+	}
+}
+
+func (h *TicketHandler) TransferTicket(ctx *fiber.Ctx) error {
+	ID, err := ctx.ParamsInt("id")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid ID: " + err.Error(),
+		})
+	}
+
+	var transferTicketRequest = &TransferTicketRequest{}
+	err = ctx.BodyParser(transferTicketRequest)
+
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Failed to parse request body: " + err.Error(),
+		})
+	}
+
+	transfer, err := h.requestHandler.TransferTicket(ctx.Context(), uint(ID), transferTicketRequest.FromUserID, transferTicketRequest.ToUserID, transferTicketRequest.ToEmail)
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to transfer ticket: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(transfer)
+}
+
+func (h *TransferHandler) GetTicketTransfer(ctx *fiber.Ctx) error {
+	ID, err := ctx.ParamsInt("id")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid ID: " + err.Error(),
+		})
+	}
+
+	transfer, err := h.requestHandler.GetTicketTransfer(ctx.Context(), uint(ID))
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to get ticket transfer: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(transfer)
+}
+
+func (h *TransferHandler) AcceptTicketTransfer(ctx *fiber.Ctx) error {
+	ID, err := ctx.ParamsInt("id")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid ID: " + err.Error(),
+		})
+	}
+
+	var acceptTicketTransferRequest = &AcceptTicketTransferRequest{}
+	err = ctx.BodyParser(acceptTicketTransferRequest)
+
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Failed to parse request body: " + err.Error(),
+		})
+	}
+
+	transfer, err := h.requestHandler.AcceptTicketTransfer(ctx.Context(), uint(ID), acceptTicketTransferRequest.UserID)
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to accept ticket transfer: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(transfer)
+}
@@ -67,9 +67,16 @@ func (h *UserHandler) ListUserTickets(ctx *fiber.Ctx) error {
 		})
 	}
 
-	tickets, err := h.requestHandler.ListTicketsByUserID(ctx.Context(), uint(ID))
+	filter, err := parseTicketFilter(ctx)
 	if err != nil {
-		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid ticket filter: " + err.Error(),
+		})
+	}
+
+	tickets, err := h.requestHandler.ListTicketsByUserID(ctx.Context(), uint(ID), filter)
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
 			"error": "Failed to get user tickets: " + err.Error(),
 		})
 	}
@@ -89,9 +96,9 @@ func (h *UserHandler) CreateUser(ctx *fiber.Ctx) error {
 		})
 	}
 
-	err = h.requestHandler.CreateUser(ctx.Context(), createUserRequest.FirstName, createUserRequest.LastName, createUserRequest.Email)
+	err = h.requestHandler.CreateUser(ctx.Context(), ctx.Get(idempotencyKeyHeader), createUserRequest.FirstName, createUserRequest.LastName, createUserRequest.Email)
 	if err != nil {
-		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
 			"error": "Failed to create user: " + err.Error(),
 		})
 	}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"gateway/events"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type WaitlistHandler struct {
+	requestHandler events.RequestSender
+}
+
+type JoinWaitlistRequest struct {
+	UserID       uint `json:"user_id"`
+	TrainID      uint `json:"train_id"`
+	TicketNumber uint `json:"ticket_number"`
+}
+
+func NewWaitlistHandler(requestSender events.RequestSender) *WaitlistHandler {
+	return &WaitlistHandler{
+		requestHandler: requestSender,
+	}
+}
+
+func (h *WaitlistHandler) JoinWaitlist(ctx *fiber.Ctx) error {
+	var joinWaitlistRequest = &JoinWaitlistRequest{}
+	err := ctx.BodyParser(joinWaitlistRequest)
+
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Failed to parse request body: " + err.Error(),
+		})
+	}
+
+	entry, err := h.requestHandler.JoinWaitlist(ctx.Context(), joinWaitlistRequest.UserID, joinWaitlistRequest.TrainID, joinWaitlistRequest.TicketNumber)
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to join waitlist: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(entry)
+}
+
+func (h *WaitlistHandler) GetWaitlistEntry(ctx *fiber.Ctx) error {
+	ID, err := ctx.ParamsInt("id")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid ID: " + err.Error(),
+		})
+	}
+
+	entry, err := h.requestHandler.GetWaitlistEntry(ctx.Context(), uint(ID))
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to get waitlist entry: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(entry)
+}
+
+func (h *WaitlistHandler) LeaveWaitlist(ctx *fiber.Ctx) error {
+	ID, err := ctx.ParamsInt("id")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid ID: " + err.Error(),
+		})
+	}
+
+	err = h.requestHandler.LeaveWaitlist(ctx.Context(), uint(ID))
+	if err != nil {
+		return ctx.Status(errorStatus(err)).JSON(fiber.Map{
+			"error": "Failed to leave waitlist: " + err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "left waitlist successfully",
+	})
+}
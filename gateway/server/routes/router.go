@@ -35,13 +35,36 @@ func InitRouters(requestSender events.RequestSender) *fiber.App {
 		r.Get("/", trainHandler.ListTrains)
 		r.Get("/:id", trainHandler.GetTrainByID)
 		r.Get("/:id/seats", trainHandler.ListTrainsSeats)
+		r.Get("/:id/seatmap", trainHandler.GetTrainSeatMap)
 		r.Get("/:id/tickets", trainHandler.ListTrainTickets)
 		r.Post("", trainHandler.CreateTrain)
 		r.Patch("", trainHandler.UpdateTrain)
 		r.Patch("/travel-details", trainHandler.UpdateTrainTravelDetails)
+		r.Put("/:id/stops", trainHandler.UpdateTrainStops)
 		r.Delete("/:id", trainHandler.DeleteTrain)
 	}
 
+	{
+		timetableHandler := handlers.NewTimetableHandler(requestSender)
+
+		r := v1.Group("/timetables")
+
+		r.Get("", timetableHandler.ListTimetables)
+		r.Get("/:id", timetableHandler.GetTimetableByID)
+		r.Post("", timetableHandler.CreateTimetable)
+		r.Put("/:id", timetableHandler.UpdateTimetable)
+	}
+
+	{
+		layoutTemplateHandler := handlers.NewLayoutTemplateHandler(requestSender)
+
+		r := v1.Group("/layout-templates")
+
+		r.Get("", layoutTemplateHandler.ListLayoutTemplates)
+		r.Get("/:id", layoutTemplateHandler.GetLayoutTemplateByID)
+		r.Post("", layoutTemplateHandler.CreateLayoutTemplate)
+	}
+
 	{
 		seatHandler := handlers.NewSeatHandler(requestSender)
 
@@ -58,10 +81,72 @@ func InitRouters(requestSender events.RequestSender) *fiber.App {
 
 		r := v1.Group("/tickets")
 
+		r.Get("/eticket-key", ticketHandler.GetETicketPublicKey)
 		r.Get("/:id", ticketHandler.GetTicketByID)
+		r.Get("/:id/eticket", ticketHandler.GetETicket)
+		r.Get("/:id/eticket/qr", ticketHandler.GetETicketQRCode)
+		r.Get("/:id/pdf", ticketHandler.GetTicketPDF)
+		r.Post("/verify", ticketHandler.VerifyETicket)
+		r.Post("/:id/transfers", ticketHandler.TransferTicket)
+		r.Post("/:id/exchange", ticketHandler.ExchangeTicket)
+		r.Post("/quote", ticketHandler.QuoteTicket)
 		r.Post("/book", ticketHandler.BookTicket)
 		r.Post("/cancel", ticketHandler.CancelTicket)
+		r.Post("/holds", ticketHandler.HoldTicket)
+		r.Post("/holds/:id/confirm", ticketHandler.ConfirmTicketHold)
+		r.Delete("/holds/:id", ticketHandler.ReleaseTicketHold)
+	}
+
+	{
+		transferHandler := handlers.NewTransferHandler(requestSender)
+
+		r := v1.Group("/transfers")
+
+		r.Get("/:id", transferHandler.GetTicketTransfer)
+		r.Post("/:id/accept", transferHandler.AcceptTicketTransfer)
+	}
+
+	{
+		bookingHandler := handlers.NewBookingHandler(requestSender)
+
+		r := v1.Group("/bookings")
+
+		r.Get("/:reference", bookingHandler.GetBookingByReference)
+		r.Post("/:reference/cancel", bookingHandler.CancelBooking)
+	}
+
+	{
+		cartHandler := handlers.NewCartHandler(requestSender)
+
+		r := v1.Group("/carts")
+
+		r.Get("/:id", cartHandler.GetCart)
+		r.Post("", cartHandler.CreateCart)
+		r.Post("/:id/legs", cartHandler.AddCartLeg)
+		r.Delete("/:id/legs/:legID", cartHandler.RemoveCartLeg)
+		r.Post("/:id/checkout", cartHandler.CheckoutCart)
+	}
+
+	{
+		waitlistHandler := handlers.NewWaitlistHandler(requestSender)
+
+		r := v1.Group("/waitlist")
+
+		r.Get("/:id", waitlistHandler.GetWaitlistEntry)
+		r.Post("", waitlistHandler.JoinWaitlist)
+		r.Delete("/:id", waitlistHandler.LeaveWaitlist)
+
+	}
+
+	{
+		promoCodeHandler := handlers.NewPromoCodeHandler(requestSender)
+
+		r := v1.Group("/promo-codes")
 
+		r.Get("", promoCodeHandler.ListPromoCodes)
+		r.Get("/:code", promoCodeHandler.GetPromoCode)
+		r.Post("", promoCodeHandler.CreatePromoCode)
+		r.Delete("/:code", promoCodeHandler.DeactivatePromoCode)
 	}
 
 	return r
@@ -5,6 +5,26 @@ import (
 	"strings"
 )
 
+var (
+	ErrConflict   = errors.New("conflict")
+	ErrNotAllowed = errors.New("not allowed")
+)
+
+// ReplyError carries the error text replied by a service, wrapping a
+// sentinel error when the reply describes a known failure kind.
+type ReplyError struct {
+	Message string
+	Err     error
+}
+
+func (e *ReplyError) Error() string {
+	return e.Message
+}
+
+func (e *ReplyError) Unwrap() error {
+	return e.Err
+}
+
 func HandleError(data []byte)error{
 	strData := string(data)
 
@@ -12,5 +32,13 @@ func HandleError(data []byte)error{
 		return  nil
 	}
 
+	if strings.Contains(strData, "conflict") {
+		return &ReplyError{Message: strData, Err: ErrConflict}
+	}
+
+	if strings.Contains(strData, "not allowed") {
+		return &ReplyError{Message: strData, Err: ErrNotAllowed}
+	}
+
 	return  errors.New(strData)
-}
\ No newline at end of file
+}
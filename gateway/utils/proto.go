@@ -4,18 +4,60 @@ import (
 	"fmt"
 	dto "gateway/DTO"
 	"gateway/gen"
-	"strconv"
+	"slices"
+	"strings"
 	"time"
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func MarshalBookTicketRequest(tranID, userID, ticketNumber uint) ([]byte, error) {
+func MarshalListTicketsRequest(userID, trainID uint, filter *dto.TicketFilterDTO) ([]byte, error) {
+	listTicketsRequest := &gen.ListTicketsRequest{
+		UserId:      uint32(userID),
+		TrainId:     uint32(trainID),
+		Timeframe:   filter.Timeframe,
+		Statuses:    filter.Statuses,
+		Origin:      filter.Origin,
+		Destination: filter.Destination,
+		Canceled:    filter.Canceled,
+		Sort:        filter.Sort,
+		Cursor:      filter.Cursor,
+		Limit:       uint32(filter.Limit),
+	}
+
+	if filter.DepartureFrom != nil {
+		listTicketsRequest.DepartureFrom = timestamppb.New(*filter.DepartureFrom)
+	}
+
+	if filter.DepartureTo != nil {
+		listTicketsRequest.DepartureTo = timestamppb.New(*filter.DepartureTo)
+	}
+
+	return proto.Marshal(listTicketsRequest)
+}
+
+func MarshalBookTicketRequest(tranID, userID, ticketNumber uint, seatIDs, seatNumbers []uint, passengers []dto.PassengerDTO, promoCode, origin, destination, class string) ([]byte, error) {
 	bookTicketRequest := &gen.BookTicketRequest{
 		TrainId:      uint32(tranID),
 		UserId:       uint32(userID),
 		TicketNumber: uint32(ticketNumber),
+		PromoCode:    promoCode,
+		Origin:       origin,
+		Destination:  destination,
+		Class:        class,
+	}
+
+	for _, seatID := range seatIDs {
+		bookTicketRequest.SeatIds = append(bookTicketRequest.SeatIds, uint32(seatID))
+	}
+
+	for _, seatNumber := range seatNumbers {
+		bookTicketRequest.SeatNumbers = append(bookTicketRequest.SeatNumbers, uint32(seatNumber))
+	}
+
+	for i := range passengers {
+		bookTicketRequest.Passengers = append(bookTicketRequest.Passengers, convertDTOPassengerToProtoPassenger(&passengers[i]))
 	}
 
 	data, err := proto.Marshal(bookTicketRequest)
@@ -26,6 +68,127 @@ func MarshalBookTicketRequest(tranID, userID, ticketNumber uint) ([]byte, error)
 	return data, nil
 }
 
+func UnmarshalSeatHold(data []byte) (*dto.SeatHoldDTO, error) {
+	protoSeatHold := &gen.SeatHold{}
+
+	err := proto.Unmarshal(data, protoSeatHold)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.SeatHoldDTO{
+		ID:        uint(protoSeatHold.ID),
+		UserID:    uint(protoSeatHold.UserId),
+		TrainID:   uint(protoSeatHold.TrainId),
+		Status:    protoSeatHold.Status,
+		ExpiresAt: protoSeatHold.ExpiresAt.AsTime(),
+		Seats:     convertProtoSeatsToDTOSeats(protoSeatHold.Seats),
+	}, nil
+}
+
+func UnmarshalCancelTicketReply(data []byte) (*dto.CancellationDTO, error) {
+	protoCancelTicketReply := &gen.CancelTicketReply{}
+
+	err := proto.Unmarshal(data, protoCancelTicketReply)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertProtoCancelTicketReplyToDTOCancellation(protoCancelTicketReply), nil
+}
+
+func MarshalCancelBookingRequest(reference string, ticketIDs []uint) ([]byte, error) {
+	cancelBookingRequest := &gen.CancelBookingRequest{
+		Reference: reference,
+	}
+
+	for _, ticketID := range ticketIDs {
+		cancelBookingRequest.TicketIds = append(cancelBookingRequest.TicketIds, uint32(ticketID))
+	}
+
+	return proto.Marshal(cancelBookingRequest)
+}
+
+func UnmarshalCancelBookingReply(data []byte) (*dto.BookingCancellationDTO, error) {
+	protoCancelBookingReply := &gen.CancelBookingReply{}
+
+	err := proto.Unmarshal(data, protoCancelBookingReply)
+	if err != nil {
+		return nil, err
+	}
+
+	bookingCancellationDTO := &dto.BookingCancellationDTO{
+		Reference:     protoCancelBookingReply.Reference,
+		Cancellations: []dto.CancellationDTO{},
+	}
+
+	for _, protoCancellation := range protoCancelBookingReply.Cancellations {
+		bookingCancellationDTO.Cancellations = append(bookingCancellationDTO.Cancellations, *convertProtoCancelTicketReplyToDTOCancellation(protoCancellation))
+	}
+
+	return bookingCancellationDTO, nil
+}
+
+func UnmarshalBooking(data []byte) (*dto.BookingDTO, error) {
+	protoBooking := &gen.Booking{}
+
+	err := proto.Unmarshal(data, protoBooking)
+	if err != nil {
+		return nil, err
+	}
+
+	bookingDTO := &dto.BookingDTO{
+		ID:        uint(protoBooking.ID),
+		Reference: protoBooking.Reference,
+		UserID:    uint(protoBooking.UserId),
+		TrainID:   uint(protoBooking.TrainId),
+		Tickets:   []dto.TicketDTO{},
+		CreatedAt: protoBooking.CreatedAt.AsTime(),
+	}
+
+	for _, protoTicket := range protoBooking.Tickets {
+		bookingDTO.Tickets = append(bookingDTO.Tickets, *convertProtoTicketToDTOTicket(protoTicket))
+	}
+
+	return bookingDTO, nil
+}
+
+func MarshalJoinWaitlistRequest(userID, trainID, ticketNumber uint) ([]byte, error) {
+	joinWaitlistRequest := &gen.JoinWaitlistRequest{
+		UserId:       uint32(userID),
+		TrainId:      uint32(trainID),
+		TicketNumber: uint32(ticketNumber),
+	}
+
+	return proto.Marshal(joinWaitlistRequest)
+}
+
+func UnmarshalWaitlistEntry(data []byte) (*dto.WaitlistEntryDTO, error) {
+	protoWaitlistEntry := &gen.WaitlistEntry{}
+
+	err := proto.Unmarshal(data, protoWaitlistEntry)
+	if err != nil {
+		return nil, err
+	}
+
+	waitlistEntryDTO := &dto.WaitlistEntryDTO{
+		ID:           uint(protoWaitlistEntry.ID),
+		UserID:       uint(protoWaitlistEntry.UserId),
+		TrainID:      uint(protoWaitlistEntry.TrainId),
+		TicketNumber: uint(protoWaitlistEntry.TicketNumber),
+		Status:       protoWaitlistEntry.Status,
+		Position:     uint(protoWaitlistEntry.Position),
+		HoldID:       uint(protoWaitlistEntry.HoldId),
+	}
+
+	if protoWaitlistEntry.OfferExpiresAt != nil {
+		offerExpiresAt := protoWaitlistEntry.OfferExpiresAt.AsTime()
+		waitlistEntryDTO.OfferExpiresAt = &offerExpiresAt
+	}
+
+	return waitlistEntryDTO, nil
+}
+
 func UnmarshalTickets(data []byte) ([]dto.TicketDTO, error) {
 	protoTickets := &gen.ListTickets{}
 
@@ -45,6 +208,26 @@ func UnmarshalTickets(data []byte) ([]dto.TicketDTO, error) {
 	return ticketsDTO, nil
 }
 
+func UnmarshalTicketPage(data []byte) (*dto.TicketPageDTO, error) {
+	protoTickets := &gen.ListTickets{}
+
+	err := proto.Unmarshal(data, protoTickets)
+	if err != nil {
+		return nil, err
+	}
+
+	ticketPageDTO := &dto.TicketPageDTO{
+		Tickets:    []dto.TicketDTO{},
+		NextCursor: protoTickets.NextCursor,
+	}
+
+	for _, protoTicket := range protoTickets.Tickets {
+		ticketPageDTO.Tickets = append(ticketPageDTO.Tickets, *convertProtoTicketToDTOTicket(protoTicket))
+	}
+
+	return ticketPageDTO, nil
+}
+
 func UnmarshalTicket(data []byte) (*dto.TicketDTO, error) {
 	protoTicket := &gen.Ticket{}
 
@@ -143,6 +326,15 @@ func UnmarshalTrain(data []byte) (*dto.TrainDTO, error) {
 }
 
 func UnmarshalListTrainsReplay(data []byte) ([]dto.TrainDTO, error) {
+	page, err := UnmarshalTrainPage(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return page.Trains, nil
+}
+
+func UnmarshalTrainPage(data []byte) (*dto.TrainPageDTO, error) {
 	protoTrains := &gen.ListTrainsReplay{}
 
 	err := proto.Unmarshal(data, protoTrains)
@@ -150,51 +342,55 @@ func UnmarshalListTrainsReplay(data []byte) ([]dto.TrainDTO, error) {
 		return nil, err
 	}
 
-	trainDTOs := []dto.TrainDTO{}
+	trainPageDTO := &dto.TrainPageDTO{
+		Trains:     []dto.TrainDTO{},
+		NextCursor: protoTrains.NextCursor,
+	}
 
 	for _, protoTrain := range protoTrains.Trains {
-		trainDTO := convertTrainProtoToTrainDTO(protoTrain)
-
-		trainDTOs = append(trainDTOs, *trainDTO)
+		trainPageDTO.Trains = append(trainPageDTO.Trains, *convertTrainProtoToTrainDTO(protoTrain))
 	}
 
-	return trainDTOs, nil
+	return trainPageDTO, nil
 }
 
-func MarshalListTrainsFilteredRequest(trainFilter map[string]string) ([]byte, error) {
-	availableSeats, _ := strconv.Atoi(trainFilter["available_seats"])
+func MarshalListTrainsFilteredRequest(filter *dto.TrainFilterDTO) ([]byte, error) {
+	protoFilter := &gen.TrainFilter{
+		Name:              filter.Name,
+		Origin:            filter.Origin,
+		Destination:       filter.Destination,
+		MinAvailableSeats: uint32(filter.MinAvailableSeats),
+		Class:             filter.Class,
+		SeatAttributes:    filter.SeatAttributes,
+		Sort:              filter.Sort,
+		Cursor:            filter.Cursor,
+		Limit:             uint32(filter.Limit),
+	}
 
-	departureTimeParsed, err := time.Parse(time.DateTime, trainFilter["departure_time"])
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse departure time: %w", err)
+	if filter.DepartureFrom != nil {
+		protoFilter.DepartureFrom = timestamppb.New(*filter.DepartureFrom)
 	}
 
-	arrivalTimeParsed, err := time.Parse(time.DateTime, trainFilter["arrival_time"])
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse arrival time: %w", err)
+	if filter.DepartureTo != nil {
+		protoFilter.DepartureTo = timestamppb.New(*filter.DepartureTo)
 	}
 
-	protoFilter := &gen.TrainFilter{
-		Name:           trainFilter["name"],
-		AvailableSeats: uint32(availableSeats),
-		Origin:         trainFilter["origin"],
-		Destination:    trainFilter["destination"],
-		DepartureTime:  timestamppb.New(departureTimeParsed),
-		ArrivalTime:    timestamppb.New(arrivalTimeParsed),
+	if filter.ArrivalFrom != nil {
+		protoFilter.ArrivalFrom = timestamppb.New(*filter.ArrivalFrom)
 	}
 
-	data, err := proto.Marshal(protoFilter)
-	if err != nil {
-		return nil, err
+	if filter.ArrivalTo != nil {
+		protoFilter.ArrivalTo = timestamppb.New(*filter.ArrivalTo)
 	}
 
-	return data, nil
+	return proto.Marshal(protoFilter)
 }
 
-func MarshalCreateTrainRequest(name string, capacity uint) ([]byte, error) {
+func MarshalCreateTrainRequest(name string, capacity, layoutTemplateID uint) ([]byte, error) {
 	protoTrain := &gen.CreateTrainRequest{
-		Name:     name,
-		Capacity: uint32(capacity),
+		Name:             name,
+		Capacity:         uint32(capacity),
+		LayoutTemplateId: uint32(layoutTemplateID),
 	}
 
 	data, err := proto.Marshal(protoTrain)
@@ -219,7 +415,7 @@ func MarshalUpdateTrainNameRequest(trainID uint, name string) ([]byte, error) {
 	return data, nil
 }
 
-func MarshalUpdateTrainTravelDetailsRequest(trainID uint, travelDetails map[string]string) ([]byte, error) {
+func MarshalUpdateTrainTravelDetailsRequest(trainID uint, price int64, travelDetails map[string]string) ([]byte, error) {
 	departureTimeParsed, err := ParseTime(travelDetails["departure_time"])
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse departure time: %w", err)
@@ -236,6 +432,7 @@ func MarshalUpdateTrainTravelDetailsRequest(trainID uint, travelDetails map[stri
 		Destination:   travelDetails["destination"],
 		DepartureTime: timestamppb.New(*departureTimeParsed),
 		ArrivalTime:   timestamppb.New(*arrivalTimeParsed),
+		Price:         price,
 	}
 
 	data, err := proto.Marshal(protoRequest)
@@ -246,6 +443,30 @@ func MarshalUpdateTrainTravelDetailsRequest(trainID uint, travelDetails map[stri
 	return data, nil
 }
 
+func MarshalUpdateTrainStopsRequest(trainID uint, stops []dto.StopDTO) ([]byte, error) {
+	protoRequest := &gen.UpdateTrainStopsRequest{
+		TrainId: uint32(trainID),
+	}
+
+	for _, stop := range stops {
+		protoRequest.Stops = append(protoRequest.Stops, &gen.Stop{
+			Station:       stop.Station,
+			ArrivalTime:   timestamppb.New(stop.ArrivalTime),
+			DepartureTime: timestamppb.New(stop.DepartureTime),
+		})
+	}
+
+	return proto.Marshal(protoRequest)
+}
+
+func MarshalTrainSegmentRequest(trainID uint, origin, destination string) ([]byte, error) {
+	return proto.Marshal(&gen.TrainSegmentRequest{
+		TrainId:     uint32(trainID),
+		Origin:      origin,
+		Destination: destination,
+	})
+}
+
 func UnmarshalSeat(data []byte) (*dto.SeatDTO, error) {
 	protoSeat := &gen.Seat{}
 
@@ -272,10 +493,11 @@ func UnmarshalListSeatsByTrainIDReplay(data []byte) ([]dto.SeatDTO, error) {
 	return seatDTOs, nil
 }
 
-func MarshalCreateSeatRequest(trainID, seatNumber uint) ([]byte, error) {
+func MarshalCreateSeatRequest(trainID, seatNumber uint, class string) ([]byte, error) {
 	protoSeat := &gen.CreateSeatRequest{
 		TrainId: uint32(trainID),
 		Number:  uint32(seatNumber),
+		Class:   class,
 	}
 
 	data, err := proto.Marshal(protoSeat)
@@ -306,20 +528,81 @@ func convertTrainProtoToTrainDTO(protoTrain *gen.Train) *dto.TrainDTO {
 		Destination:   protoTrain.Destination,
 		DepartureTime: protoTrain.DepartureTime.AsTime(),
 		ArrivalTime:   protoTrain.ArrivalTime.AsTime(),
+		Price:         protoTrain.Price,
 	}
 
 	DTOSeats := convertProtoSeatsToDTOSeats(protoTrain.Seats)
 
+	DTOStops := make([]dto.StopDTO, 0, len(protoTrain.Stops))
+	for _, protoStop := range protoTrain.Stops {
+		DTOStops = append(DTOStops, dto.StopDTO{
+			Sequence:      uint(protoStop.Sequence),
+			Station:       protoStop.Station,
+			ArrivalTime:   protoStop.ArrivalTime.AsTime(),
+			DepartureTime: protoStop.DepartureTime.AsTime(),
+		})
+	}
+
+	DTOCoaches := make([]dto.CoachDTO, 0, len(protoTrain.Coaches))
+	for _, protoCoach := range protoTrain.Coaches {
+		DTOCoaches = append(DTOCoaches, *convertProtoCoachToDTOCoach(protoCoach))
+	}
+
+	var serviceDate *time.Time
+	if protoTrain.ServiceDate != nil {
+		date := protoTrain.ServiceDate.AsTime()
+		serviceDate = &date
+	}
+
 	return &dto.TrainDTO{
 		ID:              uint(protoTrain.ID),
 		Name:            protoTrain.Name,
 		Capacity:        uint(protoTrain.Capacity),
+		AvailableSeats:  uint(protoTrain.AvailableSeats),
 		Seats:           DTOSeats,
+		Coaches:         DTOCoaches,
+		Stops:           DTOStops,
 		IsFull:          protoTrain.IsFull,
+		TimetableID:     uint(protoTrain.TimetableId),
+		ServiceDate:     serviceDate,
 		TravelDetailDTO: travelDetailDTO,
 	}
 }
 
+func convertProtoCoachToDTOCoach(protoCoach *gen.Coach) *dto.CoachDTO {
+	return &dto.CoachDTO{
+		ID:      uint(protoCoach.ID),
+		Number:  uint(protoCoach.Number),
+		Class:   protoCoach.Class,
+		Rows:    uint(protoCoach.Rows),
+		Columns: uint(protoCoach.Columns),
+	}
+}
+
+func UnmarshalSeatMap(data []byte) (*dto.SeatMapDTO, error) {
+	protoSeatMap := &gen.SeatMap{}
+
+	err := proto.Unmarshal(data, protoSeatMap)
+	if err != nil {
+		return nil, err
+	}
+
+	seatMapDTO := &dto.SeatMapDTO{
+		Train:   *convertTrainProtoToTrainDTO(protoSeatMap.Train),
+		Coaches: make([]dto.SeatMapCoachDTO, 0, len(protoSeatMap.Coaches)),
+		Seats:   convertProtoSeatsToDTOSeats(protoSeatMap.Seats),
+	}
+
+	for _, protoCoach := range protoSeatMap.Coaches {
+		seatMapDTO.Coaches = append(seatMapDTO.Coaches, dto.SeatMapCoachDTO{
+			CoachDTO: *convertProtoCoachToDTOCoach(protoCoach.Coach),
+			Seats:    convertProtoSeatsToDTOSeats(protoCoach.Seats),
+		})
+	}
+
+	return seatMapDTO, nil
+}
+
 func convertProtoSeatsToDTOSeats(protoSeats []*gen.Seat) []dto.SeatDTO {
 	dtoSeats := make([]dto.SeatDTO, 0, len(protoSeats))
 
@@ -332,10 +615,18 @@ func convertProtoSeatsToDTOSeats(protoSeats []*gen.Seat) []dto.SeatDTO {
 
 func convertProtoSeatToDTOSeat(protoSeat *gen.Seat) *dto.SeatDTO {
 	return &dto.SeatDTO{
-		ID:      uint(protoSeat.ID),
-		Number:  uint(protoSeat.SeatNumber),
-		TrainID: uint(protoSeat.TrainId),
-		UserID:  uint(protoSeat.UserId),
+		ID:         uint(protoSeat.ID),
+		Number:     uint(protoSeat.SeatNumber),
+		TrainID:    uint(protoSeat.TrainId),
+		UserID:     uint(protoSeat.UserId),
+		Class:      protoSeat.Class,
+		CoachID:    uint(protoSeat.CoachId),
+		Row:        uint(protoSeat.Row),
+		Column:     uint(protoSeat.Column),
+		Attributes: protoSeat.Attributes,
+		State:      protoSeat.State,
+		Booked:     protoSeat.Booked,
+		Held:       protoSeat.Held,
 	}
 }
 
@@ -357,12 +648,31 @@ func convertProtoTicketToDTOTicket(protoTicket *gen.Ticket) *dto.TicketDTO {
 	}
 
 	ticketDTO := &dto.TicketDTO{
-		ID:              uint(protoTicket.ID),
-		SeatNumber:      uint(protoTicket.SeatNumber),
-		UserID:          uint(protoTicket.UserId),
-		TrainID:         uint(protoTicket.TrainId),
-		ExpiresAt:       protoTicket.ExpiresAt.AsTime(),
-		TravelDetailDTO: travelDetailDTO,
+		ID:               uint(protoTicket.ID),
+		SeatNumber:       uint(protoTicket.SeatNumber),
+		Class:            protoTicket.Class,
+		UserID:           uint(protoTicket.UserId),
+		TrainID:          uint(protoTicket.TrainId),
+		ExpiresAt:        protoTicket.ExpiresAt.AsTime(),
+		TravelDetailDTO:  travelDetailDTO,
+		Price:            protoTicket.Price,
+		Currency:         protoTicket.Currency,
+		PaymentStatus:    protoTicket.PaymentStatus,
+		Status:           protoTicket.Status,
+		BookingReference: protoTicket.BookingReference,
+	}
+
+	for _, protoEntry := range protoTicket.History {
+		ticketDTO.History = append(ticketDTO.History, dto.TicketHistoryDTO{
+			FromStatus: protoEntry.FromStatus,
+			ToStatus:   protoEntry.ToStatus,
+			Reason:     protoEntry.Reason,
+			CreatedAt:  protoEntry.CreatedAt.AsTime(),
+		})
+	}
+
+	if protoTicket.Passenger.GetFirstName() != "" {
+		ticketDTO.Passenger = convertProtoPassengerToDTOPassenger(protoTicket.Passenger)
 	}
 
 	canceledAt := protoTicket.CanceledAt.AsTime()
@@ -372,3 +682,521 @@ func convertProtoTicketToDTOTicket(protoTicket *gen.Ticket) *dto.TicketDTO {
 
 	return ticketDTO
 }
+
+func convertProtoCancelTicketReplyToDTOCancellation(protoCancelTicketReply *gen.CancelTicketReply) *dto.CancellationDTO {
+	return &dto.CancellationDTO{
+		TicketID:     uint(protoCancelTicketReply.TicketId),
+		RefundAmount: protoCancelTicketReply.RefundAmount,
+		Currency:     protoCancelTicketReply.Currency,
+	}
+}
+
+func convertDTOPassengerToProtoPassenger(passenger *dto.PassengerDTO) *gen.Passenger {
+	protoPassenger := &gen.Passenger{
+		FirstName:      passenger.FirstName,
+		LastName:       passenger.LastName,
+		DocumentType:   passenger.DocumentType,
+		DocumentNumber: passenger.DocumentNumber,
+		Category:       passenger.Category,
+	}
+
+	if passenger.DateOfBirth != nil {
+		protoPassenger.DateOfBirth = timestamppb.New(*passenger.DateOfBirth)
+	}
+
+	return protoPassenger
+}
+
+func convertProtoPassengerToDTOPassenger(protoPassenger *gen.Passenger) *dto.PassengerDTO {
+	passengerDTO := &dto.PassengerDTO{
+		FirstName:      protoPassenger.FirstName,
+		LastName:       protoPassenger.LastName,
+		DocumentType:   protoPassenger.DocumentType,
+		DocumentNumber: protoPassenger.DocumentNumber,
+		Category:       protoPassenger.Category,
+	}
+
+	if protoPassenger.DateOfBirth != nil {
+		dateOfBirth := protoPassenger.DateOfBirth.AsTime()
+		passengerDTO.DateOfBirth = &dateOfBirth
+	}
+
+	return passengerDTO
+}
+
+func MarshalTransferTicketRequest(ticketID, fromUserID, toUserID uint, toEmail string) ([]byte, error) {
+	transferTicketRequest := &gen.TransferTicketRequest{
+		TicketId:   uint32(ticketID),
+		FromUserId: uint32(fromUserID),
+		ToUserId:   uint32(toUserID),
+		ToEmail:    toEmail,
+	}
+
+	return proto.Marshal(transferTicketRequest)
+}
+
+func MarshalAcceptTicketTransferRequest(transferID, userID uint) ([]byte, error) {
+	acceptTicketTransferRequest := &gen.AcceptTicketTransferRequest{
+		TransferId: uint32(transferID),
+		UserId:     uint32(userID),
+	}
+
+	return proto.Marshal(acceptTicketTransferRequest)
+}
+
+func UnmarshalTicketTransfer(data []byte) (*dto.TicketTransferDTO, error) {
+	protoTicketTransfer := &gen.TicketTransfer{}
+
+	err := proto.Unmarshal(data, protoTicketTransfer)
+	if err != nil {
+		return nil, err
+	}
+
+	ticketTransferDTO := &dto.TicketTransferDTO{
+		ID:         uint(protoTicketTransfer.ID),
+		TicketID:   uint(protoTicketTransfer.TicketId),
+		FromUserID: uint(protoTicketTransfer.FromUserId),
+		ToUserID:   uint(protoTicketTransfer.ToUserId),
+		Status:     protoTicketTransfer.Status,
+		ExpiresAt:  protoTicketTransfer.ExpiresAt.AsTime(),
+		CreatedAt:  protoTicketTransfer.CreatedAt.AsTime(),
+	}
+
+	if protoTicketTransfer.AcceptedAt != nil {
+		acceptedAt := protoTicketTransfer.AcceptedAt.AsTime()
+		ticketTransferDTO.AcceptedAt = &acceptedAt
+	}
+
+	return ticketTransferDTO, nil
+}
+
+func MarshalExchangeTicketRequest(ticketID, trainID, seatID, seatNumber uint) ([]byte, error) {
+	exchangeTicketRequest := &gen.ExchangeTicketRequest{
+		TicketId:   uint32(ticketID),
+		TrainId:    uint32(trainID),
+		SeatId:     uint32(seatID),
+		SeatNumber: uint32(seatNumber),
+	}
+
+	return proto.Marshal(exchangeTicketRequest)
+}
+
+func UnmarshalExchangeTicketReply(data []byte) (*dto.ExchangeDTO, error) {
+	protoExchangeTicketReply := &gen.ExchangeTicketReply{}
+
+	err := proto.Unmarshal(data, protoExchangeTicketReply)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ExchangeDTO{
+		OldTicket:      *convertProtoTicketToDTOTicket(protoExchangeTicketReply.OldTicket),
+		NewTicket:      *convertProtoTicketToDTOTicket(protoExchangeTicketReply.NewTicket),
+		FareDifference: protoExchangeTicketReply.FareDifference,
+		Currency:       protoExchangeTicketReply.Currency,
+	}, nil
+}
+
+func UnmarshalQuoteTicketReply(data []byte) (*dto.QuoteDTO, error) {
+	protoQuoteTicketReply := &gen.QuoteTicketReply{}
+
+	err := proto.Unmarshal(data, protoQuoteTicketReply)
+	if err != nil {
+		return nil, err
+	}
+
+	quoteDTO := &dto.QuoteDTO{
+		TrainID:       uint(protoQuoteTicketReply.TrainId),
+		Fares:         []dto.FareDTO{},
+		PromoCode:     protoQuoteTicketReply.PromoCode,
+		PromoDiscount: protoQuoteTicketReply.PromoDiscount,
+		Total:         protoQuoteTicketReply.Total,
+		Currency:      protoQuoteTicketReply.Currency,
+	}
+
+	for _, protoFare := range protoQuoteTicketReply.Fares {
+		quoteDTO.Fares = append(quoteDTO.Fares, dto.FareDTO{
+			SeatID:        uint(protoFare.SeatId),
+			SeatNumber:    uint(protoFare.SeatNumber),
+			Class:         protoFare.Class,
+			Category:      protoFare.Category,
+			BaseFare:      protoFare.BaseFare,
+			Surcharge:     protoFare.Surcharge,
+			Discount:      protoFare.Discount,
+			PromoDiscount: protoFare.PromoDiscount,
+			Price:         protoFare.Price,
+		})
+	}
+
+	return quoteDTO, nil
+}
+
+func MarshalPromoCode(promo *dto.PromoCodeDTO) ([]byte, error) {
+	protoPromoCode := &gen.PromoCode{
+		Code:           promo.Code,
+		DiscountType:   promo.DiscountType,
+		Value:          promo.Value,
+		MaxUses:        uint32(promo.MaxUses),
+		MaxUsesPerUser: uint32(promo.MaxUsesPerUser),
+		ValidFrom:      timestamppb.New(promo.ValidFrom),
+	}
+
+	if promo.ValidUntil != nil {
+		protoPromoCode.ValidUntil = timestamppb.New(*promo.ValidUntil)
+	}
+
+	for _, restriction := range promo.Restrictions {
+		protoPromoCode.Restrictions = append(protoPromoCode.Restrictions, &gen.PromoRestriction{
+			TrainId:     uint32(restriction.TrainID),
+			Origin:      restriction.Origin,
+			Destination: restriction.Destination,
+		})
+	}
+
+	return proto.Marshal(protoPromoCode)
+}
+
+func UnmarshalPromoCode(data []byte) (*dto.PromoCodeDTO, error) {
+	protoPromoCode := &gen.PromoCode{}
+
+	err := proto.Unmarshal(data, protoPromoCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertProtoPromoCodeToDTOPromoCode(protoPromoCode), nil
+}
+
+func UnmarshalPromoCodes(data []byte) ([]dto.PromoCodeDTO, error) {
+	protoListPromoCodes := &gen.ListPromoCodes{}
+
+	err := proto.Unmarshal(data, protoListPromoCodes)
+	if err != nil {
+		return nil, err
+	}
+
+	promoCodeDTOs := []dto.PromoCodeDTO{}
+
+	for _, protoPromoCode := range protoListPromoCodes.PromoCodes {
+		promoCodeDTOs = append(promoCodeDTOs, *convertProtoPromoCodeToDTOPromoCode(protoPromoCode))
+	}
+
+	return promoCodeDTOs, nil
+}
+
+func convertProtoPromoCodeToDTOPromoCode(protoPromoCode *gen.PromoCode) *dto.PromoCodeDTO {
+	promoCodeDTO := &dto.PromoCodeDTO{
+		ID:             uint(protoPromoCode.ID),
+		Code:           protoPromoCode.Code,
+		DiscountType:   protoPromoCode.DiscountType,
+		Value:          protoPromoCode.Value,
+		MaxUses:        uint(protoPromoCode.MaxUses),
+		MaxUsesPerUser: uint(protoPromoCode.MaxUsesPerUser),
+		Uses:           uint(protoPromoCode.Uses),
+		ValidFrom:      protoPromoCode.ValidFrom.AsTime(),
+		Active:         protoPromoCode.Active,
+		Restrictions:   []dto.PromoRestrictionDTO{},
+		CreatedAt:      protoPromoCode.CreatedAt.AsTime(),
+	}
+
+	if protoPromoCode.ValidUntil != nil {
+		validUntil := protoPromoCode.ValidUntil.AsTime()
+		promoCodeDTO.ValidUntil = &validUntil
+	}
+
+	for _, protoRestriction := range protoPromoCode.Restrictions {
+		promoCodeDTO.Restrictions = append(promoCodeDTO.Restrictions, dto.PromoRestrictionDTO{
+			TrainID:     uint(protoRestriction.TrainId),
+			Origin:      protoRestriction.Origin,
+			Destination: protoRestriction.Destination,
+		})
+	}
+
+	return promoCodeDTO
+}
+
+func MarshalAddCartLegRequest(cartID, trainID, ticketNumber uint) ([]byte, error) {
+	addCartLegRequest := &gen.AddCartLegRequest{
+		CartId:       uint32(cartID),
+		TrainId:      uint32(trainID),
+		TicketNumber: uint32(ticketNumber),
+	}
+
+	return proto.Marshal(addCartLegRequest)
+}
+
+func MarshalRemoveCartLegRequest(cartID, legID uint) ([]byte, error) {
+	removeCartLegRequest := &gen.RemoveCartLegRequest{
+		CartId: uint32(cartID),
+		LegId:  uint32(legID),
+	}
+
+	return proto.Marshal(removeCartLegRequest)
+}
+
+func MarshalCheckoutCartRequest(cartID uint, passengers []dto.PassengerDTO) ([]byte, error) {
+	checkoutCartRequest := &gen.CheckoutCartRequest{
+		CartId: uint32(cartID),
+	}
+
+	for i := range passengers {
+		checkoutCartRequest.Passengers = append(checkoutCartRequest.Passengers, convertDTOPassengerToProtoPassenger(&passengers[i]))
+	}
+
+	return proto.Marshal(checkoutCartRequest)
+}
+
+func UnmarshalCart(data []byte) (*dto.CartDTO, error) {
+	protoCart := &gen.Cart{}
+
+	err := proto.Unmarshal(data, protoCart)
+	if err != nil {
+		return nil, err
+	}
+
+	cartDTO := &dto.CartDTO{
+		ID:               uint(protoCart.ID),
+		UserID:           uint(protoCart.UserId),
+		Status:           protoCart.Status,
+		BookingReference: protoCart.BookingReference,
+		Legs:             []dto.CartLegDTO{},
+		CreatedAt:        protoCart.CreatedAt.AsTime(),
+	}
+
+	for _, protoLeg := range protoCart.Legs {
+		cartDTO.Legs = append(cartDTO.Legs, dto.CartLegDTO{
+			ID:           uint(protoLeg.ID),
+			TrainID:      uint(protoLeg.TrainId),
+			TicketNumber: uint(protoLeg.TicketNumber),
+		})
+	}
+
+	return cartDTO, nil
+}
+
+// weekdays are the names of the days of the week, in time.Weekday order.
+var weekdays = [7]string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// ParseWeekdays reads day names like "Mon" or "monday" as lower case three
+// letter names.
+func ParseWeekdays(names []string) ([]string, error) {
+	days := make([]string, 0, len(names))
+
+	for _, name := range names {
+		day := strings.ToLower(strings.TrimSpace(name))
+		if len(day) > 3 {
+			day = day[:3]
+		}
+
+		if !slices.Contains(weekdays[:], day) {
+			return nil, fmt.Errorf("unknown weekday %q", name)
+		}
+
+		days = append(days, day)
+	}
+
+	return days, nil
+}
+
+func weekdayMask(names []string) uint32 {
+	var mask uint32
+
+	for i, day := range weekdays {
+		if slices.Contains(names, day) {
+			mask |= 1 << i
+		}
+	}
+
+	return mask
+}
+
+func weekdayNames(mask uint32) []string {
+	names := []string{}
+
+	for i, day := range weekdays {
+		if mask&(1<<i) != 0 {
+			names = append(names, day)
+		}
+	}
+
+	return names
+}
+
+func MarshalTimetable(timetable *dto.TimetableDTO) ([]byte, error) {
+	protoTimetable := &gen.Timetable{
+		ID:               uint32(timetable.ID),
+		Name:             timetable.Name,
+		Capacity:         uint32(timetable.Capacity),
+		LayoutTemplateId: uint32(timetable.LayoutTemplateID),
+		Price:            timetable.Price,
+		TimeZone:         timetable.TimeZone,
+		Weekdays:         weekdayMask(timetable.Weekdays),
+		ValidFrom:        timestamppb.New(timetable.ValidFrom),
+		ValidUntil:       timestamppb.New(timetable.ValidUntil),
+	}
+
+	for _, stop := range timetable.Stops {
+		protoTimetable.Stops = append(protoTimetable.Stops, &gen.TimetableStop{
+			Station:         stop.Station,
+			ArrivalMinute:   uint32(stop.ArrivalMinute),
+			DepartureMinute: uint32(stop.DepartureMinute),
+		})
+	}
+
+	for _, exception := range timetable.Exceptions {
+		protoTimetable.Exceptions = append(protoTimetable.Exceptions, &gen.TimetableException{
+			Date: timestamppb.New(exception.Date),
+			Kind: exception.Kind,
+		})
+	}
+
+	return proto.Marshal(protoTimetable)
+}
+
+func UnmarshalTimetable(data []byte) (*dto.TimetableDTO, error) {
+	protoTimetable := &gen.Timetable{}
+
+	err := proto.Unmarshal(data, protoTimetable)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertProtoTimetableToDTOTimetable(protoTimetable), nil
+}
+
+func UnmarshalTimetables(data []byte) ([]dto.TimetableDTO, error) {
+	protoListTimetables := &gen.ListTimetablesReplay{}
+
+	err := proto.Unmarshal(data, protoListTimetables)
+	if err != nil {
+		return nil, err
+	}
+
+	timetableDTOs := []dto.TimetableDTO{}
+
+	for _, protoTimetable := range protoListTimetables.Timetables {
+		timetableDTOs = append(timetableDTOs, *convertProtoTimetableToDTOTimetable(protoTimetable))
+	}
+
+	return timetableDTOs, nil
+}
+
+func convertProtoTimetableToDTOTimetable(protoTimetable *gen.Timetable) *dto.TimetableDTO {
+	timetableDTO := &dto.TimetableDTO{
+		ID:               uint(protoTimetable.ID),
+		Name:             protoTimetable.Name,
+		Capacity:         uint(protoTimetable.Capacity),
+		LayoutTemplateID: uint(protoTimetable.LayoutTemplateId),
+		Price:            protoTimetable.Price,
+		TimeZone:         protoTimetable.TimeZone,
+		Weekdays:         weekdayNames(protoTimetable.Weekdays),
+		ValidFrom:        protoTimetable.ValidFrom.AsTime(),
+		ValidUntil:       protoTimetable.ValidUntil.AsTime(),
+		Stops:            []dto.TimetableStopDTO{},
+		Exceptions:       []dto.TimetableExceptionDTO{},
+	}
+
+	for _, protoStop := range protoTimetable.Stops {
+		timetableDTO.Stops = append(timetableDTO.Stops, dto.TimetableStopDTO{
+			Sequence:        uint(protoStop.Sequence),
+			Station:         protoStop.Station,
+			ArrivalMinute:   uint(protoStop.ArrivalMinute),
+			DepartureMinute: uint(protoStop.DepartureMinute),
+		})
+	}
+
+	for _, protoException := range protoTimetable.Exceptions {
+		timetableDTO.Exceptions = append(timetableDTO.Exceptions, dto.TimetableExceptionDTO{
+			Date: protoException.Date.AsTime(),
+			Kind: protoException.Kind,
+		})
+	}
+
+	return timetableDTO
+}
+
+func MarshalLayoutTemplate(layout *dto.LayoutTemplateDTO) ([]byte, error) {
+	protoLayout := &gen.LayoutTemplate{
+		ID:   uint32(layout.ID),
+		Name: layout.Name,
+	}
+
+	for _, coach := range layout.Coaches {
+		protoCoach := &gen.CoachTemplate{
+			Number:  uint32(coach.Number),
+			Class:   coach.Class,
+			Rows:    uint32(coach.Rows),
+			Columns: uint32(coach.Columns),
+		}
+
+		for _, seat := range coach.Seats {
+			protoCoach.Seats = append(protoCoach.Seats, &gen.SeatTemplate{
+				Row:        uint32(seat.Row),
+				Column:     uint32(seat.Column),
+				Attributes: seat.Attributes,
+			})
+		}
+
+		protoLayout.Coaches = append(protoLayout.Coaches, protoCoach)
+	}
+
+	return proto.Marshal(protoLayout)
+}
+
+func UnmarshalLayoutTemplate(data []byte) (*dto.LayoutTemplateDTO, error) {
+	protoLayout := &gen.LayoutTemplate{}
+
+	err := proto.Unmarshal(data, protoLayout)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertProtoLayoutTemplateToDTOLayoutTemplate(protoLayout), nil
+}
+
+func UnmarshalLayoutTemplates(data []byte) ([]dto.LayoutTemplateDTO, error) {
+	protoListLayouts := &gen.ListLayoutTemplatesReplay{}
+
+	err := proto.Unmarshal(data, protoListLayouts)
+	if err != nil {
+		return nil, err
+	}
+
+	layoutDTOs := []dto.LayoutTemplateDTO{}
+
+	for _, protoLayout := range protoListLayouts.LayoutTemplates {
+		layoutDTOs = append(layoutDTOs, *convertProtoLayoutTemplateToDTOLayoutTemplate(protoLayout))
+	}
+
+	return layoutDTOs, nil
+}
+
+func convertProtoLayoutTemplateToDTOLayoutTemplate(protoLayout *gen.LayoutTemplate) *dto.LayoutTemplateDTO {
+	layoutDTO := &dto.LayoutTemplateDTO{
+		ID:      uint(protoLayout.ID),
+		Name:    protoLayout.Name,
+		Coaches: []dto.CoachTemplateDTO{},
+	}
+
+	for _, protoCoach := range protoLayout.Coaches {
+		coachDTO := dto.CoachTemplateDTO{
+			Number:  uint(protoCoach.Number),
+			Class:   protoCoach.Class,
+			Rows:    uint(protoCoach.Rows),
+			Columns: uint(protoCoach.Columns),
+			Seats:   []dto.SeatTemplateDTO{},
+		}
+
+		for _, protoSeat := range protoCoach.Seats {
+			coachDTO.Seats = append(coachDTO.Seats, dto.SeatTemplateDTO{
+				Row:        uint(protoSeat.Row),
+				Column:     uint(protoSeat.Column),
+				Attributes: protoSeat.Attributes,
+			})
+		}
+
+		layoutDTO.Coaches = append(layoutDTO.Coaches, coachDTO)
+	}
+
+	return layoutDTO
+}
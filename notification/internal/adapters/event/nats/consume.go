@@ -15,6 +15,12 @@ const (
 	UserStream                = "USER"
 	UserRegisteredSubjectName = "user.registered"
 	UserUpdatedSubjectName    = "user.updated"
+
+	TicketStream                       = "TICKET"
+	TicketTransferSubjectsName         = "ticket.transfer.*"
+	TicketTransferRequestedSubjectName = "ticket.transfer.requested"
+	TicketTransferAcceptedSubjectName  = "ticket.transfer.accepted"
+	BookingConfirmedSubjectName        = "ticket.booking.confirmed"
 )
 
 var (
@@ -112,6 +118,105 @@ func (c *NatsEventConsumer) ConsumerUserEvents(ctx context.Context) <-chan error
 	return errorStream
 }
 
+// ConsumerTicketEvents emails both parties of a ticket transfer. The TICKET
+// stream is owned by the ticket service, so the consumer is created inside
+// the loop goroutine and its error reported through the stream.
+func (c *NatsEventConsumer) ConsumerTicketEvents(ctx context.Context) <-chan error {
+	var errorStream = make(chan error)
+
+	go func() {
+		defer close(errorStream)
+
+		ticketConsumer, err := c.jetStream.CreateOrUpdateConsumer(ctx, TicketStream, jetstream.ConsumerConfig{
+			Durable:       "ticket_transfer_email_consumer",
+			FilterSubject: TicketTransferSubjectsName,
+			MaxAckPending: 5,
+		})
+		if err != nil {
+			errorStream <- err
+			return
+		}
+
+		for {
+			msgs, err := ticketConsumer.Fetch(5)
+			if err != nil {
+				errorStream <- err
+			}
+
+			for msg := range msgs.Messages() {
+				transfer, err := utils.UnmarshalTicketTransferNotice(msg.Data())
+				if err != nil {
+					errorStream <- err
+					ack(msg, errorStream)
+					continue
+				}
+
+				switch msg.Subject() {
+				case TicketTransferRequestedSubjectName:
+					err = c.API.TicketTransferRequested(ctx, transfer)
+				case TicketTransferAcceptedSubjectName:
+					err = c.API.TicketTransferAccepted(ctx, transfer)
+				default:
+					err = fmt.Errorf("%w:%s", ErrInvalidSubject, msg.Subject())
+				}
+
+				if err != nil {
+					errorStream <- err
+				}
+
+				ack(msg, errorStream)
+			}
+		}
+	}()
+
+	return errorStream
+}
+
+// ConsumerBookingEvents emails the booker a confirmation of each paid
+// booking with the tickets attached.
+func (c *NatsEventConsumer) ConsumerBookingEvents(ctx context.Context) <-chan error {
+	var errorStream = make(chan error)
+
+	go func() {
+		defer close(errorStream)
+
+		bookingConsumer, err := c.jetStream.CreateOrUpdateConsumer(ctx, TicketStream, jetstream.ConsumerConfig{
+			Durable:       "booking_email_consumer",
+			FilterSubject: BookingConfirmedSubjectName,
+			MaxAckPending: 5,
+		})
+		if err != nil {
+			errorStream <- err
+			return
+		}
+
+		for {
+			msgs, err := bookingConsumer.Fetch(5)
+			if err != nil {
+				errorStream <- err
+			}
+
+			for msg := range msgs.Messages() {
+				confirmation, err := utils.UnmarshalBookingConfirmedNotice(msg.Data())
+				if err != nil {
+					errorStream <- err
+					ack(msg, errorStream)
+					continue
+				}
+
+				err = c.API.BookingConfirmed(ctx, confirmation)
+				if err != nil {
+					errorStream <- err
+				}
+
+				ack(msg, errorStream)
+			}
+		}
+	}()
+
+	return errorStream
+}
+
 func ack(msg jetstream.Msg, errorStream chan<- error) {
 	err := msg.Ack()
 	if err != nil {
@@ -3,11 +3,15 @@ package email
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
 	"html/template"
 	"log"
+	"mime/multipart"
 	"net/smtp"
+	"net/textproto"
 	"notification/config"
+	"notification/internal/application/core/domain"
 	"path/filepath"
 	"runtime"
 	"strings"
@@ -55,6 +59,75 @@ func (s EmailNotifier) NotifyUserUpdated(ctx context.Context, name string, email
 	return nil
 }
 
+// transferEmail is the data of a transfer email, addressed to one of the
+// two parties with Other naming the other one.
+type transferEmail struct {
+	Name  string
+	Other string
+	*domain.TicketTransfer
+}
+
+// NotifyTicketTransferRequested asks the recipient to accept the ticket and
+// confirms to the sender that the transfer was sent.
+func (s EmailNotifier) NotifyTicketTransferRequested(ctx context.Context, transfer *domain.TicketTransfer) error {
+	err := s.sendEmail(newEmailMessage(
+		"transfer_offered.html",
+		"A ticket was transferred to you",
+		transferEmail{Name: transfer.ToName, Other: transfer.FromName, TicketTransfer: transfer},
+		[]string{transfer.ToEmail},
+	))
+	if err != nil {
+		return err
+	}
+
+	return s.sendEmail(newEmailMessage(
+		"transfer_sent.html",
+		"Ticket transfer sent",
+		transferEmail{Name: transfer.FromName, Other: transfer.ToName, TicketTransfer: transfer},
+		[]string{transfer.FromEmail},
+	))
+}
+
+func (s EmailNotifier) NotifyTicketTransferAccepted(ctx context.Context, transfer *domain.TicketTransfer) error {
+	err := s.sendEmail(newEmailMessage(
+		"transfer_accepted.html",
+		"Ticket transfer completed",
+		transferEmail{Name: transfer.FromName, Other: transfer.ToName, TicketTransfer: transfer},
+		[]string{transfer.FromEmail},
+	))
+	if err != nil {
+		return err
+	}
+
+	return s.sendEmail(newEmailMessage(
+		"transfer_accepted.html",
+		"Ticket transfer completed",
+		transferEmail{Name: transfer.ToName, Other: transfer.FromName, TicketTransfer: transfer},
+		[]string{transfer.ToEmail},
+	))
+}
+
+// NotifyBookingConfirmed sends the booker a confirmation with the printable
+// tickets attached when the ticket service could render them.
+func (s EmailNotifier) NotifyBookingConfirmed(ctx context.Context, confirmation *domain.BookingConfirmation) error {
+	msg := newEmailMessage(
+		"booking_confirmed.html",
+		"Booking "+confirmation.Reference+" confirmed",
+		confirmation,
+		[]string{confirmation.Email},
+	)
+
+	if len(confirmation.Document) > 0 {
+		msg.attachments = append(msg.attachments, emailAttachment{
+			fileName:    "tickets-" + confirmation.Reference + ".pdf",
+			contentType: "application/pdf",
+			content:     confirmation.Document,
+		})
+	}
+
+	return s.sendEmail(msg)
+}
+
 func templatesDirPath() string {
 	_, f, _, ok := runtime.Caller(0)
 	if !ok {
@@ -69,6 +142,13 @@ type emailMessage struct {
 	receiver        []string
 	args            interface{}
 	subject         string
+	attachments     []emailAttachment
+}
+
+type emailAttachment struct {
+	fileName    string
+	contentType string
+	content     []byte
 }
 
 
@@ -109,6 +189,13 @@ func (s *EmailNotifier) sendEmail(msg *emailMessage) error {
 		"Content-Type: text/html; charset=UTF-8\r\n"+
 		"\r\n"+body.String(), msg.subject)
 
+	if len(msg.attachments) > 0 {
+		emailMessage, err = multipartMessage(msg, body.String())
+		if err != nil {
+			return err
+		}
+	}
+
 	configs := extractSMTPConfigs(s.configs)
 	auth := smtp.PlainAuth("", configs[0], configs[1], configs[2])
 	err = smtp.SendMail(configs[2]+":"+configs[3], auth, configs[0], msg.receiver, []byte(emailMessage))
@@ -122,6 +209,58 @@ func (s *EmailNotifier) sendEmail(msg *emailMessage) error {
 	return nil
 }
 
+// multipartMessage builds a multipart/mixed message carrying the html body
+// followed by each attachment in base64.
+func multipartMessage(msg *emailMessage, body string) (string, error) {
+	var buffer bytes.Buffer
+	writer := multipart.NewWriter(&buffer)
+
+	fmt.Fprintf(&buffer, "Subject: %s\r\n"+
+		"MIME-Version: 1.0\r\n"+
+		"Content-Type: multipart/mixed; boundary=%s\r\n"+
+		"\r\n", msg.subject, writer.Boundary())
+
+	part, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {"text/html; charset=UTF-8"},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	_, err = part.Write([]byte(body))
+	if err != nil {
+		return "", err
+	}
+
+	for _, attachment := range msg.attachments {
+		part, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {attachment.contentType},
+			"Content-Transfer-Encoding": {"base64"},
+			"Content-Disposition":       {fmt.Sprintf(`attachment; filename="%s"`, attachment.fileName)},
+		})
+		if err != nil {
+			return "", err
+		}
+
+		encoded := base64.StdEncoding.EncodeToString(attachment.content)
+
+		// RFC 2045 limits encoded lines to 76 characters
+		for len(encoded) > 76 {
+			fmt.Fprintf(part, "%s\r\n", encoded[:76])
+			encoded = encoded[76:]
+		}
+
+		fmt.Fprintf(part, "%s\r\n", encoded)
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return "", err
+	}
+
+	return buffer.String(), nil
+}
+
 func extractSMTPConfigs(configs string) []string {
 	GetSMTPConfigs := strings.Split(configs, ",")
 
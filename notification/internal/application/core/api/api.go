@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"notification/internal/application/core/domain"
 	"notification/internal/ports"
 )
 
@@ -22,3 +23,15 @@ func (a *APIAdapter) UserRegistered(ctx context.Context, name, email string) err
 func (a *APIAdapter) UserUpdated(ctx context.Context, name, email string) error {
 	return a.notifier.NotifyUserUpdated(ctx, name, email)
 }
+
+func (a *APIAdapter) TicketTransferRequested(ctx context.Context, transfer *domain.TicketTransfer) error {
+	return a.notifier.NotifyTicketTransferRequested(ctx, transfer)
+}
+
+func (a *APIAdapter) TicketTransferAccepted(ctx context.Context, transfer *domain.TicketTransfer) error {
+	return a.notifier.NotifyTicketTransferAccepted(ctx, transfer)
+}
+
+func (a *APIAdapter) BookingConfirmed(ctx context.Context, confirmation *domain.BookingConfirmation) error {
+	return a.notifier.NotifyBookingConfirmed(ctx, confirmation)
+}
@@ -0,0 +1,18 @@
+package domain
+
+import "time"
+
+// BookingConfirmation is a paid booking, as told by the ticket service,
+// with its printable tickets as a PDF document.
+type BookingConfirmation struct {
+	Reference     string
+	Name          string
+	Email         string
+	TrainName     string
+	Origin        string
+	Destination   string
+	DepartureTime time.Time
+	ArrivalTime   time.Time
+	SeatNumbers   []uint
+	Document      []byte
+}
@@ -0,0 +1,20 @@
+package domain
+
+import "time"
+
+// TicketTransfer is a ticket handed from one user to another, as told by
+// the ticket service.
+type TicketTransfer struct {
+	TransferID    uint
+	TicketID      uint
+	TrainID       uint
+	SeatNumber    uint
+	Origin        string
+	Destination   string
+	DepartureTime time.Time
+	ExpiresAt     time.Time
+	FromName      string
+	FromEmail     string
+	ToName        string
+	ToEmail       string
+}
@@ -1,9 +1,15 @@
 package ports
 
-import "context"
+import (
+	"context"
+	"notification/internal/application/core/domain"
+)
 
 type APIPort interface {
 	UserRegistered(ctx context.Context,name,email string)error
 	UserUpdated(ctx context.Context,name,email string)error
+	TicketTransferRequested(ctx context.Context, transfer *domain.TicketTransfer) error
+	TicketTransferAccepted(ctx context.Context, transfer *domain.TicketTransfer) error
+	BookingConfirmed(ctx context.Context, confirmation *domain.BookingConfirmation) error
 }
 
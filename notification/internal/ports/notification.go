@@ -1,8 +1,14 @@
 package ports
 
-import "context"
+import (
+	"context"
+	"notification/internal/application/core/domain"
+)
 
 type Notify interface {
 	NotifyUserRegistered(ctx context.Context,name,email string)error
 	NotifyUserUpdated(ctx context.Context,name,email string)error
+	NotifyTicketTransferRequested(ctx context.Context, transfer *domain.TicketTransfer) error
+	NotifyTicketTransferAccepted(ctx context.Context, transfer *domain.TicketTransfer) error
+	NotifyBookingConfirmed(ctx context.Context, confirmation *domain.BookingConfirmation) error
 }
\ No newline at end of file
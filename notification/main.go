@@ -31,6 +31,18 @@ func main() {
 		log.Fatalf("Error Jet Stream connection: %v", err)
 	}
 
+	go func() {
+		for err := range eventConsumer.ConsumerTicketEvents(ctx) {
+			log.Printf("Error received: %v\n", err)
+		}
+	}()
+
+	go func() {
+		for err := range eventConsumer.ConsumerBookingEvents(ctx) {
+			log.Printf("Error received: %v\n", err)
+		}
+	}()
+
 	errorStream := eventConsumer.ConsumerUserEvents(ctx)
 	
 	for err := range errorStream {
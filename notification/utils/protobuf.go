@@ -3,6 +3,7 @@ package utils
 import (
 	"fmt"
 	"notification/gen"
+	"notification/internal/application/core/domain"
 
 	"google.golang.org/protobuf/proto"
 )
@@ -20,3 +21,54 @@ func UnMarshalUserData(data []byte) (string, string, error) {
 	return fullname, protoUser.Email, nil
 
 }
+
+func UnmarshalBookingConfirmedNotice(data []byte) (*domain.BookingConfirmation, error) {
+	protoNotice := &gen.BookingConfirmedNotice{}
+
+	err := proto.Unmarshal(data, protoNotice)
+	if err != nil {
+		return nil, err
+	}
+
+	confirmation := &domain.BookingConfirmation{
+		Reference:     protoNotice.Reference,
+		Name:          protoNotice.Name,
+		Email:         protoNotice.Email,
+		TrainName:     protoNotice.TrainName,
+		Origin:        protoNotice.Origin,
+		Destination:   protoNotice.Destination,
+		DepartureTime: protoNotice.DepartureTime.AsTime(),
+		ArrivalTime:   protoNotice.ArrivalTime.AsTime(),
+		Document:      protoNotice.Document,
+	}
+
+	for _, ticket := range protoNotice.Tickets {
+		confirmation.SeatNumbers = append(confirmation.SeatNumbers, uint(ticket.GetSeatNumber()))
+	}
+
+	return confirmation, nil
+}
+
+func UnmarshalTicketTransferNotice(data []byte) (*domain.TicketTransfer, error) {
+	protoNotice := &gen.TicketTransferNotice{}
+
+	err := proto.Unmarshal(data, protoNotice)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.TicketTransfer{
+		TransferID:    uint(protoNotice.Transfer.GetID()),
+		TicketID:      uint(protoNotice.Transfer.GetTicketId()),
+		TrainID:       uint(protoNotice.TrainId),
+		SeatNumber:    uint(protoNotice.SeatNumber),
+		Origin:        protoNotice.Origin,
+		Destination:   protoNotice.Destination,
+		DepartureTime: protoNotice.DepartureTime.AsTime(),
+		ExpiresAt:     protoNotice.Transfer.GetExpiresAt().AsTime(),
+		FromName:      protoNotice.FromName,
+		FromEmail:     protoNotice.FromEmail,
+		ToName:        protoNotice.ToName,
+		ToEmail:       protoNotice.ToEmail,
+	}, nil
+}
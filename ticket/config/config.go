@@ -2,6 +2,8 @@ package config
 
 import (
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -18,4 +20,94 @@ func GetDatabaseURL() string {
 
 func GetNatsURL() string {
 	return os.Getenv("NATS_URL")
-}
\ No newline at end of file
+}
+
+// GetSeatHoldDuration returns how long held seats stay reserved before they
+// are released, SEAT_HOLD_MINUTES defaults to 10.
+func GetSeatHoldDuration() time.Duration {
+	minutes, err := strconv.Atoi(os.Getenv("SEAT_HOLD_MINUTES"))
+	if err != nil || minutes <= 0 {
+		return 10 * time.Minute
+	}
+
+	return time.Duration(minutes) * time.Minute
+}
+
+// GetTicketTransferDuration returns how long a recipient has to accept a
+// ticket transfer, TICKET_TRANSFER_HOURS defaults to 24.
+func GetTicketTransferDuration() time.Duration {
+	hours, err := strconv.Atoi(os.Getenv("TICKET_TRANSFER_HOURS"))
+	if err != nil || hours <= 0 {
+		return 24 * time.Hour
+	}
+
+	return time.Duration(hours) * time.Hour
+}
+
+// GetTicketPrice returns the standard fare of routes missing from the fare
+// table in minor currency units, TICKET_PRICE_CENTS defaults to 1000.
+func GetTicketPrice() int64 {
+	price, err := strconv.ParseInt(os.Getenv("TICKET_PRICE_CENTS"), 10, 64)
+	if err != nil || price < 0 {
+		return 1000
+	}
+
+	return price
+}
+
+func GetCurrency() string {
+	currency := os.Getenv("CURRENCY")
+	if currency == "" {
+		return "USD"
+	}
+
+	return currency
+}
+
+// GetPaymentMode selects the behaviour of the fake payment provider:
+// success, decline or timeout.
+func GetPaymentMode() string {
+	mode := os.Getenv("PAYMENT_MODE")
+	if mode == "" {
+		return "success"
+	}
+
+	return mode
+}
+
+func GetRefundPolicyPath() string {
+	path := os.Getenv("REFUND_POLICY_FILE")
+	if path == "" {
+		return "refund_policy.json"
+	}
+
+	return path
+}
+
+// GetFareTablePath points at the JSON fare table. Without the file every
+// route costs the ticket price under the default class multipliers and
+// passenger discounts.
+func GetFareTablePath() string {
+	path := os.Getenv("FARE_TABLE_FILE")
+	if path == "" {
+		return "fare_table.json"
+	}
+
+	return path
+}
+
+// GetTicketSigningKeyPath points at the base64 Ed25519 seed used to sign
+// e-tickets. TICKET_SIGNING_KEY_FILE has no default, the key belongs
+// outside the repository.
+func GetTicketSigningKeyPath() string {
+	return os.Getenv("TICKET_SIGNING_KEY_FILE")
+}
+
+// GetGenerateTicketSigningKey tells whether a missing signing key file may
+// be created with a new key. TICKET_SIGNING_KEY_GENERATE is meant for
+// development and defaults to false.
+func GetGenerateTicketSigningKey() bool {
+	generate, err := strconv.ParseBool(os.Getenv("TICKET_SIGNING_KEY_GENERATE"))
+
+	return err == nil && generate
+}
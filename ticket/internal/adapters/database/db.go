@@ -9,7 +9,7 @@ import (
 )
 
 func NewDB(url string) (*gorm.DB, error) {
-	db, openErr := gorm.Open(postgres.Open((url)), &gorm.Config{})
+	db, openErr := gorm.Open(postgres.Open((url)), &gorm.Config{TranslateError: true})
 	if openErr != nil {
 		return nil, fmt.Errorf("db connection error: %v", openErr)
 	}
@@ -19,6 +19,72 @@ func NewDB(url string) (*gorm.DB, error) {
 		return nil, err
 	}
 
+	err = backfillTicketStatus(db)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := migrate(db, &domain.TicketSeatLeg{}); err != nil {
+		return nil, err
+	}
+
+	err = backfillTicketSeatLegs(db)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := migrate(db, &domain.TicketHistory{}); err != nil {
+		return nil, err
+	}
+	if err := migrate(db, &domain.Booking{}); err != nil {
+		return nil, err
+	}
+	if err := migrate(db, &domain.BookingSaga{}); err != nil {
+		return nil, err
+	}
+	if err := migrate(db, &domain.BookingSagaSeat{}); err != nil {
+		return nil, err
+	}
+	if err := migrate(db, &domain.Payment{}); err != nil {
+		return nil, err
+	}
+	if err := migrate(db, &domain.Refund{}); err != nil {
+		return nil, err
+	}
+	if err := migrate(db, &domain.Cart{}); err != nil {
+		return nil, err
+	}
+	if err := migrate(db, &domain.CartLeg{}); err != nil {
+		return nil, err
+	}
+	if err := migrate(db, &domain.SeatHold{}); err != nil {
+		return nil, err
+	}
+	if err := migrate(db, &domain.SeatHoldSeat{}); err != nil {
+		return nil, err
+	}
+	if err := migrate(db, &domain.WaitlistEntry{}); err != nil {
+		return nil, err
+	}
+	if err := migrate(db, &domain.TicketTransfer{}); err != nil {
+		return nil, err
+	}
+	if err := migrate(db, &domain.PromoCode{}); err != nil {
+		return nil, err
+	}
+	if err := migrate(db, &domain.PromoRestriction{}); err != nil {
+		return nil, err
+	}
+	if err := migrate(db, &domain.PromoRedemption{}); err != nil {
+		return nil, err
+	}
+	if err := migrate(db, &domain.IdempotencyRecord{}); err != nil {
+		return nil, err
+	}
+	if err := migrate(db, &domain.OutboxMessage{}); err != nil {
+		return nil, err
+	}
+
 	return db, nil
 }
 
@@ -29,3 +95,39 @@ func migrate(db *gorm.DB, model any) error {
 
 	return nil
 }
+
+// backfillTicketStatus gives tickets created before the status column a
+// status derived from their cancellation time.
+func backfillTicketStatus(db *gorm.DB) error {
+	err := db.Model(&domain.Ticket{}).Where("status IS NULL OR status = ''").
+		Update("status", gorm.Expr("CASE WHEN canceled_at IS NULL THEN ? ELSE ? END", domain.TicketConfirmed, domain.TicketCanceled)).Error
+	if err != nil {
+		return fmt.Errorf("db migration error: %v", err)
+	}
+
+	return nil
+}
+
+// backfillTicketSeatLegs moves the seats of confirmed and checked-in
+// tickets from the single seat index of whole route tickets to the legs they
+// claim. Those tickets are all for the first leg, the only one their trains
+// had.
+func backfillTicketSeatLegs(db *gorm.DB) error {
+	if db.Migrator().HasIndex(&domain.Ticket{}, "idx_tickets_active_seat") {
+		err := db.Migrator().DropIndex(&domain.Ticket{}, "idx_tickets_active_seat")
+		if err != nil {
+			return fmt.Errorf("db migration error: %v", err)
+		}
+	}
+
+	err := db.Exec(`INSERT INTO ticket_seat_legs (ticket_id, seat_id, leg)
+		SELECT id, seat_id, 0 FROM tickets
+		WHERE status IN ? AND to_stop = 0
+		AND NOT EXISTS (SELECT 1 FROM ticket_seat_legs WHERE ticket_seat_legs.ticket_id = tickets.id)`,
+		[]domain.TicketStatus{domain.TicketConfirmed, domain.TicketCheckedIn}).Error
+	if err != nil {
+		return fmt.Errorf("db migration error: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"ticket/internal/application/core/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// maxBookingReferenceAttempts bounds the retries on a reference collision,
+// which is rare with 32^6 possible references.
+const maxBookingReferenceAttempts = 5
+
+// createBooking inserts the booking, drawing a new reference whenever the
+// current one is already taken.
+func createBooking(tx *gorm.DB, booking *domain.Booking) error {
+	for attempt := 0; attempt < maxBookingReferenceAttempts; attempt++ {
+		result := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "reference"}},
+			DoNothing: true,
+		}).Create(booking)
+		if result.Error != nil {
+			return fmt.Errorf("failed to create booking: saga ID:%d %w", booking.SagaID, result.Error)
+		}
+
+		if result.RowsAffected == 1 {
+			return nil
+		}
+
+		booking.Reference = domain.NewBookingReference()
+	}
+
+	return fmt.Errorf("failed to create booking: saga ID:%d no free booking reference", booking.SagaID)
+}
+
+func (r *PostgresDBAdapter) GetBookingByReference(ctx context.Context, reference string) (*domain.Booking, error) {
+	var booking domain.Booking
+
+	err := r.db.WithContext(ctx).Where("reference = ?", reference).First(&booking).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find booking by reference %s: %w", reference, err)
+	}
+
+	return r.loadBookingTickets(ctx, &booking)
+}
+
+// GetBookingBySagaID returns the booking made by the saga with its tickets.
+func (r *PostgresDBAdapter) GetBookingBySagaID(ctx context.Context, sagaID uint) (*domain.Booking, error) {
+	var booking domain.Booking
+
+	err := r.db.WithContext(ctx).Where("saga_id = ?", sagaID).First(&booking).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find booking by saga ID %d: %w", sagaID, err)
+	}
+
+	return r.loadBookingTickets(ctx, &booking)
+}
+
+func (r *PostgresDBAdapter) loadBookingTickets(ctx context.Context, booking *domain.Booking) (*domain.Booking, error) {
+	err := r.db.WithContext(ctx).Preload("History", orderHistory).
+		Where("booking_reference = ?", booking.Reference).
+		Order("id").
+		Find(&booking.Tickets).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tickets of booking %s: %w", booking.Reference, err)
+	}
+
+	return booking, nil
+}
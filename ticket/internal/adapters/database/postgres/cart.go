@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ticket/internal/application/core/domain"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func (r *PostgresDBAdapter) CreateCart(ctx context.Context, cart *domain.Cart) error {
+	err := r.db.WithContext(ctx).Create(cart).Error
+	if err != nil {
+		return fmt.Errorf("failed to create cart: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresDBAdapter) GetCartByID(ctx context.Context, cartID uint) (*domain.Cart, error) {
+	var cart domain.Cart
+
+	err := r.db.WithContext(ctx).Preload("Legs", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id")
+	}).First(&cart, cartID).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find cart by ID %d: %w", cartID, err)
+	}
+
+	return &cart, nil
+}
+
+// AddCartLeg adds the leg to its cart. A train can only be in a cart once,
+// the unique index settles concurrent additions of the same train.
+func (r *PostgresDBAdapter) AddCartLeg(ctx context.Context, leg *domain.CartLeg) error {
+	err := r.db.WithContext(ctx).Create(leg).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return fmt.Errorf("%w: train %d", domain.ErrCartLegExists, leg.TrainID)
+	} else if err != nil {
+		return fmt.Errorf("failed to add cart leg: cart ID:%d %w", leg.CartID, err)
+	}
+
+	return nil
+}
+
+func (r *PostgresDBAdapter) DeleteCartLeg(ctx context.Context, cartID, legID uint) error {
+	result := r.db.WithContext(ctx).Where("id = ? AND cart_id = ?", legID, cartID).Delete(&domain.CartLeg{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete cart leg: cart ID:%d leg ID:%d %w", cartID, legID, result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: leg %d, cart %d", domain.ErrCartLegNotFound, legID, cartID)
+	}
+
+	return nil
+}
+
+// UpdateCartStatus moves the cart from its current status to status. Only
+// one caller can move a cart on, every other one gets domain.ErrCartNotOpen.
+func (r *PostgresDBAdapter) UpdateCartStatus(ctx context.Context, cart *domain.Cart, status domain.CartStatus, bookingReference string) error {
+	result := r.db.WithContext(ctx).Model(&domain.Cart{}).
+		Where("id = ? AND status = ?", cart.ID, cart.Status).
+		Updates(map[string]any{
+			"status":            status,
+			"booking_reference": bookingReference,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update cart status: cart ID:%d %w", cart.ID, result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrCartNotOpen
+	}
+
+	cart.Status = status
+	cart.BookingReference = bookingReference
+
+	return nil
+}
+
+// ListStaleCartCheckouts lists the carts checking out since before
+// staleBefore that have no unfinished saga left, so no instance is still
+// booking them.
+func (r *PostgresDBAdapter) ListStaleCartCheckouts(ctx context.Context, staleBefore time.Time) ([]domain.Cart, error) {
+	var carts []domain.Cart
+
+	unfinished := []domain.SagaStatus{domain.SagaStarted, domain.SagaCompensating}
+
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND updated_at < ?", domain.CartCheckingOut, staleBefore).
+		Where("NOT EXISTS (SELECT 1 FROM booking_sagas WHERE booking_sagas.cart_id = carts.id AND booking_sagas.status IN ?)", unfinished).
+		Order("id").Find(&carts).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stale cart checkouts: %w", err)
+	}
+
+	return carts, nil
+}
+
+// GetCartBookingReference returns the reference of the booking a completed
+// saga made for the cart, or an empty reference when none did.
+func (r *PostgresDBAdapter) GetCartBookingReference(ctx context.Context, cartID uint) (string, error) {
+	var references []string
+
+	err := r.db.WithContext(ctx).Model(&domain.Booking{}).
+		Joins("JOIN booking_sagas ON booking_sagas.id = bookings.saga_id").
+		Where("booking_sagas.cart_id = ? AND booking_sagas.status = ?", cartID, domain.SagaCompleted).
+		Limit(1).Pluck("bookings.reference", &references).Error
+	if err != nil {
+		return "", fmt.Errorf("failed to find booking of cart %d: %w", cartID, err)
+	}
+
+	if len(references) == 0 {
+		return "", nil
+	}
+
+	return references[0], nil
+}
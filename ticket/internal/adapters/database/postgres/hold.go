@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"ticket/internal/application/core/domain"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func (r *PostgresDBAdapter) CreateSeatHold(ctx context.Context, hold *domain.SeatHold) error {
+	err := r.db.WithContext(ctx).Create(hold).Error
+	if err != nil {
+		return fmt.Errorf("failed to create seat hold: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresDBAdapter) GetSeatHoldByID(ctx context.Context, holdID uint) (*domain.SeatHold, error) {
+	var hold domain.SeatHold
+
+	err := r.db.WithContext(ctx).Preload("Seats").First(&hold, holdID).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find seat hold by ID %d: %w", holdID, err)
+	}
+
+	return &hold, nil
+}
+
+// ClaimSeatHold moves an unexpired hold to confirmed. Only one caller can
+// claim a hold, every other one gets domain.ErrSeatHoldNotActive.
+func (r *PostgresDBAdapter) ClaimSeatHold(ctx context.Context, hold *domain.SeatHold, now time.Time) error {
+	result := r.db.WithContext(ctx).Model(&domain.SeatHold{}).
+		Where("id = ? AND status = ? AND expires_at > ?", hold.ID, domain.SeatHoldHeld, now).
+		Update("status", domain.SeatHoldConfirmed)
+	if result.Error != nil {
+		return fmt.Errorf("failed to claim seat hold: hold ID:%d %w", hold.ID, result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrSeatHoldNotActive
+	}
+
+	hold.Status = domain.SeatHoldConfirmed
+
+	return nil
+}
+
+// ReleaseSeatHold moves the hold from its current status to status and
+// writes the seat release events in the same transaction.
+func (r *PostgresDBAdapter) ReleaseSeatHold(ctx context.Context, hold *domain.SeatHold, status domain.SeatHoldStatus, messages []domain.OutboxMessage) error {
+	err := withTx(r.db.WithContext(ctx), func(tx *gorm.DB) error {
+		result := tx.Model(&domain.SeatHold{}).
+			Where("id = ? AND status = ?", hold.ID, hold.Status).
+			Update("status", status)
+		if result.Error != nil {
+			return fmt.Errorf("failed to release seat hold: hold ID:%d %w", hold.ID, result.Error)
+		}
+
+		if result.RowsAffected == 0 {
+			return domain.ErrSeatHoldNotActive
+		}
+
+		// A waitlist offer ends together with the hold behind it
+		err := closeWaitlistOffer(tx, hold.ID, domain.WaitlistExpired)
+		if err != nil {
+			return err
+		}
+
+		return createOutboxMessages(tx, messages)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	hold.Status = status
+
+	return nil
+}
+
+func (r *PostgresDBAdapter) ListExpiredSeatHolds(ctx context.Context, now time.Time) ([]domain.SeatHold, error) {
+	var holds []domain.SeatHold
+
+	err := r.db.WithContext(ctx).Preload("Seats").
+		Where("status = ? AND expires_at <= ?", domain.SeatHoldHeld, now).
+		Find(&holds).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expired seat holds: %w", err)
+	}
+
+	return holds, nil
+}
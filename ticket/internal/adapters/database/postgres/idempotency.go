@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"ticket/internal/application/core/domain"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// CreateIdempotencyRecord stores a new record, or returns
+// domain.ErrIdempotencyKeyExists when the key is already taken.
+func (r *PostgresDBAdapter) CreateIdempotencyRecord(ctx context.Context, record *domain.IdempotencyRecord) error {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(record)
+	if result.Error != nil {
+		return fmt.Errorf("failed to create idempotency record: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrIdempotencyKeyExists
+	}
+
+	return nil
+}
+
+func (r *PostgresDBAdapter) GetIdempotencyRecord(ctx context.Context, scope, key string) (*domain.IdempotencyRecord, error) {
+	var record domain.IdempotencyRecord
+
+	err := r.db.WithContext(ctx).Where("scope = ? AND key = ?", scope, key).First(&record).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find idempotency record %s/%s: %w", scope, key, err)
+	}
+
+	return &record, nil
+}
+
+// TakeOverIdempotencyRecord hands a pending record to a new attempt with a
+// fresh token and lease, or returns domain.ErrIdempotencyKeyInProgress when
+// another retry took it over first.
+func (r *PostgresDBAdapter) TakeOverIdempotencyRecord(ctx context.Context, record *domain.IdempotencyRecord, now time.Time) error {
+	token := domain.NewIdempotencyToken()
+
+	result := r.db.WithContext(ctx).Model(&domain.IdempotencyRecord{}).
+		Where("id = ? AND status = ? AND token = ?", record.ID, domain.IdempotencyPending, record.Token).
+		Updates(map[string]any{
+			"token":      token,
+			"updated_at": now,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to take over idempotency record: record ID:%d %w", record.ID, result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrIdempotencyKeyInProgress
+	}
+
+	record.Token = token
+	record.UpdatedAt = now
+
+	return nil
+}
+
+// CompleteIdempotencyRecord stores the response of the attempt holding the
+// record, or returns domain.ErrIdempotencyLeaseLost when a retry took the
+// record over.
+func (r *PostgresDBAdapter) CompleteIdempotencyRecord(ctx context.Context, record *domain.IdempotencyRecord, response []byte) error {
+	result := r.db.WithContext(ctx).Model(&domain.IdempotencyRecord{}).
+		Where("id = ? AND token = ?", record.ID, record.Token).
+		Updates(map[string]any{
+			"status":   domain.IdempotencyCompleted,
+			"response": response,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to complete idempotency record: record ID:%d %w", record.ID, result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrIdempotencyLeaseLost
+	}
+
+	return nil
+}
+
+// DeleteIdempotencyRecord frees the key held by the attempt, or returns
+// domain.ErrIdempotencyLeaseLost when a retry took the record over.
+func (r *PostgresDBAdapter) DeleteIdempotencyRecord(ctx context.Context, record *domain.IdempotencyRecord) error {
+	result := r.db.WithContext(ctx).Where("token = ?", record.Token).Delete(&domain.IdempotencyRecord{}, record.ID)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete idempotency record: record ID:%d %w", record.ID, result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrIdempotencyLeaseLost
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"ticket/internal/application/core/domain"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func createOutboxMessages(tx *gorm.DB, messages []domain.OutboxMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	err := tx.Create(&messages).Error
+	if err != nil {
+		return fmt.Errorf("failed to create outbox messages: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimOutboxMessages claims up to limit due messages, each the oldest
+// pending message of its aggregate, and leases them for
+// domain.OutboxClaimLease. Other relays skip a leased message, so it can be
+// published after the claim commits without two instances sending it at
+// once.
+func (r *PostgresDBAdapter) ClaimOutboxMessages(ctx context.Context, limit int, now time.Time) ([]domain.OutboxMessage, error) {
+	var messages []domain.OutboxMessage
+
+	err := withTx(r.db.WithContext(ctx), func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("sent_at IS NULL AND dead_at IS NULL").
+			Where("(next_attempt_at IS NULL OR next_attempt_at <= ?)", now).
+			Where(`NOT EXISTS (SELECT 1 FROM outbox_messages earlier
+				WHERE earlier.aggregate_key = outbox_messages.aggregate_key AND earlier.id < outbox_messages.id
+				AND earlier.sent_at IS NULL AND earlier.dead_at IS NULL)`).
+			Order("id").Limit(limit).Find(&messages).Error
+		if err != nil {
+			return fmt.Errorf("failed to claim outbox messages: %w", err)
+		}
+
+		if len(messages) == 0 {
+			return nil
+		}
+
+		messageIDs := make([]uint, 0, len(messages))
+		for i := range messages {
+			messages[i].Claim(now)
+			messageIDs = append(messageIDs, messages[i].ID)
+		}
+
+		err = tx.Model(&domain.OutboxMessage{}).Where("id IN ?", messageIDs).Update("next_attempt_at", messages[0].NextAttemptAt).Error
+		if err != nil {
+			return fmt.Errorf("failed to lease outbox messages: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+// SaveOutboxMessage saves the outcome of publishing a claimed message.
+func (r *PostgresDBAdapter) SaveOutboxMessage(ctx context.Context, message *domain.OutboxMessage) error {
+	err := r.db.WithContext(ctx).Model(&domain.OutboxMessage{}).Where("id = ?", message.ID).Updates(map[string]any{
+		"attempts":        message.Attempts,
+		"last_error":      message.LastError,
+		"next_attempt_at": message.NextAttemptAt,
+		"sent_at":         message.SentAt,
+		"dead_at":         message.DeadAt,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to save outbox message %d: %w", message.ID, err)
+	}
+
+	return nil
+}
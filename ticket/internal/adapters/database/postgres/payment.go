@@ -0,0 +1,133 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ticket/internal/application/core/domain"
+
+	"gorm.io/gorm"
+)
+
+func (r *PostgresDBAdapter) CreatePayment(ctx context.Context, payment *domain.Payment) error {
+	err := r.db.WithContext(ctx).Create(payment).Error
+	if err != nil {
+		return fmt.Errorf("failed to create payment: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresDBAdapter) GetPaymentByID(ctx context.Context, paymentID uint) (*domain.Payment, error) {
+	var payment domain.Payment
+
+	err := r.db.WithContext(ctx).First(&payment, paymentID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("%w: ID %d", domain.ErrPaymentNotFound, paymentID)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to find payment by ID %d: %w", paymentID, err)
+	}
+
+	return &payment, nil
+}
+
+func (r *PostgresDBAdapter) GetPaymentBySagaID(ctx context.Context, sagaID uint) (*domain.Payment, error) {
+	var payment domain.Payment
+
+	err := r.db.WithContext(ctx).Where("saga_id = ?", sagaID).First(&payment).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("%w: saga ID %d", domain.ErrPaymentNotFound, sagaID)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to find payment by saga ID %d: %w", sagaID, err)
+	}
+
+	return &payment, nil
+}
+
+func (r *PostgresDBAdapter) UpdatePaymentState(ctx context.Context, paymentID uint, state domain.PaymentState) error {
+	err := r.db.WithContext(ctx).Model(&domain.Payment{}).Where("id = ?", paymentID).Update("state", state).Error
+	if err != nil {
+		return fmt.Errorf("failed to update payment state: payment ID:%d %w", paymentID, err)
+	}
+
+	return nil
+}
+
+// CreateRefund saves the refund and sets its amount aside on the payment,
+// so the same money is not refunded twice.
+func (r *PostgresDBAdapter) CreateRefund(ctx context.Context, refund *domain.Refund) error {
+	return withTx(r.db.WithContext(ctx), func(tx *gorm.DB) error {
+		return createRefund(tx, refund)
+	})
+}
+
+func createRefund(tx *gorm.DB, refund *domain.Refund) error {
+	err := tx.Create(refund).Error
+	if err != nil {
+		return fmt.Errorf("failed to create refund: payment ID:%d %w", refund.PaymentID, err)
+	}
+
+	err = tx.Model(&domain.Payment{}).Where("id = ?", refund.PaymentID).
+		Update("refunded_amount", gorm.Expr("refunded_amount + ?", refund.Amount)).Error
+	if err != nil {
+		return fmt.Errorf("failed to refund payment: payment ID:%d %w", refund.PaymentID, err)
+	}
+
+	return nil
+}
+
+func (r *PostgresDBAdapter) ListPendingRefunds(ctx context.Context, limit int) ([]domain.Refund, error) {
+	var refunds []domain.Refund
+
+	err := r.db.WithContext(ctx).Where("state = ?", domain.RefundPending).Order("id").Limit(limit).Find(&refunds).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending refunds: %w", err)
+	}
+
+	return refunds, nil
+}
+
+// CountUnpaidRefundsByTicketID counts the refunds of the ticket that are
+// pending or were given up.
+func (r *PostgresDBAdapter) CountUnpaidRefundsByTicketID(ctx context.Context, ticketID uint) (int64, error) {
+	var count int64
+
+	err := r.db.WithContext(ctx).Model(&domain.Refund{}).
+		Where("ticket_id = ? AND state <> ?", ticketID, domain.RefundCompleted).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unpaid refunds: ticket ID:%d %w", ticketID, err)
+	}
+
+	return count, nil
+}
+
+// CompleteRefund marks the refund paid. A refund that is no longer pending
+// is left as it is.
+func (r *PostgresDBAdapter) CompleteRefund(ctx context.Context, refundID uint) error {
+	err := r.db.WithContext(ctx).Model(&domain.Refund{}).
+		Where("id = ? AND state = ?", refundID, domain.RefundPending).
+		Update("state", domain.RefundCompleted).Error
+	if err != nil {
+		return fmt.Errorf("failed to complete refund %d: %w", refundID, err)
+	}
+
+	return nil
+}
+
+// MarkRefundFailed records a failed attempt to pay the refund, giving the
+// refund up once it reaches the attempt limit.
+func (r *PostgresDBAdapter) MarkRefundFailed(ctx context.Context, refundID uint, reason string) error {
+	err := r.db.WithContext(ctx).Model(&domain.Refund{}).
+		Where("id = ? AND state = ?", refundID, domain.RefundPending).
+		Updates(map[string]any{
+			"attempts":   gorm.Expr("attempts + 1"),
+			"last_error": reason,
+			"state":      gorm.Expr("CASE WHEN attempts + 1 >= ? THEN ? ELSE state END", domain.MaxRefundAttempts, domain.RefundFailed),
+		}).Error
+	if err != nil {
+		return fmt.Errorf("failed to mark refund %d as failed: %w", refundID, err)
+	}
+
+	return nil
+}
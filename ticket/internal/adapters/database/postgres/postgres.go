@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"ticket/internal/application/core/domain"
-	"ticket/internal/ports"
 	"time"
 
 	"gorm.io/gorm"
@@ -20,23 +19,9 @@ func NewPostgresDBAdapter(db *gorm.DB) *PostgresDBAdapter {
 	}
 }
 
-func (r *PostgresDBAdapter) CreateTicket(ctx context.Context, ticket *domain.Ticket) (ports.Tx, error) {
-	tx, err := begin(r.db)
-	if err != nil {
-		return nil, err
-	}
-
-	err = tx.db.WithContext(ctx).Create(ticket).Error
-	if err != nil {
-		return nil, fmt.Errorf("failed to create ticket: %w", err)
-	}
-
-	return tx, nil
-}
-
 func (r *PostgresDBAdapter) GetTicketByID(ctx context.Context, ID uint) (*domain.Ticket, error) {
 	var ticket domain.Ticket
-	err := r.db.WithContext(ctx).First(&ticket, ID).Error
+	err := r.db.WithContext(ctx).Preload("History", orderHistory).First(&ticket, ID).Error
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get ticket by ID: %w", err)
@@ -45,38 +30,160 @@ func (r *PostgresDBAdapter) GetTicketByID(ctx context.Context, ID uint) (*domain
 	return &ticket, nil
 }
 
-func (r *PostgresDBAdapter) GetTicketsByUserID(ctx context.Context, userID uint) ([]domain.Ticket, error) {
+// ListTickets lists a page of the tickets matching the filter, fetching
+// one ticket more than the page holds to tell whether another page
+// follows. Pages continue after the cursor in the order of the sort.
+func (r *PostgresDBAdapter) ListTickets(ctx context.Context, filter *domain.TicketFilter, now time.Time) (*domain.TicketPage, error) {
 	var tickets []domain.Ticket
 
-	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Find(&tickets).Error
+	query := r.db.WithContext(ctx).Preload("History", orderHistory)
+
+	if filter.UserID != 0 {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+
+	if filter.TrainID != 0 {
+		query = query.Where("train_id = ?", filter.TrainID)
+	}
+
+	switch filter.Timeframe {
+	case domain.TicketsUpcoming:
+		query = query.Where("expires_at > ?", now)
+	case domain.TicketsPast:
+		query = query.Where("expires_at <= ?", now)
+	}
+
+	if len(filter.Statuses) > 0 {
+		query = query.Where("status IN ?", filter.Statuses)
+	}
+
+	if !filter.DepartureFrom.IsZero() {
+		query = query.Where("departure_time >= ?", filter.DepartureFrom)
+	}
+
+	if !filter.DepartureTo.IsZero() {
+		query = query.Where("departure_time < ?", filter.DepartureTo)
+	}
+
+	if filter.Origin != "" {
+		query = query.Where("origin = ?", filter.Origin)
+	}
+
+	if filter.Destination != "" {
+		query = query.Where("destination = ?", filter.Destination)
+	}
+
+	if filter.Canceled != nil {
+		if *filter.Canceled {
+			query = query.Where("canceled_at IS NOT NULL")
+		} else {
+			query = query.Where("canceled_at IS NULL")
+		}
+	}
+
+	column, direction, comparison := "created_at", "ASC", ">"
+	if filter.Sort == domain.SortByDeparture || filter.Sort == domain.SortByDepartureDesc {
+		column = "departure_time"
+	}
+
+	if filter.Sort.Descending() {
+		direction, comparison = "DESC", "<"
+	}
+
+	if filter.Cursor != "" {
+		cursor, err := domain.DecodeTicketCursor(filter.Cursor, filter.Sort)
+		if err != nil {
+			return nil, err
+		}
+
+		query = query.Where(fmt.Sprintf("(%s, id) %s (?, ?)", column, comparison), cursor.Key, cursor.ID)
+	}
+
+	err := query.Order(fmt.Sprintf("%s %s, id %s", column, direction, direction)).Limit(filter.Limit + 1).Find(&tickets).Error
 	if err != nil {
-		return nil, fmt.Errorf("failed to list tickets by user id %d: %w", userID, err)
+		return nil, fmt.Errorf("failed to list tickets: %w", err)
 	}
 
-	return tickets, nil
+	page := &domain.TicketPage{Tickets: tickets}
+
+	if len(tickets) > filter.Limit {
+		page.Tickets = tickets[:filter.Limit]
+		page.NextCursor = domain.NewTicketCursor(filter.Sort, &page.Tickets[filter.Limit-1]).Encode()
+	}
+
+	return page, nil
 }
 
-func (r *PostgresDBAdapter) GetTicketsByTrainID(ctx context.Context, trainID uint) ([]domain.Ticket, error) {
+// TransitionTicket stores the new status of the ticket with its history
+// entries, its pending refunds and outbox messages in one transaction. The
+// update only applies when the ticket is still in the status the first
+// history entry moved it from.
+func (r *PostgresDBAdapter) TransitionTicket(ctx context.Context, ticket *domain.Ticket, history []domain.TicketHistory, refunds []domain.Refund, messages []domain.OutboxMessage) error {
+	if len(history) == 0 {
+		return fmt.Errorf("no status transition for ticket %d", ticket.ID)
+	}
+
+	return withTx(r.db.WithContext(ctx), func(tx *gorm.DB) error {
+		return transitionTicket(tx, ticket, history, refunds, messages)
+	})
+}
+
+// ListExpiredTickets lists up to limit tickets still open for travel whose
+// train arrived by now, so that tickets can be checked in on board. Tickets
+// without an arrival time expire at departure. Both times are compared on
+// their own so the expires_at index narrows the scan.
+func (r *PostgresDBAdapter) ListExpiredTickets(ctx context.Context, now time.Time, limit int) ([]domain.Ticket, error) {
 	var tickets []domain.Ticket
 
-	err := r.db.WithContext(ctx).Where("train_id = ?", trainID).Find(&tickets).Error
+	err := r.db.WithContext(ctx).Preload("History", orderHistory).
+		Where("status IN ? AND expires_at <= ? AND arrival_time <= ?", []domain.TicketStatus{domain.TicketConfirmed, domain.TicketCheckedIn}, now, now).
+		Order("id").Limit(limit).Find(&tickets).Error
 	if err != nil {
-		return nil, fmt.Errorf("failed to list tickets by train id %d: %w", trainID, err)
+		return nil, fmt.Errorf("failed to list expired tickets: %w", err)
 	}
 
 	return tickets, nil
 }
 
-func (r *PostgresDBAdapter) UpdateCanceledAt(ctx context.Context, ticketID uint, canceledAt time.Time) error {
-	tx, err := begin(r.db)
-	if err != nil {
-		return err
+func transitionTicket(tx *gorm.DB, ticket *domain.Ticket, history []domain.TicketHistory, refunds []domain.Refund, messages []domain.OutboxMessage) error {
+	result := tx.Model(&domain.Ticket{}).
+		Where("id = ? AND status = ?", ticket.ID, history[0].FromStatus).
+		Updates(map[string]any{
+			"status":         ticket.Status,
+			"payment_status": ticket.PaymentStatus,
+			"canceled_at":    ticket.CanceledAt,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update ticket status by ticket id %d: %w", ticket.ID, result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: ticket %d is no longer %s", domain.ErrInvalidTicketTransition, ticket.ID, history[0].FromStatus)
 	}
 
-	err = tx.db.WithContext(ctx).Model(&domain.Ticket{}).Where("ID = ?", ticketID).Update("canceled_at", canceledAt).Error
+	err := tx.Create(&history).Error
 	if err != nil {
-		return fmt.Errorf("failed to update canceled_at field by ticket id %d: %w", ticketID, err)
+		return fmt.Errorf("failed to create ticket history: ticket ID:%d %w", ticket.ID, err)
 	}
 
-	return nil
+	// Canceled and exchanged tickets give their seat back on every leg
+	if ticket.CanceledAt != nil {
+		err := tx.Where("ticket_id = ?", ticket.ID).Delete(&domain.TicketSeatLeg{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to release seat: ticket ID:%d %w", ticket.ID, err)
+		}
+	}
+
+	for i := range refunds {
+		err := createRefund(tx, &refunds[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	return createOutboxMessages(tx, messages)
+}
+
+func orderHistory(db *gorm.DB) *gorm.DB {
+	return db.Order("ticket_histories.id")
 }
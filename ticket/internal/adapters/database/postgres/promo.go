@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ticket/internal/application/core/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (r *PostgresDBAdapter) CreatePromoCode(ctx context.Context, promo *domain.PromoCode) error {
+	err := r.db.WithContext(ctx).Create(promo).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return fmt.Errorf("%w: %s", domain.ErrPromoCodeExists, promo.Code)
+	} else if err != nil {
+		return fmt.Errorf("failed to create promo code %s: %w", promo.Code, err)
+	}
+
+	return nil
+}
+
+func (r *PostgresDBAdapter) GetPromoCodeByCode(ctx context.Context, code string) (*domain.PromoCode, error) {
+	var promo domain.PromoCode
+
+	err := r.db.WithContext(ctx).Preload("Restrictions").Where("code = ?", code).First(&promo).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("%w: %s", domain.ErrPromoCodeNotFound, code)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to find promo code %s: %w", code, err)
+	}
+
+	return &promo, nil
+}
+
+func (r *PostgresDBAdapter) ListPromoCodes(ctx context.Context) ([]domain.PromoCode, error) {
+	var promos []domain.PromoCode
+
+	err := r.db.WithContext(ctx).Preload("Restrictions").Order("id").Find(&promos).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list promo codes: %w", err)
+	}
+
+	return promos, nil
+}
+
+func (r *PostgresDBAdapter) DeactivatePromoCode(ctx context.Context, code string) error {
+	result := r.db.WithContext(ctx).Model(&domain.PromoCode{}).Where("code = ?", code).Update("active", false)
+	if result.Error != nil {
+		return fmt.Errorf("failed to deactivate promo code %s: %w", code, result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: %s", domain.ErrPromoCodeNotFound, code)
+	}
+
+	return nil
+}
+
+// redeemPromoCode counts the use of the code against its limits. The code
+// row stays locked until the transaction ends, so concurrent bookings
+// redeem it one after the other and never past its limits.
+func redeemPromoCode(tx *gorm.DB, redemption *domain.PromoRedemption) error {
+	var promo domain.PromoCode
+
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&promo, redemption.PromoCodeID).Error
+	if err != nil {
+		return fmt.Errorf("failed to lock promo code %d: %w", redemption.PromoCodeID, err)
+	}
+
+	if promo.MaxUses != 0 && promo.Uses >= promo.MaxUses {
+		return fmt.Errorf("%w: %s", domain.ErrPromoCodeExhausted, promo.Code)
+	}
+
+	if promo.MaxUsesPerUser != 0 {
+		var userUses int64
+
+		err := tx.Model(&domain.PromoRedemption{}).
+			Where("promo_code_id = ? AND user_id = ?", promo.ID, redemption.UserID).
+			Count(&userUses).Error
+		if err != nil {
+			return fmt.Errorf("failed to count promo code redemptions: promo code ID:%d %w", promo.ID, err)
+		}
+
+		if userUses >= int64(promo.MaxUsesPerUser) {
+			return fmt.Errorf("%w: %s", domain.ErrPromoCodeUserLimit, promo.Code)
+		}
+	}
+
+	err = tx.Model(&domain.PromoCode{}).Where("id = ?", promo.ID).Update("uses", gorm.Expr("uses + 1")).Error
+	if err != nil {
+		return fmt.Errorf("failed to count promo code use: promo code ID:%d %w", promo.ID, err)
+	}
+
+	err = tx.Create(redemption).Error
+	if err != nil {
+		return fmt.Errorf("failed to create promo redemption: saga ID:%d %w", redemption.SagaID, err)
+	}
+
+	return nil
+}
+
+// releasePromoRedemptions gives the uses redeemed by a compensated saga
+// back to their codes.
+func releasePromoRedemptions(tx *gorm.DB, sagaID uint) error {
+	var redemptions []domain.PromoRedemption
+
+	err := tx.Where("saga_id = ?", sagaID).Find(&redemptions).Error
+	if err != nil {
+		return fmt.Errorf("failed to find promo redemptions by saga id %d: %w", sagaID, err)
+	}
+
+	for _, redemption := range redemptions {
+		err := tx.Model(&domain.PromoCode{}).Where("id = ? AND uses > 0", redemption.PromoCodeID).
+			Update("uses", gorm.Expr("uses - 1")).Error
+		if err != nil {
+			return fmt.Errorf("failed to release promo code use: promo code ID:%d %w", redemption.PromoCodeID, err)
+		}
+
+		err = tx.Delete(&redemption).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete promo redemption %d: %w", redemption.ID, err)
+		}
+	}
+
+	return nil
+}
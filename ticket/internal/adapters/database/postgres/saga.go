@@ -0,0 +1,312 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ticket/internal/application/core/domain"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func (r *PostgresDBAdapter) CreateBookingSaga(ctx context.Context, saga *domain.BookingSaga) error {
+	err := r.db.WithContext(ctx).Create(saga).Error
+	if err != nil {
+		return fmt.Errorf("failed to create booking saga: %w", err)
+	}
+
+	return nil
+}
+
+// GetBookingSagaByIdempotencyRecordID returns the latest saga started by
+// the request holding the idempotency record.
+func (r *PostgresDBAdapter) GetBookingSagaByIdempotencyRecordID(ctx context.Context, recordID uint) (*domain.BookingSaga, error) {
+	var saga domain.BookingSaga
+
+	err := r.db.WithContext(ctx).Where("idempotency_record_id = ?", recordID).Order("id DESC").First(&saga).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("%w: idempotency record ID %d", domain.ErrBookingSagaNotFound, recordID)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to find booking saga by idempotency record ID %d: %w", recordID, err)
+	}
+
+	return &saga, nil
+}
+
+// ReserveBookingSaga writes the tickets of the saga, the promo code
+// redemption paying part of them and their events. A nil booking keeps the
+// booking reference the tickets already carry. It returns
+// domain.ErrSagaStatusChanged once the saga is no longer started, so a saga
+// taken over by recovery gets no tickets.
+func (r *PostgresDBAdapter) ReserveBookingSaga(ctx context.Context, saga *domain.BookingSaga, booking *domain.Booking, tickets []domain.Ticket, redemption *domain.PromoRedemption, messages []domain.OutboxMessage) error {
+	err := withTx(r.db.WithContext(ctx), func(tx *gorm.DB) error {
+		err := transitionBookingSaga(tx, saga, []domain.SagaStatus{domain.SagaStarted}, domain.SagaStarted)
+		if err != nil {
+			return err
+		}
+
+		if redemption != nil {
+			err := redeemPromoCode(tx, redemption)
+			if err != nil {
+				return err
+			}
+		}
+
+		if booking != nil {
+			err := createBooking(tx, booking)
+			if err != nil {
+				return err
+			}
+
+			for i := range tickets {
+				tickets[i].BookingReference = booking.Reference
+			}
+		}
+
+		if len(tickets) > 0 {
+			err := tx.Create(&tickets).Error
+			if err != nil {
+				return fmt.Errorf("failed to create tickets: %w", err)
+			}
+
+			seatLegs := domain.SeatLegs(tickets)
+
+			err = tx.Create(&seatLegs).Error
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				return fmt.Errorf("%w: taken by another booking", domain.ErrSeatAlreadyBooked)
+			} else if err != nil {
+				return fmt.Errorf("failed to claim seats: %w", err)
+			}
+		}
+
+		err = createOutboxMessages(tx, messages)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&domain.BookingSagaSeat{}).Where("saga_id = ?", saga.ID).Update("status", domain.SagaSeatBooked).Error
+		if err != nil {
+			return fmt.Errorf("failed to update booking saga seats: saga ID:%d %w", saga.ID, err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for i := range saga.Seats {
+		saga.Seats[i].Status = domain.SagaSeatBooked
+	}
+
+	return nil
+}
+
+// CompensateBookingSaga deletes what the saga reserved and finishes it as
+// compensated. It returns domain.ErrSagaStatusChanged when the saga is not
+// compensating anymore, because another instance already compensated it.
+func (r *PostgresDBAdapter) CompensateBookingSaga(ctx context.Context, saga *domain.BookingSaga, messages []domain.OutboxMessage) error {
+	err := withTx(r.db.WithContext(ctx), func(tx *gorm.DB) error {
+		err := transitionBookingSaga(tx, saga, []domain.SagaStatus{domain.SagaCompensating}, domain.SagaCompensated)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("ticket_id IN (?)", tx.Model(&domain.Ticket{}).Select("id").Where("saga_id = ?", saga.ID)).
+			Delete(&domain.TicketSeatLeg{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to release seats by saga id %d: %w", saga.ID, err)
+		}
+
+		err = tx.Where("saga_id = ?", saga.ID).Delete(&domain.Ticket{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete tickets by saga id %d: %w", saga.ID, err)
+		}
+
+		err = tx.Where("saga_id = ?", saga.ID).Delete(&domain.Booking{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete booking by saga id %d: %w", saga.ID, err)
+		}
+
+		err = releasePromoRedemptions(tx, saga.ID)
+		if err != nil {
+			return err
+		}
+
+		err = createOutboxMessages(tx, messages)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&domain.BookingSagaSeat{}).
+			Where("saga_id = ? AND status = ?", saga.ID, domain.SagaSeatBooked).
+			Update("status", domain.SagaSeatReleased).Error
+		if err != nil {
+			return fmt.Errorf("failed to release booking saga seats: saga ID:%d %w", saga.ID, err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for i := range saga.Seats {
+		if saga.Seats[i].Status == domain.SagaSeatBooked {
+			saga.Seats[i].Status = domain.SagaSeatReleased
+		}
+	}
+
+	saga.Status = domain.SagaCompensated
+
+	return nil
+}
+
+// CompleteBookingSaga confirms every ticket of the saga as paid, records
+// the transitions with their outbox messages and finishes the saga.
+func (r *PostgresDBAdapter) CompleteBookingSaga(ctx context.Context, saga *domain.BookingSaga, history []domain.TicketHistory, messages []domain.OutboxMessage) error {
+	err := withTx(r.db.WithContext(ctx), func(tx *gorm.DB) error {
+		err := completeBookingSaga(tx, saga, history)
+		if err != nil {
+			return err
+		}
+
+		return createOutboxMessages(tx, messages)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	saga.Status = domain.SagaCompleted
+
+	return nil
+}
+
+// CompleteTicketExchange finishes the saga of the replacement ticket and
+// moves the old ticket on in the same transaction, so neither ticket is
+// confirmed without the other being retired.
+func (r *PostgresDBAdapter) CompleteTicketExchange(ctx context.Context, saga *domain.BookingSaga, history []domain.TicketHistory, oldTicket *domain.Ticket, oldHistory []domain.TicketHistory, refunds []domain.Refund, messages []domain.OutboxMessage) error {
+	if len(oldHistory) == 0 {
+		return fmt.Errorf("no status transition for ticket %d", oldTicket.ID)
+	}
+
+	err := withTx(r.db.WithContext(ctx), func(tx *gorm.DB) error {
+		err := transitionTicket(tx, oldTicket, oldHistory, refunds, messages)
+		if err != nil {
+			return err
+		}
+
+		return completeBookingSaga(tx, saga, history)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	saga.Status = domain.SagaCompleted
+
+	return nil
+}
+
+// completeBookingSaga confirms the tickets of a started saga, so a saga
+// taken over for compensation is never completed.
+func completeBookingSaga(tx *gorm.DB, saga *domain.BookingSaga, history []domain.TicketHistory) error {
+	err := transitionBookingSaga(tx, saga, []domain.SagaStatus{domain.SagaStarted}, domain.SagaCompleted)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Model(&domain.Ticket{}).Where("saga_id = ? AND status = ?", saga.ID, domain.TicketReserved).Updates(map[string]any{
+		"status":         domain.TicketConfirmed,
+		"payment_status": domain.PaymentPaid,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to confirm tickets: saga ID:%d %w", saga.ID, err)
+	}
+
+	if len(history) > 0 {
+		err = tx.Create(&history).Error
+		if err != nil {
+			return fmt.Errorf("failed to create ticket history: saga ID:%d %w", saga.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// transitionBookingSaga moves the saga to status if it is still in one of
+// the from statuses, or returns domain.ErrSagaStatusChanged. The saga row
+// stays locked until the transaction ends and its lease is renewed.
+func transitionBookingSaga(tx *gorm.DB, saga *domain.BookingSaga, from []domain.SagaStatus, status domain.SagaStatus) error {
+	result := tx.Model(&domain.BookingSaga{}).Where("id = ? AND status IN ?", saga.ID, from).Update("status", status)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update booking saga status: saga ID:%d %w", saga.ID, result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: saga ID:%d", domain.ErrSagaStatusChanged, saga.ID)
+	}
+
+	return nil
+}
+
+// StartBookingSagaCompensation moves a started saga to compensating, or
+// returns domain.ErrSagaStatusChanged when it already completed or was
+// taken over.
+func (r *PostgresDBAdapter) StartBookingSagaCompensation(ctx context.Context, saga *domain.BookingSaga) error {
+	err := transitionBookingSaga(r.db.WithContext(ctx), saga, []domain.SagaStatus{domain.SagaStarted}, domain.SagaCompensating)
+	if err != nil {
+		return err
+	}
+
+	saga.Status = domain.SagaCompensating
+
+	return nil
+}
+
+// TakeOverBookingSaga moves an unfinished saga to compensating and renews
+// its lease, or returns domain.ErrSagaStatusChanged when the saga made
+// progress since it was listed.
+func (r *PostgresDBAdapter) TakeOverBookingSaga(ctx context.Context, saga *domain.BookingSaga, now time.Time) error {
+	unfinished := []domain.SagaStatus{domain.SagaStarted, domain.SagaCompensating}
+
+	result := r.db.WithContext(ctx).Model(&domain.BookingSaga{}).
+		Where("id = ? AND status IN ? AND updated_at = ?", saga.ID, unfinished, saga.UpdatedAt).
+		Updates(map[string]any{
+			"status":     domain.SagaCompensating,
+			"updated_at": now,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to take over booking saga: saga ID:%d %w", saga.ID, result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: saga ID:%d", domain.ErrSagaStatusChanged, saga.ID)
+	}
+
+	saga.Status = domain.SagaCompensating
+	saga.UpdatedAt = now
+
+	return nil
+}
+
+// ListUnfinishedBookingSagas lists the sagas that are unfinished and were
+// last updated before staleBefore, so their lease has expired.
+func (r *PostgresDBAdapter) ListUnfinishedBookingSagas(ctx context.Context, staleBefore time.Time) ([]domain.BookingSaga, error) {
+	var sagas []domain.BookingSaga
+
+	unfinished := []domain.SagaStatus{domain.SagaStarted, domain.SagaCompensating}
+
+	err := r.db.WithContext(ctx).Preload("Seats").
+		Where("status IN ? AND updated_at < ?", unfinished, staleBefore).
+		Order("id").Find(&sagas).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unfinished booking sagas: %w", err)
+	}
+
+	return sagas, nil
+}
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"ticket/internal/application/core/domain"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CreateTicketTransfer stores a pending transfer. Pending transfers of the
+// ticket past their deadline are expired first, so that they do not block a
+// new one.
+func (r *PostgresDBAdapter) CreateTicketTransfer(ctx context.Context, transfer *domain.TicketTransfer, now time.Time, newEvent domain.TicketTransferMessageFactory) error {
+	return withTx(r.db.WithContext(ctx), func(tx *gorm.DB) error {
+		err := tx.Model(&domain.TicketTransfer{}).
+			Where("ticket_id = ? AND status = ? AND expires_at <= ?", transfer.TicketID, domain.TransferPending, now).
+			Update("status", domain.TransferExpired).Error
+		if err != nil {
+			return fmt.Errorf("failed to expire ticket transfers: ticket ID:%d %w", transfer.TicketID, err)
+		}
+
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(transfer)
+		if result.Error != nil {
+			return fmt.Errorf("failed to create ticket transfer: ticket ID:%d %w", transfer.TicketID, result.Error)
+		}
+
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: ticket %d", domain.ErrTransferInProgress, transfer.TicketID)
+		}
+
+		message, err := newEvent(transfer)
+		if err != nil {
+			return err
+		}
+
+		return createOutboxMessages(tx, []domain.OutboxMessage{*message})
+	})
+}
+
+func (r *PostgresDBAdapter) GetTicketTransferByID(ctx context.Context, transferID uint) (*domain.TicketTransfer, error) {
+	var transfer domain.TicketTransfer
+
+	err := r.db.WithContext(ctx).First(&transfer, transferID).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find ticket transfer by ID %d: %w", transferID, err)
+	}
+
+	return &transfer, nil
+}
+
+func (r *PostgresDBAdapter) ExpireTicketTransfer(ctx context.Context, transfer *domain.TicketTransfer) error {
+	err := r.db.WithContext(ctx).Model(&domain.TicketTransfer{}).
+		Where("id = ? AND status = ?", transfer.ID, domain.TransferPending).
+		Update("status", domain.TransferExpired).Error
+	if err != nil {
+		return fmt.Errorf("failed to expire ticket transfer %d: %w", transfer.ID, err)
+	}
+
+	return nil
+}
+
+// AcceptTicketTransfer hands the ticket to the recipient of the transfer.
+// The ticket must still be confirmed and owned by the sender.
+func (r *PostgresDBAdapter) AcceptTicketTransfer(ctx context.Context, transfer *domain.TicketTransfer, history *domain.TicketHistory, messages []domain.OutboxMessage) error {
+	return withTx(r.db.WithContext(ctx), func(tx *gorm.DB) error {
+		result := tx.Model(&domain.TicketTransfer{}).
+			Where("id = ? AND status = ?", transfer.ID, domain.TransferPending).
+			Updates(map[string]any{
+				"status":      transfer.Status,
+				"accepted_at": transfer.AcceptedAt,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to accept ticket transfer %d: %w", transfer.ID, result.Error)
+		}
+
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: transfer %d", domain.ErrTransferNotPending, transfer.ID)
+		}
+
+		result = tx.Model(&domain.Ticket{}).
+			Where("id = ? AND user_id = ? AND status = ?", transfer.TicketID, transfer.FromUserID, domain.TicketConfirmed).
+			Update("user_id", transfer.ToUserID)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update ticket owner: ticket ID:%d %w", transfer.TicketID, result.Error)
+		}
+
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: ticket %d", domain.ErrTransferTicketReplaced, transfer.TicketID)
+		}
+
+		err := tx.Create(history).Error
+		if err != nil {
+			return fmt.Errorf("failed to create ticket history: ticket ID:%d %w", transfer.TicketID, err)
+		}
+
+		return createOutboxMessages(tx, messages)
+	})
+}
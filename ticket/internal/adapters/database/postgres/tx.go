@@ -1,6 +1,8 @@
 package postgres
 
 import (
+	"errors"
+
 	"gorm.io/gorm"
 )
 
@@ -24,3 +26,22 @@ func (t *gormTx) Commit() error {
 func (t *gormTx) Rollback() error {
 	return t.db.Rollback().Error
 }
+
+func withTx(db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	tx, err := begin(db)
+	if err != nil {
+		return err
+	}
+
+	err = fn(tx.db)
+	if err != nil {
+		rollBackErr := tx.Rollback()
+		if rollBackErr != nil {
+			return errors.Join(err, rollBackErr)
+		}
+
+		return err
+	}
+
+	return tx.Commit()
+}
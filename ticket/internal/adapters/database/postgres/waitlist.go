@@ -0,0 +1,135 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ticket/internal/application/core/domain"
+
+	"gorm.io/gorm"
+)
+
+func (r *PostgresDBAdapter) CreateWaitlistEntry(ctx context.Context, entry *domain.WaitlistEntry) error {
+	err := r.db.WithContext(ctx).Create(entry).Error
+	if err != nil {
+		return fmt.Errorf("failed to create waitlist entry: %w", err)
+	}
+
+	return nil
+}
+
+// GetWaitlistEntryByID returns the entry with its position among the
+// waiting entries of the train, starting at 1.
+func (r *PostgresDBAdapter) GetWaitlistEntryByID(ctx context.Context, entryID uint) (*domain.WaitlistEntry, error) {
+	var entry domain.WaitlistEntry
+
+	err := r.db.WithContext(ctx).First(&entry, entryID).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find waitlist entry by ID %d: %w", entryID, err)
+	}
+
+	if entry.Status != domain.WaitlistWaiting {
+		return &entry, nil
+	}
+
+	var ahead int64
+
+	err = r.db.WithContext(ctx).Model(&domain.WaitlistEntry{}).
+		Where("train_id = ? AND status = ? AND id < ?", entry.TrainID, domain.WaitlistWaiting, entry.ID).
+		Count(&ahead).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to count waitlist position: entry ID:%d %w", entryID, err)
+	}
+
+	entry.Position = uint(ahead) + 1
+
+	return &entry, nil
+}
+
+func (r *PostgresDBAdapter) CountActiveWaitlistEntries(ctx context.Context, userID, trainID uint) (int64, error) {
+	var count int64
+
+	active := []domain.WaitlistStatus{domain.WaitlistWaiting, domain.WaitlistOffered}
+
+	err := r.db.WithContext(ctx).Model(&domain.WaitlistEntry{}).
+		Where("user_id = ? AND train_id = ? AND status IN ?", userID, trainID, active).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to count waitlist entries: user ID:%d train ID:%d %w", userID, trainID, err)
+	}
+
+	return count, nil
+}
+
+// GetNextWaitlistEntry finds the oldest waiting entry of the train that
+// wants no more than freeSeats tickets. Larger entries keep their place
+// until enough seats are free.
+func (r *PostgresDBAdapter) GetNextWaitlistEntry(ctx context.Context, trainID, freeSeats uint) (*domain.WaitlistEntry, error) {
+	var entry domain.WaitlistEntry
+
+	err := r.db.WithContext(ctx).
+		Where("train_id = ? AND status = ? AND ticket_number <= ?", trainID, domain.WaitlistWaiting, freeSeats).
+		Order("id").First(&entry).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domain.ErrWaitlistEmpty
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to find next waitlist entry: train ID:%d %w", trainID, err)
+	}
+
+	return &entry, nil
+}
+
+// OfferWaitlistEntry stores the offer of a waiting entry together with its
+// notification event.
+func (r *PostgresDBAdapter) OfferWaitlistEntry(ctx context.Context, entry *domain.WaitlistEntry, messages []domain.OutboxMessage) error {
+	return withTx(r.db.WithContext(ctx), func(tx *gorm.DB) error {
+		result := tx.Model(&domain.WaitlistEntry{}).
+			Where("id = ? AND status = ?", entry.ID, domain.WaitlistWaiting).
+			Updates(map[string]any{
+				"status":           entry.Status,
+				"hold_id":          entry.HoldID,
+				"offer_expires_at": entry.OfferExpiresAt,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to offer waitlist entry: entry ID:%d %w", entry.ID, result.Error)
+		}
+
+		if result.RowsAffected == 0 {
+			return domain.ErrWaitlistEntryNotActive
+		}
+
+		return createOutboxMessages(tx, messages)
+	})
+}
+
+func (r *PostgresDBAdapter) UpdateWaitlistEntryStatus(ctx context.Context, entry *domain.WaitlistEntry, status domain.WaitlistStatus) error {
+	result := r.db.WithContext(ctx).Model(&domain.WaitlistEntry{}).
+		Where("id = ? AND status = ?", entry.ID, entry.Status).
+		Update("status", status)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update waitlist entry status: entry ID:%d %w", entry.ID, result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrWaitlistEntryNotActive
+	}
+
+	entry.Status = status
+
+	return nil
+}
+
+func (r *PostgresDBAdapter) CloseWaitlistOffer(ctx context.Context, holdID uint, status domain.WaitlistStatus) error {
+	return closeWaitlistOffer(r.db.WithContext(ctx), holdID, status)
+}
+
+func closeWaitlistOffer(tx *gorm.DB, holdID uint, status domain.WaitlistStatus) error {
+	err := tx.Model(&domain.WaitlistEntry{}).
+		Where("hold_id = ? AND status = ?", holdID, domain.WaitlistOffered).
+		Update("status", status).Error
+	if err != nil {
+		return fmt.Errorf("failed to close waitlist offer: hold ID:%d %w", holdID, err)
+	}
+
+	return nil
+}
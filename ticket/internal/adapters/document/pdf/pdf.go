@@ -0,0 +1,113 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"ticket/internal/application/core/domain"
+
+	"github.com/jung-kurt/gofpdf"
+	"github.com/skip2/go-qrcode"
+)
+
+const (
+	timeLayout = "Mon 02 Jan 2006 15:04"
+	qrCodeSize = 512 // pixels
+)
+
+// PDFDocumentAdapter renders tickets as an A4 PDF, one page per ticket,
+// with the e-ticket token as a QR code conductors can scan.
+type PDFDocumentAdapter struct{}
+
+func NewPDFDocumentAdapter() *PDFDocumentAdapter {
+	return &PDFDocumentAdapter{}
+}
+
+func (a *PDFDocumentAdapter) RenderTickets(documents []domain.TicketDocument) ([]byte, error) {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetTitle("E-ticket", true)
+	pdf.SetAutoPageBreak(false, 0)
+
+	// The core fonts are not UTF-8, names are translated to their code page
+	translate := pdf.UnicodeTranslatorFromDescriptor("")
+
+	for i := range documents {
+		err := renderTicket(pdf, translate, &documents[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var buffer bytes.Buffer
+
+	err := pdf.Output(&buffer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render tickets: %w", err)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func renderTicket(pdf *gofpdf.Fpdf, translate func(string) string, document *domain.TicketDocument) error {
+	ticket := document.Ticket
+
+	qrCode, err := qrcode.Encode(document.ETicket, qrcode.Medium, qrCodeSize)
+	if err != nil {
+		return fmt.Errorf("failed to encode e-ticket of ticket %d: %w", ticket.ID, err)
+	}
+
+	imageName := fmt.Sprintf("eticket-%d", ticket.ID)
+	pdf.RegisterImageOptionsReader(imageName, gofpdf.ImageOptions{ImageType: "PNG"}, bytes.NewReader(qrCode))
+
+	pdf.AddPage()
+
+	pdf.SetFont("Helvetica", "B", 22)
+	pdf.CellFormat(0, 12, "E-ticket", "", 1, "L", false, 0, "")
+
+	pdf.SetFont("Helvetica", "", 11)
+	pdf.CellFormat(0, 6, fmt.Sprintf("Booking reference %s  -  Ticket %d", ticket.BookingReference, ticket.ID), "", 1, "L", false, 0, "")
+	pdf.Ln(6)
+
+	pdf.ImageOptions(imageName, 130, 20, 60, 60, false, gofpdf.ImageOptions{ImageType: "PNG"}, 0, "")
+
+	rows := [][2]string{
+		{"Passenger", passengerName(&ticket.Passenger)},
+		{"Train", document.TrainName},
+		{"From", ticket.Origin},
+		{"To", ticket.Destination},
+		{"Departure", ticket.DepartureTime.Format(timeLayout)},
+		{"Arrival", ticket.ArrivalTime.Format(timeLayout)},
+		{"Seat", fmt.Sprintf("%d (%s class)", ticket.SeatNumber, seatClass(ticket.Class))},
+		{"Price", fmt.Sprintf("%d.%02d %s", ticket.Price/100, ticket.Price%100, ticket.Currency)},
+	}
+
+	for _, row := range rows {
+		pdf.SetFont("Helvetica", "B", 11)
+		pdf.CellFormat(35, 8, row[0], "", 0, "L", false, 0, "")
+		pdf.SetFont("Helvetica", "", 11)
+		pdf.CellFormat(80, 8, translate(row[1]), "", 1, "L", false, 0, "")
+	}
+
+	pdf.Ln(10)
+	pdf.SetFont("Helvetica", "I", 9)
+	pdf.MultiCell(0, 5, "Show the QR code to the conductor when boarding. The ticket is only valid for the passenger named on it together with an identity document.", "", "L", false)
+
+	return pdf.Error()
+}
+
+func passengerName(passenger *domain.Passenger) string {
+	name := strings.TrimSpace(passenger.FirstName + " " + passenger.LastName)
+	if name == "" {
+		return "-"
+	}
+
+	return name
+}
+
+func seatClass(class string) string {
+	if class == "" {
+		return domain.SeatClassStandard
+	}
+
+	return class
+}
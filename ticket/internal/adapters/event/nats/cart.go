@@ -0,0 +1,177 @@
+package nats
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"ticket/internal/application/core/domain"
+	"ticket/utils"
+
+	"github.com/nats-io/nats.go"
+)
+
+func (r *TicketEventResponderAdapter) ReplyToCreateCart(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestCreateCart, func(msg *nats.Msg) {
+		r.respondIdempotent(ctx, msg, func(uint) ([]byte, error) {
+			userIDstr := string(msg.Data)
+			userID, err := strconv.Atoi(userIDstr)
+			if err != nil {
+				return nil, fmt.Errorf("error invalid userID: %s, %w", userIDstr, err)
+			}
+
+			cart, err := r.APIAdapter.CreateCart(ctx, uint(userID))
+			if err != nil {
+				return nil, fmt.Errorf("error create cart: %w", err)
+			}
+
+			serializedCartData, err := utils.MarshalCart(cart)
+			if err != nil {
+				return nil, fmt.Errorf("error serialize cart: %v,%w", cart, err)
+			}
+
+			return serializedCartData, nil
+		})
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TicketEventResponderAdapter) ReplyToGetCart(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestGetCart, func(msg *nats.Msg) {
+		cartIDstr := string(msg.Data)
+		cartID, err := strconv.Atoi(cartIDstr)
+		if err != nil {
+			errString := fmt.Errorf("error invalid cartID: %s, %w", cartIDstr, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		cart, err := r.APIAdapter.GetCart(ctx, uint(cartID))
+		r.respondCart(msg, cart, err)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TicketEventResponderAdapter) ReplyToAddCartLeg(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestAddCartLeg, func(msg *nats.Msg) {
+		cartID, leg, err := utils.UnmarshalAddCartLegRequest(msg.Data)
+		if err != nil {
+			errString := fmt.Errorf("error unmarshal request(AddCartLeg):%w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		cart, err := r.APIAdapter.AddCartLeg(ctx, cartID, leg)
+		r.respondCart(msg, cart, err)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TicketEventResponderAdapter) ReplyToRemoveCartLeg(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestRemoveCartLeg, func(msg *nats.Msg) {
+		cartID, legID, err := utils.UnmarshalRemoveCartLegRequest(msg.Data)
+		if err != nil {
+			errString := fmt.Errorf("error unmarshal request(RemoveCartLeg):%w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		cart, err := r.APIAdapter.RemoveCartLeg(ctx, cartID, legID)
+		r.respondCart(msg, cart, err)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+// ReplyToCheckoutCart books every leg of the cart and replies with the
+// booking.
+func (r *TicketEventResponderAdapter) ReplyToCheckoutCart(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestCheckoutCart, func(msg *nats.Msg) {
+		r.respondIdempotent(ctx, msg, func(recordID uint) ([]byte, error) {
+			request, err := utils.UnmarshalCheckoutCartRequest(msg.Data)
+			if err != nil {
+				return nil, fmt.Errorf("error unmarshal request(CheckoutCart):%w", err)
+			}
+
+			request.IdempotencyRecordID = recordID
+
+			booking, err := r.APIAdapter.CheckoutCart(ctx, request)
+			if err != nil {
+				return nil, fmt.Errorf("error checkout cart:%d, %w", request.CartID, err)
+			}
+
+			serializedBookingData, err := utils.MarshalBooking(booking)
+			if err != nil {
+				return nil, fmt.Errorf("error serialize booking: %v,%w", booking, err)
+			}
+
+			return serializedBookingData, nil
+		})
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TicketEventResponderAdapter) respondCart(msg *nats.Msg, cart *domain.Cart, err error) {
+	if err != nil {
+		errString := fmt.Errorf("error cart: %w", err).Error()
+		msg.Respond([]byte(errString))
+		return
+	}
+
+	serializedCartData, err := utils.MarshalCart(cart)
+	if err != nil {
+		errString := fmt.Errorf("error serialize cart: %v,%w", cart, err).Error()
+		msg.Respond([]byte(errString))
+		return
+	}
+
+	msg.Respond(serializedCartData)
+}
@@ -0,0 +1,121 @@
+package nats
+
+import (
+	"context"
+	"ticket/internal/ports"
+	"ticket/utils"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	SeatBookCanceledSubjectName = "seat.book.canceled"
+	SeatHoldReleasedSubjectName = "seat.hold.released"
+
+	waitlistConsumerName = "ticket_waitlist_consumer"
+
+	// The train service frees the seat from the same event, so a promotion
+	// that finds no free seat is retried a few times before giving up.
+	maxPromotionDeliveries = 5
+	promotionRetryDelay    = 2 * time.Second
+)
+
+type TicketEventConsumerAdapter struct {
+	jetStream  jetstream.JetStream
+	APIAdapter ports.APIPort
+}
+
+func NewTicketEventConsumerAdapter(natsConn *nats.Conn, apiAdapter ports.APIPort) (*TicketEventConsumerAdapter, error) {
+	jetStream, err := jetstream.New(natsConn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TicketEventConsumerAdapter{
+		jetStream:  jetStream,
+		APIAdapter: apiAdapter,
+	}, nil
+}
+
+// ConsumeSeatReleases promotes the waitlist of a train whenever one of its
+// seats is canceled or its hold is released.
+func (c *TicketEventConsumerAdapter) ConsumeSeatReleases(ctx context.Context) <-chan error {
+	var errorStream = make(chan error, 1)
+
+	_, err := c.jetStream.CreateOrUpdateStream(ctx, streamConfig(SeatBookCanceledSubjectName))
+	if err != nil {
+		errorStream <- err
+
+		close(errorStream)
+
+		return errorStream
+	}
+
+	seatConsumer, err := c.jetStream.CreateOrUpdateConsumer(ctx, SeatStream, jetstream.ConsumerConfig{
+		Durable:        waitlistConsumerName,
+		FilterSubjects: []string{SeatBookCanceledSubjectName, SeatHoldReleasedSubjectName},
+		MaxAckPending:  5,
+	})
+
+	if err != nil {
+		errorStream <- err
+
+		close(errorStream)
+
+		return errorStream
+	}
+
+	go func() {
+		defer close(errorStream)
+
+		for ctx.Err() == nil {
+			msgs, err := seatConsumer.Fetch(5)
+			if err != nil {
+				errorStream <- err
+				continue
+			}
+
+			for msg := range msgs.Messages() {
+				seat, err := utils.UnmarshalSeat(msg.Data())
+				if err != nil {
+					errorStream <- err
+					ack(msg, errorStream)
+					continue
+				}
+
+				err = c.APIAdapter.PromoteWaitlist(ctx, seat.TrainID)
+				if err != nil {
+					errorStream <- err
+					retry(msg, errorStream)
+					continue
+				}
+
+				ack(msg, errorStream)
+			}
+		}
+	}()
+
+	return errorStream
+}
+
+func ack(msg jetstream.Msg, errorStream chan<- error) {
+	err := msg.Ack()
+	if err != nil {
+		errorStream <- err
+	}
+}
+
+func retry(msg jetstream.Msg, errorStream chan<- error) {
+	metadata, err := msg.Metadata()
+	if err != nil || metadata.NumDelivered >= maxPromotionDeliveries {
+		ack(msg, errorStream)
+		return
+	}
+
+	err = msg.NakWithDelay(promotionRetryDelay)
+	if err != nil {
+		errorStream <- err
+	}
+}
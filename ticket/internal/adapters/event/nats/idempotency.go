@@ -0,0 +1,73 @@
+package nats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"ticket/internal/application/core/domain"
+
+	"github.com/nats-io/nats.go"
+)
+
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// respondIdempotent replies with the result of handle, which gets the ID
+// of the idempotency record of the request, zero without a key. A request
+// carrying an idempotency key is handled once, retries with the same key
+// get the stored reply of the first successful attempt. A key left pending
+// by an attempt that crashed is taken over once its lease has run out, and
+// handle finds the booking that attempt made through the record.
+func (r *TicketEventResponderAdapter) respondIdempotent(ctx context.Context, msg *nats.Msg, handle func(recordID uint) ([]byte, error)) {
+	key := msg.Header.Get(IdempotencyKeyHeader)
+	if key == "" {
+		respond(msg, func() ([]byte, error) {
+			return handle(0)
+		})
+		return
+	}
+
+	record, err := r.APIAdapter.BeginIdempotentRequest(ctx, msg.Subject, key, msg.Data)
+	if err != nil {
+		errString := fmt.Errorf("error idempotency key %s: %w", key, err).Error()
+		msg.Respond([]byte(errString))
+		return
+	}
+
+	if record.Status == domain.IdempotencyCompleted {
+		msg.Respond(record.Response)
+		return
+	}
+
+	data, err := handle(record.ID)
+	if errors.Is(err, domain.ErrIdempotencyKeyInProgress) {
+		// The record still leads to the booking of the earlier attempt
+		msg.Respond([]byte(err.Error()))
+		return
+	} else if err != nil {
+		abortErr := r.APIAdapter.AbortIdempotentRequest(ctx, record)
+		if abortErr != nil {
+			log.Printf("abort idempotent request %s error:%v\n", key, abortErr)
+		}
+
+		msg.Respond([]byte(err.Error()))
+		return
+	}
+
+	err = r.APIAdapter.CompleteIdempotentRequest(ctx, record, data)
+	if err != nil {
+		log.Printf("complete idempotent request %s error:%v\n", key, err)
+	}
+
+	msg.Respond(data)
+}
+
+func respond(msg *nats.Msg, handle func() ([]byte, error)) {
+	data, err := handle()
+	if err != nil {
+		msg.Respond([]byte(err.Error()))
+		return
+	}
+
+	msg.Respond(data)
+}
@@ -2,18 +2,19 @@ package nats
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"ticket/internal/application/core/domain"
-	"ticket/utils"
 
 	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
 )
 
 const (
-	SeatStream                  = "SEAT"
-	SeatBookedSubjectName       = "seat.book.booked"
-	SeatBookCanceledSubjectName = "seat.book.canceled"
-	SeatSubjectsName            = "seat.book.*"
+	SeatStream         = "SEAT"
+	SeatSubjectsName   = "seat.*.*"
+	TicketStream       = "TICKET"
+	TicketSubjectsName = "ticket.>"
 )
 
 type TicketEventPublisherAdapter struct {
@@ -33,44 +34,34 @@ func NewEventPublisherAdapter(nats *nats.Conn) (*TicketEventPublisherAdapter, er
 	return ticketEventPublisherAdapter, nil
 }
 
-func (p *TicketEventPublisherAdapter) PublishSeatBooked(ctx context.Context, seat *domain.Seat) error {
-	_, err := p.jetStream.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
-		Name:     SeatStream,
-		Subjects: []string{SeatSubjectsName},
-		Storage:  jetstream.FileStorage,
-	})
+func (p *TicketEventPublisherAdapter) PublishOutboxMessage(ctx context.Context, message *domain.OutboxMessage) error {
+	_, err := p.jetStream.CreateOrUpdateStream(ctx, streamConfig(message.Subject))
 
 	if err != nil {
 		return err
 	}
 
-	data, err := utils.MarshalSeat(seat)
-	if err != nil {
-		return err
-	}
+	// The message ID lets JetStream drop duplicates when the relay retries
+	// a message whose publish ack got lost.
+	msgID := fmt.Sprintf("ticket-outbox-%d", message.ID)
 
-	_, err = p.jetStream.Publish(ctx, SeatBookedSubjectName, data)
+	_, err = p.jetStream.Publish(ctx, message.Subject, message.Payload, jetstream.WithMsgID(msgID))
 
 	return err
 }
 
-func (p *TicketEventPublisherAdapter) PublishSeatBookingCanceled(ctx context.Context, seat *domain.Seat) error {
-	_, err := p.jetStream.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+func streamConfig(subject string) jetstream.StreamConfig {
+	if strings.HasPrefix(subject, "ticket.") {
+		return jetstream.StreamConfig{
+			Name:     TicketStream,
+			Subjects: []string{TicketSubjectsName},
+			Storage:  jetstream.FileStorage,
+		}
+	}
+
+	return jetstream.StreamConfig{
 		Name:     SeatStream,
 		Subjects: []string{SeatSubjectsName},
 		Storage:  jetstream.FileStorage,
-	})
-
-	if err != nil {
-		return err
-	}
-
-	data, err := utils.MarshalSeat(seat)
-	if err != nil {
-		return err
 	}
-	
-	_, err = p.jetStream.Publish(ctx, SeatBookCanceledSubjectName, data)
-
-	return err
 }
@@ -5,12 +5,18 @@ import (
 	"strconv"
 	"ticket/internal/application/core/domain"
 	"ticket/utils"
+	"time"
 
 	"github.com/nats-io/nats.go"
 )
 
 const (
-	SubjectRequestGetTrainByID            = "request.get.train.byID"
+	SubjectRequestGetTrainByID       = "request.get.train.byID"
+	SubjectRequestGetTrainSegment    = "request.get.train.segment"
+	SubjectRequestListSeatsBySegment = "request.list.seats.bySegment"
+	SubjectRequestHoldSeats          = "request.hold.seats"
+	SubjectRequestGetUserByID        = "request.get.user.byID"
+	SubjectRequestGetUserByEmail     = "request.get.user.byEmail"
 )
 
 type TicketRequestSenderAdapter struct {
@@ -40,3 +46,77 @@ func (t *TicketRequestSenderAdapter) RequestGetTrainByID(ctx context.Context, tr
 
 	return  train,nil
 }
+
+// RequestGetTrainSegment gets the train between two of its stations, with
+// the seats free on that part of the route.
+func (t *TicketRequestSenderAdapter) RequestGetTrainSegment(ctx context.Context, trainID uint, origin, destination string) (*domain.Train, error) {
+	data, err := utils.MarshalTrainSegmentRequest(trainID, origin, destination)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := t.natsConn.RequestWithContext(ctx, SubjectRequestGetTrainSegment, data)
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(msg.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalTrain(msg.Data)
+}
+
+func (t *TicketRequestSenderAdapter) RequestListSeatsBySegment(ctx context.Context, trainID uint, segment domain.Segment) ([]domain.Seat, error) {
+	data, err := utils.MarshalListSegmentSeatsRequest(trainID, segment)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := t.natsConn.RequestWithContext(ctx, SubjectRequestListSeatsBySegment, data)
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(msg.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalSeats(msg.Data)
+}
+
+func (t *TicketRequestSenderAdapter) RequestHoldSeats(ctx context.Context, trainID, userID uint, seatIDs []uint, segment domain.Segment, heldUntil time.Time) ([]domain.Seat, error) {
+	data, err := utils.MarshalHoldSeatsRequest(trainID, userID, seatIDs, segment, heldUntil)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := t.natsConn.RequestWithContext(ctx, SubjectRequestHoldSeats, data)
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(msg.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalSeats(msg.Data)
+}
+
+func (t *TicketRequestSenderAdapter) RequestGetUserByID(ctx context.Context, userID uint) (*domain.User, error) {
+	strUserID := strconv.Itoa(int(userID))
+
+	msg, err := t.natsConn.RequestWithContext(ctx, SubjectRequestGetUserByID, []byte(strUserID))
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(msg.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalUser(msg.Data)
+}
+
+func (t *TicketRequestSenderAdapter) RequestGetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
+	msg, err := t.natsConn.RequestWithContext(ctx, SubjectRequestGetUserByEmail, []byte(email))
+	if err != nil {
+		return nil, err
+	} else if err := utils.HandleError(msg.Data); err != nil {
+		return nil, err
+	}
+
+	return utils.UnmarshalUser(msg.Data)
+}
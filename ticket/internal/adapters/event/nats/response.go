@@ -2,8 +2,10 @@ package nats
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"strconv"
+	"ticket/internal/application/core/domain"
 	"ticket/internal/ports"
 	"ticket/utils"
 
@@ -11,11 +13,37 @@ import (
 )
 
 const (
-	SubjectRequestGetTicketByID        = "request.get.ticket.byID"
-	SubjectRequestBookTicket           = "request.book.ticket"
-	SubjectRequestCancelTicket         = "request.cancel.ticket"
-	SubjectRequestListTicketsByUserID  = "request.list.tickets.byUserID"
-	SubjectRequestListTicketsByTrainID = "request.list.tickets.byTrainID"
+	SubjectRequestGetTicketByID         = "request.get.ticket.byID"
+	SubjectRequestBookTicket            = "request.book.ticket"
+	SubjectRequestQuoteTicket           = "request.quote.ticket"
+	SubjectRequestCancelTicket          = "request.cancel.ticket"
+	SubjectRequestListTicketsByUserID   = "request.list.tickets.byUserID"
+	SubjectRequestListTicketsByTrainID  = "request.list.tickets.byTrainID"
+	SubjectRequestHoldTicket            = "request.hold.ticket"
+	SubjectRequestConfirmTicketHold     = "request.confirm.ticket.hold"
+	SubjectRequestReleaseTicketHold     = "request.release.ticket.hold"
+	SubjectRequestIssueETicket          = "request.issue.eticket"
+	SubjectRequestRenderTicketPDF       = "request.render.ticket.pdf"
+	SubjectRequestVerifyETicket         = "request.verify.eticket"
+	SubjectRequestGetETicketPublicKey   = "request.get.eticket.publicKey"
+	SubjectRequestTransferTicket        = "request.transfer.ticket"
+	SubjectRequestGetTicketTransfer     = "request.get.ticket.transfer"
+	SubjectRequestAcceptTicketTransfer  = "request.accept.ticket.transfer"
+	SubjectRequestExchangeTicket        = "request.exchange.ticket"
+	SubjectRequestGetBookingByReference = "request.get.booking.byReference"
+	SubjectRequestCancelBooking         = "request.cancel.booking"
+	SubjectRequestCreateCart            = "request.create.cart"
+	SubjectRequestGetCart               = "request.get.cart"
+	SubjectRequestAddCartLeg            = "request.add.cart.leg"
+	SubjectRequestRemoveCartLeg         = "request.remove.cart.leg"
+	SubjectRequestCheckoutCart          = "request.checkout.cart"
+	SubjectRequestJoinWaitlist          = "request.join.waitlist"
+	SubjectRequestGetWaitlistEntry      = "request.get.waitlist.entry"
+	SubjectRequestLeaveWaitlist         = "request.leave.waitlist"
+	SubjectRequestCreatePromoCode       = "request.create.promoCode"
+	SubjectRequestGetPromoCode          = "request.get.promoCode"
+	SubjectRequestListPromoCodes        = "request.list.promoCodes"
+	SubjectRequestDeactivatePromoCode   = "request.deactivate.promoCode"
 )
 
 type TicketEventResponderAdapter struct {
@@ -73,26 +101,24 @@ func (r *TicketEventResponderAdapter) ReplyToGetTicketByID(ctx context.Context)
 
 func (r *TicketEventResponderAdapter) ReplayToListTicketsByUserID(ctx context.Context) error {
 	subscription, err := r.natsConn.Subscribe(SubjectRequestListTicketsByUserID, func(msg *nats.Msg) {
-		userIDstr := string(msg.Data)
-		userID, err := strconv.Atoi(userIDstr)
+		filter, err := utils.UnmarshalListTicketsRequest(msg.Data)
 		if err != nil {
-			errString := fmt.Errorf("error invalid userID: %d, %w", userID, err).Error()
+			errString := fmt.Errorf("error deserialize list tickets request: %w", err).Error()
 
 			msg.Respond([]byte(errString))
 			return
 		}
 
-		tickets, err := r.APIAdapter.GetTicketsByUserID(ctx, uint(userID))
+		page, err := r.APIAdapter.GetTicketsByUserID(ctx, filter.UserID, filter)
 		if err != nil {
 			errString := fmt.Errorf("error list tickets: %w", err).Error()
 			msg.Respond([]byte(errString))
 			return
 		}
 
-		serializedTicketsData, err := utils.MarshalTickets(tickets)
+		serializedTicketsData, err := utils.MarshalTicketPage(page)
 		if err != nil {
-			fmt.Println()
-			errString := fmt.Errorf("error serialize tickets: %v,%w", tickets, err).Error()
+			errString := fmt.Errorf("error serialize tickets: %w", err).Error()
 			msg.Respond([]byte(errString))
 			return
 		}
@@ -114,26 +140,24 @@ func (r *TicketEventResponderAdapter) ReplayToListTicketsByUserID(ctx context.Co
 
 func (r *TicketEventResponderAdapter) ReplayToListTicketsByTrainID(ctx context.Context) error {
 	subscription, err := r.natsConn.Subscribe(SubjectRequestListTicketsByTrainID, func(msg *nats.Msg) {
-		trainIDstr := string(msg.Data)
-		trainID, err := strconv.Atoi(trainIDstr)
+		filter, err := utils.UnmarshalListTicketsRequest(msg.Data)
 		if err != nil {
-			errString := fmt.Errorf("error invalid trainID: %d, %w", trainID, err).Error()
+			errString := fmt.Errorf("error deserialize list tickets request: %w", err).Error()
 
 			msg.Respond([]byte(errString))
 			return
 		}
 
-		tickets, err := r.APIAdapter.GetTicketsByTrainID(ctx, uint(trainID))
+		page, err := r.APIAdapter.GetTicketsByTrainID(ctx, filter.TrainID, filter)
 		if err != nil {
 			errString := fmt.Errorf("error list tickets: %w", err).Error()
 			msg.Respond([]byte(errString))
 			return
 		}
 
-		serializedTicketsData, err := utils.MarshalTickets(tickets)
+		serializedTicketsData, err := utils.MarshalTicketPage(page)
 		if err != nil {
-			fmt.Println()
-			errString := fmt.Errorf("error serialize tickets: %v,%w", tickets, err).Error()
+			errString := fmt.Errorf("error serialize tickets: %w", err).Error()
 			msg.Respond([]byte(errString))
 			return
 		}
@@ -155,29 +179,65 @@ func (r *TicketEventResponderAdapter) ReplayToListTicketsByTrainID(ctx context.C
 
 func (r *TicketEventResponderAdapter) ReplayToBookTicket(ctx context.Context) error {
 	subscription, err := r.natsConn.Subscribe(SubjectRequestBookTicket, func(msg *nats.Msg) {
-		userID, trainID, ticketNumber, err := utils.UnmarshalBookTicketRequest(msg.Data)
+		r.respondIdempotent(ctx, msg, func(recordID uint) ([]byte, error) {
+			bookingRequest, err := utils.UnmarshalBookTicketRequest(msg.Data)
+			if err != nil {
+				return nil, fmt.Errorf("error unmarshal request(BookTicket):%w", err)
+			}
+
+			bookingRequest.IdempotencyRecordID = recordID
+
+			tickets, err := r.APIAdapter.BookTicket(ctx, bookingRequest)
+			if err != nil {
+				return nil, fmt.Errorf("error book ticket:, %w", err)
+			}
+
+			serializedTicketsData, err := utils.MarshalTickets(tickets)
+			if err != nil {
+				return nil, fmt.Errorf("error unmarshal request(BookTicket):%w", err)
+			}
+
+			return serializedTicketsData, nil
+		})
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
 
+	return nil
+}
+
+// ReplyToQuoteTicket prices a book ticket request without booking it.
+func (r *TicketEventResponderAdapter) ReplyToQuoteTicket(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestQuoteTicket, func(msg *nats.Msg) {
+		bookingRequest, err := utils.UnmarshalBookTicketRequest(msg.Data)
 		if err != nil {
-			errString := fmt.Errorf("error unmarshal request(BookTicket):%w", err).Error()
+			errString := fmt.Errorf("error unmarshal request(QuoteTicket):%w", err).Error()
 			msg.Respond([]byte(errString))
 			return
 		}
 
-		tickets, err := r.APIAdapter.BookTicket(ctx, userID, trainID, ticketNumber)
+		quote, err := r.APIAdapter.QuoteBooking(ctx, bookingRequest)
 		if err != nil {
-			errString := fmt.Errorf("error book ticket:, %w", err).Error()
+			errString := fmt.Errorf("error quote ticket:%w", err).Error()
 			msg.Respond([]byte(errString))
 			return
 		}
 
-		serializedTicketsData, err := utils.MarshalTickets(tickets)
+		serializedQuoteData, err := utils.MarshalQuoteTicketReply(quote)
 		if err != nil {
-			errString := fmt.Errorf("error unmarshal request(BookTicket):%w", err).Error()
+			errString := fmt.Errorf("error serialize quote: %v,%w", quote, err).Error()
 			msg.Respond([]byte(errString))
 			return
 		}
 
-		msg.Respond(serializedTicketsData)
+		msg.Respond(serializedQuoteData)
 	})
 
 	if err != nil {
@@ -194,23 +254,596 @@ func (r *TicketEventResponderAdapter) ReplayToBookTicket(ctx context.Context) er
 
 func (r *TicketEventResponderAdapter) ReplayToCancelTicket(ctx context.Context) error {
 	subscription, err := r.natsConn.Subscribe(SubjectRequestCancelTicket, func(msg *nats.Msg) {
+		r.respondIdempotent(ctx, msg, func(uint) ([]byte, error) {
+			ticketIDstr := string(msg.Data)
+			ticketID, err := strconv.Atoi(ticketIDstr)
+			if err != nil {
+				return nil, fmt.Errorf("error invalid ticketID: %d", ticketID)
+			}
+
+			cancellation, err := r.APIAdapter.CancelTicket(ctx, uint(ticketID))
+			if err != nil {
+				return nil, fmt.Errorf("error cancel ticket:%d, %w", ticketID, err)
+			}
+
+			serializedCancellationData, err := utils.MarshalCancelTicketReply(cancellation)
+			if err != nil {
+				return nil, fmt.Errorf("error serialize cancellation: %v,%w", cancellation, err)
+			}
+
+			return serializedCancellationData, nil
+		})
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TicketEventResponderAdapter) ReplyToHoldTicket(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestHoldTicket, func(msg *nats.Msg) {
+		bookingRequest, err := utils.UnmarshalBookTicketRequest(msg.Data)
+		if err != nil {
+			errString := fmt.Errorf("error unmarshal request(HoldTicket):%w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		hold, err := r.APIAdapter.HoldSeats(ctx, bookingRequest)
+		if err != nil {
+			errString := fmt.Errorf("error hold seats: %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		serializedHoldData, err := utils.MarshalSeatHold(hold)
+		if err != nil {
+			errString := fmt.Errorf("error serialize seat hold: %v,%w", hold, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		msg.Respond(serializedHoldData)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TicketEventResponderAdapter) ReplyToConfirmTicketHold(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestConfirmTicketHold, func(msg *nats.Msg) {
+		holdIDstr := string(msg.Data)
+		holdID, err := strconv.Atoi(holdIDstr)
+		if err != nil {
+			errString := fmt.Errorf("error invalid holdID: %s, %w", holdIDstr, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		tickets, err := r.APIAdapter.ConfirmSeatHold(ctx, uint(holdID))
+		if err != nil {
+			errString := fmt.Errorf("error confirm seat hold:%d, %w", holdID, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		serializedTicketsData, err := utils.MarshalTickets(tickets)
+		if err != nil {
+			errString := fmt.Errorf("error serialize tickets: %v,%w", tickets, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		msg.Respond(serializedTicketsData)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TicketEventResponderAdapter) ReplyToReleaseTicketHold(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestReleaseTicketHold, func(msg *nats.Msg) {
+		holdIDstr := string(msg.Data)
+		holdID, err := strconv.Atoi(holdIDstr)
+		if err != nil {
+			errString := fmt.Errorf("error invalid holdID: %s, %w", holdIDstr, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		err = r.APIAdapter.ReleaseSeatHold(ctx, uint(holdID))
+		if err != nil {
+			errString := fmt.Errorf("error release seat hold:%d, %w", holdID, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		msg.Respond([]byte("seat hold released successfully"))
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TicketEventResponderAdapter) ReplyToJoinWaitlist(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestJoinWaitlist, func(msg *nats.Msg) {
+		userID, trainID, ticketNumber, err := utils.UnmarshalJoinWaitlistRequest(msg.Data)
+		if err != nil {
+			errString := fmt.Errorf("error unmarshal request(JoinWaitlist):%w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		entry, err := r.APIAdapter.JoinWaitlist(ctx, userID, trainID, ticketNumber)
+		if err != nil {
+			errString := fmt.Errorf("error join waitlist: %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		serializedEntryData, err := utils.MarshalWaitlistEntry(entry)
+		if err != nil {
+			errString := fmt.Errorf("error serialize waitlist entry: %v,%w", entry, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		msg.Respond(serializedEntryData)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TicketEventResponderAdapter) ReplyToGetWaitlistEntry(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestGetWaitlistEntry, func(msg *nats.Msg) {
+		entryIDstr := string(msg.Data)
+		entryID, err := strconv.Atoi(entryIDstr)
+		if err != nil {
+			errString := fmt.Errorf("error invalid entryID: %s, %w", entryIDstr, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		entry, err := r.APIAdapter.GetWaitlistEntry(ctx, uint(entryID))
+		if err != nil {
+			errString := fmt.Errorf("error get waitlist entry: %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		serializedEntryData, err := utils.MarshalWaitlistEntry(entry)
+		if err != nil {
+			errString := fmt.Errorf("error serialize waitlist entry: %v,%w", entry, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		msg.Respond(serializedEntryData)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TicketEventResponderAdapter) ReplyToLeaveWaitlist(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestLeaveWaitlist, func(msg *nats.Msg) {
+		entryIDstr := string(msg.Data)
+		entryID, err := strconv.Atoi(entryIDstr)
+		if err != nil {
+			errString := fmt.Errorf("error invalid entryID: %s, %w", entryIDstr, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		err = r.APIAdapter.LeaveWaitlist(ctx, uint(entryID))
+		if err != nil {
+			errString := fmt.Errorf("error leave waitlist:%d, %w", entryID, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		msg.Respond([]byte("left waitlist successfully"))
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TicketEventResponderAdapter) ReplyToGetBookingByReference(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestGetBookingByReference, func(msg *nats.Msg) {
+		reference := string(msg.Data)
+
+		booking, err := r.APIAdapter.GetBookingByReference(ctx, reference)
+		if err != nil {
+			errString := fmt.Errorf("error get booking: %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		serializedBookingData, err := utils.MarshalBooking(booking)
+		if err != nil {
+			errString := fmt.Errorf("error serialize booking: %v,%w", booking, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		msg.Respond(serializedBookingData)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TicketEventResponderAdapter) ReplyToCancelBooking(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestCancelBooking, func(msg *nats.Msg) {
+		r.respondIdempotent(ctx, msg, func(uint) ([]byte, error) {
+			reference, ticketIDs, err := utils.UnmarshalCancelBookingRequest(msg.Data)
+			if err != nil {
+				return nil, fmt.Errorf("error unmarshal request(CancelBooking):%w", err)
+			}
+
+			cancellations, err := r.APIAdapter.CancelBooking(ctx, reference, ticketIDs)
+			if err != nil {
+				return nil, fmt.Errorf("error cancel booking:%s, %w", reference, err)
+			}
+
+			serializedCancellationsData, err := utils.MarshalCancelBookingReply(reference, cancellations)
+			if err != nil {
+				return nil, fmt.Errorf("error serialize cancellations: %v,%w", cancellations, err)
+			}
+
+			return serializedCancellationsData, nil
+		})
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+// ReplyToIssueETicket replies with the signed token of the ticket as text.
+func (r *TicketEventResponderAdapter) ReplyToIssueETicket(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestIssueETicket, func(msg *nats.Msg) {
+		ticketIDstr := string(msg.Data)
+		ticketID, err := strconv.Atoi(ticketIDstr)
+		if err != nil {
+			errString := fmt.Errorf("error invalid ticketID: %s, %w", ticketIDstr, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		token, err := r.APIAdapter.IssueETicket(ctx, uint(ticketID))
+		if err != nil {
+			errString := fmt.Errorf("error issue e-ticket: %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		msg.Respond([]byte(token))
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TicketEventResponderAdapter) ReplyToRenderTicketPDF(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestRenderTicketPDF, func(msg *nats.Msg) {
 		ticketIDstr := string(msg.Data)
 		ticketID, err := strconv.Atoi(ticketIDstr)
 		if err != nil {
-			errString := fmt.Errorf("error invalid ticketID: %d", ticketID).Error()
+			errString := fmt.Errorf("error invalid ticketID: %s, %w", ticketIDstr, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		document, err := r.APIAdapter.RenderTicketPDF(ctx, uint(ticketID))
+		if err != nil {
+			errString := fmt.Errorf("error render ticket pdf: %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		msg.Respond(document)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TicketEventResponderAdapter) ReplyToVerifyETicket(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestVerifyETicket, func(msg *nats.Msg) {
+		ticket, err := r.APIAdapter.VerifyETicket(ctx, string(msg.Data))
+		if err != nil {
+			errString := fmt.Errorf("error verify e-ticket: %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		serializedTicketData, err := utils.MarshalTicket(ticket)
+		if err != nil {
+			errString := fmt.Errorf("error serialize ticket: %v,%w", ticket, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		msg.Respond(serializedTicketData)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+// ReplyToGetETicketPublicKey replies with the base64 encoded public key.
+func (r *TicketEventResponderAdapter) ReplyToGetETicketPublicKey(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestGetETicketPublicKey, func(msg *nats.Msg) {
+		publicKey := r.APIAdapter.GetETicketPublicKey(ctx)
+
+		msg.Respond([]byte(base64.StdEncoding.EncodeToString(publicKey)))
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TicketEventResponderAdapter) ReplyToTransferTicket(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestTransferTicket, func(msg *nats.Msg) {
+		request, err := utils.UnmarshalTransferTicketRequest(msg.Data)
+		if err != nil {
+			errString := fmt.Errorf("error unmarshal request(TransferTicket):%w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		transfer, err := r.APIAdapter.TransferTicket(ctx, request)
+		if err != nil {
+			errString := fmt.Errorf("error transfer ticket:%d, %w", request.TicketID, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		r.respondTicketTransfer(msg, transfer)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TicketEventResponderAdapter) ReplyToGetTicketTransfer(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestGetTicketTransfer, func(msg *nats.Msg) {
+		transferIDstr := string(msg.Data)
+		transferID, err := strconv.Atoi(transferIDstr)
+		if err != nil {
+			errString := fmt.Errorf("error invalid transferID: %s, %w", transferIDstr, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		transfer, err := r.APIAdapter.GetTicketTransfer(ctx, uint(transferID))
+		if err != nil {
+			errString := fmt.Errorf("error get ticket transfer: %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		r.respondTicketTransfer(msg, transfer)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TicketEventResponderAdapter) ReplyToAcceptTicketTransfer(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestAcceptTicketTransfer, func(msg *nats.Msg) {
+		transferID, userID, err := utils.UnmarshalAcceptTicketTransferRequest(msg.Data)
+		if err != nil {
+			errString := fmt.Errorf("error unmarshal request(AcceptTicketTransfer):%w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		transfer, err := r.APIAdapter.AcceptTicketTransfer(ctx, transferID, userID)
+		if err != nil {
+			errString := fmt.Errorf("error accept ticket transfer:%d, %w", transferID, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		r.respondTicketTransfer(msg, transfer)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
 
+	return nil
+}
+
+func (r *TicketEventResponderAdapter) ReplyToExchangeTicket(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestExchangeTicket, func(msg *nats.Msg) {
+		r.respondIdempotent(ctx, msg, func(uint) ([]byte, error) {
+			request, err := utils.UnmarshalExchangeTicketRequest(msg.Data)
+			if err != nil {
+				return nil, fmt.Errorf("error unmarshal request(ExchangeTicket):%w", err)
+			}
+
+			exchange, err := r.APIAdapter.ExchangeTicket(ctx, request)
+			if err != nil {
+				return nil, fmt.Errorf("error exchange ticket:%d, %w", request.TicketID, err)
+			}
+
+			serializedExchangeData, err := utils.MarshalExchangeTicketReply(exchange)
+			if err != nil {
+				return nil, fmt.Errorf("error serialize exchange: %v,%w", exchange, err)
+			}
+
+			return serializedExchangeData, nil
+		})
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TicketEventResponderAdapter) respondTicketTransfer(msg *nats.Msg, transfer *domain.TicketTransfer) {
+	serializedTransferData, err := utils.MarshalTicketTransfer(transfer)
+	if err != nil {
+		errString := fmt.Errorf("error serialize ticket transfer: %v,%w", transfer, err).Error()
+		msg.Respond([]byte(errString))
+		return
+	}
+
+	msg.Respond(serializedTransferData)
+}
+
+func (r *TicketEventResponderAdapter) ReplyToCreatePromoCode(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestCreatePromoCode, func(msg *nats.Msg) {
+		promo, err := utils.UnmarshalPromoCode(msg.Data)
+		if err != nil {
+			errString := fmt.Errorf("error unmarshal request(CreatePromoCode):%w", err).Error()
 			msg.Respond([]byte(errString))
 			return
 		}
 
-		err = r.APIAdapter.CancelTicket(ctx, uint(ticketID))
+		err = r.APIAdapter.CreatePromoCode(ctx, promo)
 		if err != nil {
-			errString := fmt.Errorf("error cancel ticket:%d, %w", ticketID, err).Error()
+			errString := fmt.Errorf("error create promo code: %w", err).Error()
 			msg.Respond([]byte(errString))
 			return
 		}
 
-		msg.Respond([]byte("ticket canceled successfully"))
+		r.respondPromoCode(msg, promo)
 	})
 
 	if err != nil {
@@ -224,3 +857,97 @@ func (r *TicketEventResponderAdapter) ReplayToCancelTicket(ctx context.Context)
 
 	return nil
 }
+
+func (r *TicketEventResponderAdapter) ReplyToGetPromoCode(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestGetPromoCode, func(msg *nats.Msg) {
+		code := string(msg.Data)
+
+		promo, err := r.APIAdapter.GetPromoCode(ctx, code)
+		if err != nil {
+			errString := fmt.Errorf("error get promo code: %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		r.respondPromoCode(msg, promo)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TicketEventResponderAdapter) ReplyToListPromoCodes(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestListPromoCodes, func(msg *nats.Msg) {
+		promos, err := r.APIAdapter.ListPromoCodes(ctx)
+		if err != nil {
+			errString := fmt.Errorf("error list promo codes: %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		serializedPromosData, err := utils.MarshalPromoCodes(promos)
+		if err != nil {
+			errString := fmt.Errorf("error serialize promo codes: %v,%w", promos, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		msg.Respond(serializedPromosData)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TicketEventResponderAdapter) ReplyToDeactivatePromoCode(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestDeactivatePromoCode, func(msg *nats.Msg) {
+		code := string(msg.Data)
+
+		err := r.APIAdapter.DeactivatePromoCode(ctx, code)
+		if err != nil {
+			errString := fmt.Errorf("error deactivate promo code:%s, %w", code, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		msg.Respond([]byte("promo code deactivated successfully"))
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TicketEventResponderAdapter) respondPromoCode(msg *nats.Msg, promo *domain.PromoCode) {
+	serializedPromoData, err := utils.MarshalPromoCode(promo)
+	if err != nil {
+		errString := fmt.Errorf("error serialize promo code: %v,%w", promo, err).Error()
+		msg.Respond([]byte(errString))
+		return
+	}
+
+	msg.Respond(serializedPromoData)
+}
@@ -0,0 +1,118 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"ticket/internal/application/core/domain"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ModeSuccess = "success"
+	ModeDecline = "decline"
+	ModeTimeout = "timeout"
+)
+
+type authorization struct {
+	amount   int64
+	captured int64
+	refunded int64
+}
+
+// FakePaymentAdapter is an in-process payment provider for local runs.
+// Depending on its mode every authorization succeeds, is declined or
+// hangs until the caller gives up.
+type FakePaymentAdapter struct {
+	mode           string
+	mu             sync.Mutex
+	authorizations map[string]*authorization
+	refunds        map[string]bool
+}
+
+func NewFakePaymentAdapter(mode string) (*FakePaymentAdapter, error) {
+	switch mode {
+	case ModeSuccess, ModeDecline, ModeTimeout:
+	default:
+		return nil, fmt.Errorf("unknown fake payment mode: %s", mode)
+	}
+
+	return &FakePaymentAdapter{
+		mode:           mode,
+		authorizations: make(map[string]*authorization),
+		refunds:        make(map[string]bool),
+	}, nil
+}
+
+func (f *FakePaymentAdapter) Authorize(ctx context.Context, userID uint, amount int64, currency string) (string, error) {
+	switch f.mode {
+	case ModeDecline:
+		return "", fmt.Errorf("%w: user %d, %d %s", domain.ErrPaymentDeclined, userID, amount, currency)
+	case ModeTimeout:
+		<-ctx.Done()
+		return "", fmt.Errorf("%w: %v", domain.ErrPaymentTimeout, ctx.Err())
+	}
+
+	authorizationID := uuid.NewString()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.authorizations[authorizationID] = &authorization{amount: amount}
+
+	return authorizationID, nil
+}
+
+func (f *FakePaymentAdapter) Capture(ctx context.Context, authorizationID string, amount int64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	auth, ok := f.authorizations[authorizationID]
+	if !ok {
+		return fmt.Errorf("unknown authorization: %s", authorizationID)
+	}
+
+	if auth.captured+amount > auth.amount {
+		return fmt.Errorf("capture of %d exceeds authorized amount %d", amount, auth.amount)
+	}
+
+	auth.captured += amount
+
+	return nil
+}
+
+func (f *FakePaymentAdapter) CapturedAmount(ctx context.Context, authorizationID string) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	auth, ok := f.authorizations[authorizationID]
+	if !ok {
+		return 0, fmt.Errorf("unknown authorization: %s", authorizationID)
+	}
+
+	return auth.captured, nil
+}
+
+func (f *FakePaymentAdapter) Refund(ctx context.Context, authorizationID, refundKey string, amount int64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.refunds[refundKey] {
+		return nil
+	}
+
+	auth, ok := f.authorizations[authorizationID]
+	if !ok {
+		return fmt.Errorf("unknown authorization: %s", authorizationID)
+	}
+
+	if auth.refunded+amount > auth.captured {
+		return fmt.Errorf("refund of %d exceeds captured amount %d", amount, auth.captured-auth.refunded)
+	}
+
+	auth.refunded += amount
+	f.refunds[refundKey] = true
+
+	return nil
+}
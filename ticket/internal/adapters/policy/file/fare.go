@@ -0,0 +1,30 @@
+package file
+
+import (
+	"context"
+	"ticket/internal/application/core/domain"
+)
+
+// FileFareTableAdapter reads the fare table from a JSON file and reloads it
+// whenever the file changes. Without a file every route costs the default
+// fare under the default class multipliers and discounts.
+type FileFareTableAdapter struct {
+	file *jsonFile[domain.FareTable]
+}
+
+func NewFileFareTableAdapter(path string, defaultFare int64) *FileFareTableAdapter {
+	return &FileFareTableAdapter{
+		file: &jsonFile[domain.FareTable]{
+			name: "fare table",
+			path: path,
+			defaults: func() *domain.FareTable {
+				return domain.DefaultFareTable(defaultFare)
+			},
+			validate: (*domain.FareTable).Validate,
+		},
+	}
+}
+
+func (f *FileFareTableAdapter) GetFareTable(ctx context.Context) (*domain.FareTable, error) {
+	return f.file.get()
+}
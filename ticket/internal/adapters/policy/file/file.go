@@ -0,0 +1,28 @@
+package file
+
+import (
+	"context"
+	"ticket/internal/application/core/domain"
+)
+
+// FileRefundPolicyAdapter reads the refund policy from a JSON file and
+// reloads it whenever the file changes, so rules can be edited without a
+// redeploy. Without a file the default policy applies.
+type FileRefundPolicyAdapter struct {
+	file *jsonFile[domain.RefundPolicy]
+}
+
+func NewFileRefundPolicyAdapter(path string) *FileRefundPolicyAdapter {
+	return &FileRefundPolicyAdapter{
+		file: &jsonFile[domain.RefundPolicy]{
+			name:     "refund policy",
+			path:     path,
+			defaults: domain.DefaultRefundPolicy,
+			validate: (*domain.RefundPolicy).Validate,
+		},
+	}
+}
+
+func (f *FileRefundPolicyAdapter) GetRefundPolicy(ctx context.Context) (*domain.RefundPolicy, error) {
+	return f.file.get()
+}
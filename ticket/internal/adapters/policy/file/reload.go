@@ -0,0 +1,77 @@
+package file
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// jsonFile caches a JSON document read from path and reads it again
+// whenever the file changes. Without a file the default applies.
+type jsonFile[T any] struct {
+	name     string
+	path     string
+	defaults func() *T
+	validate func(*T) error
+	mu       sync.Mutex
+	modTime  time.Time
+	value    *T
+}
+
+func (f *jsonFile[T]) get() (*T, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	info, err := os.Stat(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return f.defaults(), nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to stat %s %s: %w", f.name, f.path, err)
+	}
+
+	if f.value != nil && info.ModTime().Equal(f.modTime) {
+		return f.value, nil
+	}
+
+	value, err := f.read()
+	if err != nil {
+		if f.value == nil {
+			return nil, err
+		}
+
+		// Keep serving the last good document while the file is being fixed
+		log.Printf("reload %s error:%v\n", f.name, err)
+
+		return f.value, nil
+	}
+
+	f.value = value
+	f.modTime = info.ModTime()
+
+	return f.value, nil
+}
+
+func (f *jsonFile[T]) read() (*T, error) {
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s %s: %w", f.name, f.path, err)
+	}
+
+	value := new(T)
+
+	err = json.Unmarshal(data, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s %s: %w", f.name, f.path, err)
+	}
+
+	err = f.validate(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %w", f.name, f.path, err)
+	}
+
+	return value, nil
+}
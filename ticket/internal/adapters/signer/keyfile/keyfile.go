@@ -0,0 +1,87 @@
+package keyfile
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+)
+
+// KeyFileSignerAdapter signs e-tickets with an Ed25519 key whose seed is
+// stored base64 encoded in a file. Every instance sharing the file issues
+// the same tokens.
+type KeyFileSignerAdapter struct {
+	privateKey ed25519.PrivateKey
+}
+
+// NewKeyFileSignerAdapter loads the key at path. A missing file is an
+// error unless generate allows writing a freshly generated key to it,
+// which is meant for development only.
+func NewKeyFileSignerAdapter(path string, generate bool) (*KeyFileSignerAdapter, error) {
+	if path == "" {
+		return nil, errors.New("no ticket signing key file given, set TICKET_SIGNING_KEY_FILE to a path outside the repository")
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && generate {
+		return generateKeyFile(path)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read signing key %s: %w", path, err)
+	}
+
+	seed, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode signing key %s: %w", path, err)
+	}
+
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("signing key %s must hold a %d byte seed, got %d", path, ed25519.SeedSize, len(seed))
+	}
+
+	return &KeyFileSignerAdapter{
+		privateKey: ed25519.NewKeyFromSeed(seed),
+	}, nil
+}
+
+func generateKeyFile(path string) (*KeyFileSignerAdapter, error) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate signing key: %w", err)
+	}
+
+	seed := base64.StdEncoding.EncodeToString(privateKey.Seed())
+
+	// O_EXCL keeps a key written meanwhile by another instance
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signing key %s: %w", path, err)
+	}
+	defer file.Close()
+
+	_, err = file.WriteString(seed + "\n")
+	if err != nil {
+		return nil, fmt.Errorf("failed to write signing key %s: %w", path, err)
+	}
+
+	log.Printf("WARNING: generated a new ticket signing key in %s, do not use a generated key in production\n", path)
+
+	return &KeyFileSignerAdapter{
+		privateKey: privateKey,
+	}, nil
+}
+
+func (k *KeyFileSignerAdapter) Sign(payload []byte) []byte {
+	return ed25519.Sign(k.privateKey, payload)
+}
+
+func (k *KeyFileSignerAdapter) Verify(payload, signature []byte) bool {
+	return ed25519.Verify(k.privateKey.Public().(ed25519.PublicKey), payload, signature)
+}
+
+func (k *KeyFileSignerAdapter) PublicKey() []byte {
+	return k.privateKey.Public().(ed25519.PublicKey)
+}
@@ -14,16 +14,35 @@ type APIAdapter struct {
 	databasePort       ports.DatabasePort
 	eventPublisherPort ports.EventPublisherPort
 	requestPort        ports.RequestPort
+	paymentPort        ports.PaymentPort
+	refundPolicyPort   ports.RefundPolicyPort
+	fareTablePort      ports.FareTablePort
+	ticketSignerPort   ports.TicketSignerPort
+	ticketDocumentPort ports.TicketDocumentPort
+	options            Options
+}
+
+// Options holds the booking settings of the ticket service.
+type Options struct {
+	HoldDuration     time.Duration
+	TransferDuration time.Duration
+	Currency         string
 }
 
 var ErrNoAvailableTrain = errors.New("no available train")
 var ErrTicketHaveAlreadyCanceled = errors.New("ticket have already canceled")
 
-func NewAPIAdapter(dbPort ports.DatabasePort, eventPublisherPort ports.EventPublisherPort, requestPort ports.RequestPort) *APIAdapter {
+func NewAPIAdapter(dbPort ports.DatabasePort, eventPublisherPort ports.EventPublisherPort, requestPort ports.RequestPort, paymentPort ports.PaymentPort, refundPolicyPort ports.RefundPolicyPort, fareTablePort ports.FareTablePort, ticketSignerPort ports.TicketSignerPort, ticketDocumentPort ports.TicketDocumentPort, options Options) *APIAdapter {
 	return &APIAdapter{
 		databasePort:       dbPort,
 		eventPublisherPort: eventPublisherPort,
 		requestPort:        requestPort,
+		paymentPort:        paymentPort,
+		refundPolicyPort:   refundPolicyPort,
+		fareTablePort:      fareTablePort,
+		ticketSignerPort:   ticketSignerPort,
+		ticketDocumentPort: ticketDocumentPort,
+		options:            options,
 	}
 }
 
@@ -31,118 +50,412 @@ func (a *APIAdapter) GetTicketByID(ctx context.Context, ticketID uint) (*domain.
 	return a.databasePort.GetTicketByID(ctx, ticketID)
 }
 
-func (a *APIAdapter) GetTicketsByUserID(ctx context.Context, userID uint) ([]domain.Ticket, error) {
-	return a.databasePort.GetTicketsByUserID(ctx, userID)
+func (a *APIAdapter) GetTicketsByUserID(ctx context.Context, userID uint, filter *domain.TicketFilter) (*domain.TicketPage, error) {
+	filter.UserID, filter.TrainID = userID, 0
+
+	return a.listTickets(ctx, filter)
+}
+
+func (a *APIAdapter) GetTicketsByTrainID(ctx context.Context, trainID uint, filter *domain.TicketFilter) (*domain.TicketPage, error) {
+	filter.UserID, filter.TrainID = 0, trainID
+
+	return a.listTickets(ctx, filter)
 }
 
-func (a *APIAdapter) GetTicketsByTrainID(ctx context.Context, trainID uint) ([]domain.Ticket, error) {
-	return a.databasePort.GetTicketsByTrainID(ctx, trainID)
+func (a *APIAdapter) listTickets(ctx context.Context, filter *domain.TicketFilter) (*domain.TicketPage, error) {
+	err := filter.Normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	return a.databasePort.ListTickets(ctx, filter, time.Now())
 }
 
-func (a *APIAdapter) BookTicket(ctx context.Context, userID, trainID, ticketNumber uint) ([]domain.Ticket, error) {
-	availableTrain, err := a.requestPort.RequestGetTrainByID(ctx, trainID)
+func (a *APIAdapter) BookTicket(ctx context.Context, request *domain.BookingRequest) ([]domain.Ticket, error) {
+	booking, err := a.bookingOfIdempotentRequest(ctx, request.IdempotencyRecordID)
 	if err != nil {
 		return nil, err
+	} else if booking != nil {
+		return booking.Tickets, nil
+	}
+
+	availableTrain, err := a.requestTrain(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.TicketNumber == 0 && !request.HasSeatChoice() {
+		request.TicketNumber = uint(len(request.Passengers))
+	}
+
+	seats, err := a.selectSeats(ctx, availableTrain, request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = request.CheckPassengers(len(seats), time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return a.bookSeats(ctx, request, availableTrain, seats)
+}
+
+// QuoteBooking prices the booking request the way BookTicket would book
+// it, without holding or booking any seat.
+func (a *APIAdapter) QuoteBooking(ctx context.Context, request *domain.BookingRequest) (*domain.Quote, error) {
+	availableTrain, err := a.requestTrain(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.TicketNumber == 0 && !request.HasSeatChoice() {
+		request.TicketNumber = uint(len(request.Passengers))
+	}
+
+	seats, err := a.selectSeats(ctx, availableTrain, request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = request.CheckPassengers(len(seats), time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	fares, err := a.priceSeats(ctx, availableTrain, seats, request.Passengers)
+	if err != nil {
+		return nil, err
+	}
+
+	quote := &domain.Quote{
+		TrainID:  availableTrain.ID,
+		Fares:    fares,
+		Currency: a.options.Currency,
 	}
 
-	trainSeatsLen := len(availableTrain.Seats)
+	promo, discount, err := a.applyPromoCode(ctx, request.PromoCode, availableTrain, fares)
+	if err != nil {
+		return nil, err
+	}
 
-	if trainSeatsLen < int(ticketNumber) {
-		return nil, fmt.Errorf("%w for %d tickets", ErrNoAvailableTrain, ticketNumber)
+	if promo != nil {
+		quote.PromoCode = promo.Code
+		quote.PromoDiscount = discount
 	}
 
-	bookedTickets := []domain.Ticket{}
+	quote.Total = domain.FareTotal(fares)
+
+	return quote, nil
+}
 
-	if ticketNumber == 1 {
-		selectedSeat := availableTrain.Seats[0]
+// priceSeats prices every seat for the passenger at the same position, or
+// for an adult when no passenger is given.
+func (a *APIAdapter) priceSeats(ctx context.Context, train *domain.Train, seats []domain.Seat, passengers []domain.Passenger) ([]domain.Fare, error) {
+	fareTable, err := a.fareTablePort.GetFareTable(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-		selectedSeat.UserID = userID
+	fares := make([]domain.Fare, 0, len(seats))
 
-		ticket := domain.NewTicket(userID,
-			availableTrain.ID, selectedSeat.ID,
-			selectedSeat.SeatNumber,
-			availableTrain.DepartureTime,
-			availableTrain.TravelDetails)
+	for i := range seats {
+		var passenger *domain.Passenger
+		if i < len(passengers) {
+			passenger = &passengers[i]
+		}
 
-		tx, err := a.databasePort.CreateTicket(ctx, ticket)
+		fare, err := fareTable.Price(train, &seats[i], passenger)
 		if err != nil {
 			return nil, err
 		}
 
-		err = a.eventPublisherPort.PublishSeatBooked(ctx, &selectedSeat)
+		fares = append(fares, fare)
+	}
+
+	return fares, nil
+}
+
+// bookSeats books and pays the given seats of the train for the user of
+// the request. Passengers are matched to the seats by position and each
+// ticket keeps the fare it was sold at, net of the promo code if any.
+func (a *APIAdapter) bookSeats(ctx context.Context, request *domain.BookingRequest, availableTrain *domain.Train, seats []domain.Seat) ([]domain.Ticket, error) {
+	fares, err := a.priceSeats(ctx, availableTrain, seats, request.Passengers)
+	if err != nil {
+		return nil, err
+	}
+
+	promo, discount, err := a.applyPromoCode(ctx, request.PromoCode, availableTrain, fares)
+	if err != nil {
+		return nil, err
+	}
+
+	leg := domain.BookingLeg{
+		Train:      availableTrain,
+		Seats:      seats,
+		Fares:      fares,
+		Passengers: request.Passengers,
+	}
+
+	saga := domain.NewBookingSaga(request.UserID, availableTrain.ID, domain.Segment{}, nil)
+	saga.IdempotencyRecordID = request.IdempotencyRecordID
+
+	return a.bookLegs(ctx, saga, []domain.BookingLeg{leg}, promo, discount)
+}
+
+// bookLegs books and pays the seats of every leg through the new saga, so
+// either all legs are booked or none is. Tickets stay pending_payment until
+// the payment is captured and are returned in leg order. The saga comes
+// without seats and records the cart or the idempotent request it books
+// for, if any.
+func (a *APIAdapter) bookLegs(ctx context.Context, saga *domain.BookingSaga, legs []domain.BookingLeg, promo *domain.PromoCode, discount int64) ([]domain.Ticket, error) {
+	var total int64
+
+	for _, leg := range legs {
+		saga.AddSeats(leg.Train.ID, leg.Train.Segment, leg.Seats)
+		total += domain.FareTotal(leg.Fares)
+	}
+
+	err := a.databasePort.CreateBookingSaga(ctx, saga)
+	if err != nil {
+		return nil, err
+	}
+
+	var redemption *domain.PromoRedemption
+	if promo != nil {
+		redemption = domain.NewPromoRedemption(promo, saga.UserID, saga.ID, discount)
+	}
+
+	payment, err := a.authorizePayment(ctx, saga, total)
+	if err != nil {
+		a.abortBookingSaga(ctx, saga)
+
+		return nil, err
+	}
+
+	bookedTickets, err := a.reserveBookingSaga(ctx, saga, legs, payment, redemption)
+	if err != nil {
+		a.abortBookingSaga(ctx, saga)
+
+		return nil, err
+	}
+
+	err = a.capturePayment(ctx, payment)
+	if err != nil {
+		a.abortBookingSaga(ctx, saga)
+
+		return nil, err
+	}
+
+	history := make([]domain.TicketHistory, 0, len(bookedTickets))
+	now := time.Now()
+
+	for i := range bookedTickets {
+		entry, err := bookedTickets[i].Transition(domain.TicketConfirmed, "payment captured", now)
 		if err != nil {
-			rollBackError := tx.Rollback()
-			log.Printf("roll back error:%v\n", rollBackError)
+			a.abortBookingSaga(ctx, saga)
 
 			return nil, err
 		}
 
-		bookedTickets = append(bookedTickets, *ticket)
+		bookedTickets[i].PaymentStatus = domain.PaymentPaid
+		history = append(history, *entry)
+	}
+
+	messages := []domain.OutboxMessage{}
+	offset := 0
+
+	// Every leg is confirmed with the tickets of its train. A booking whose
+	// confirmation cannot be prepared is still a booking
+	for _, leg := range legs {
+		legTickets := bookedTickets[offset : offset+len(leg.Seats)]
+		offset += len(leg.Seats)
 
-		err = tx.Commit()
+		confirmationMessage, err := a.newBookingConfirmation(ctx, leg.Train, legTickets)
 		if err != nil {
-			return nil, err
+			log.Printf("prepare confirmation of booking saga %d error:%v\n", saga.ID, err)
+			continue
 		}
 
-	} else if ticketNumber > 1 {
-		for i := 0; i < int(ticketNumber); i++ {
-			selectedSeat := availableTrain.Seats[i]
-
-			selectedSeat.UserID = userID
+		messages = append(messages, *confirmationMessage)
+	}
 
-			ticket := domain.NewTicket(userID,
-				availableTrain.ID, selectedSeat.ID,
-				selectedSeat.SeatNumber,
-				availableTrain.DepartureTime,
-				availableTrain.TravelDetails)
+	err = a.databasePort.CompleteBookingSaga(ctx, saga, history, messages)
+	if err != nil {
+		a.abortBookingSaga(ctx, saga)
 
-			tx, err := a.databasePort.CreateTicket(ctx, ticket)
-			if err != nil {
-				return nil, err
-			}
+		return nil, err
+	}
 
-			err = a.eventPublisherPort.PublishSeatBooked(ctx, &selectedSeat)
-			if err != nil {
-				rollBackError := tx.Rollback()
-				log.Printf("roll back error:%v\n", rollBackError)
+	return bookedTickets, nil
+}
 
-				return nil, err
-			}
+// requestTrain gets the train of the booking request, narrowed to the
+// segment between the requested stations if any.
+func (a *APIAdapter) requestTrain(ctx context.Context, request *domain.BookingRequest) (*domain.Train, error) {
+	if request.Origin == "" && request.Destination == "" {
+		return a.requestPort.RequestGetTrainByID(ctx, request.TrainID)
+	}
 
-			bookedTickets = append(bookedTickets, *ticket)
+	return a.requestPort.RequestGetTrainSegment(ctx, request.TrainID, request.Origin, request.Destination)
+}
 
-			err = tx.Commit()
-			if err != nil {
-				return nil, err
-			}
+func (a *APIAdapter) selectSeats(ctx context.Context, availableTrain *domain.Train, request *domain.BookingRequest) ([]domain.Seat, error) {
+	if !request.HasSeatChoice() {
+		seats := request.SeatsOfClass(availableTrain.Seats)
+		if len(seats) < int(request.TicketNumber) {
+			return nil, fmt.Errorf("%w for %d tickets", ErrNoAvailableTrain, request.TicketNumber)
 		}
+
+		return seats[:request.TicketNumber], nil
 	}
 
-	return bookedTickets, nil
+	// The train only carries its free seats, so the full seat list is needed
+	// to tell a seat of another train apart from one that was just taken.
+	// Seats taken on any part of the segment are listed as booked.
+	trainSeats, err := a.requestPort.RequestListSeatsBySegment(ctx, availableTrain.ID, availableTrain.Segment)
+	if err != nil {
+		return nil, err
+	}
+
+	return request.ChooseSeats(trainSeats)
 }
 
-func (a *APIAdapter) CancelTicket(ctx context.Context, ticketID uint) error {
+// CancelTicket cancels the ticket under the current refund policy and
+// refunds the amount the policy grants for a paid ticket.
+func (a *APIAdapter) CancelTicket(ctx context.Context, ticketID uint) (*domain.Cancellation, error) {
 	ticket, err := a.databasePort.GetTicketByID(ctx, ticketID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if ticket.Status == domain.TicketCanceled || ticket.Status == domain.TicketRefunded {
+		return nil, ErrTicketHaveAlreadyCanceled
+	}
+
+	policy, err := a.refundPolicyPort.GetRefundPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.cancelTicket(ctx, ticket, policy, time.Now())
+}
+
+// CancelBooking cancels the given tickets of the booking, or all of its
+// tickets that can still be canceled when no ticket is given.
+func (a *APIAdapter) CancelBooking(ctx context.Context, reference string, ticketIDs []uint) ([]domain.Cancellation, error) {
+	booking, err := a.databasePort.GetBookingByReference(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	tickets, err := booking.SelectTickets(ticketIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := a.refundPolicyPort.GetRefundPolicy(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	if ticket.CanceledAt != nil {
-		return ErrTicketHaveAlreadyCanceled
+	now := time.Now()
+
+	// Check every ticket up front so that a rejected ticket does not leave
+	// the booking half canceled.
+	for i := range tickets {
+		if !tickets[i].CanTransition(domain.TicketCanceled) {
+			return nil, fmt.Errorf("%w: ticket %d is %s", domain.ErrInvalidTicketTransition, tickets[i].ID, tickets[i].Status)
+		}
+
+		if tickets[i].IsExpired(now) {
+			return nil, fmt.Errorf("%w: ticket %d has expired", domain.ErrCancellationNotAllowed, tickets[i].ID)
+		}
+
+		_, err := policy.RefundAmount(tickets[i].Price, tickets[i].DepartureTime, now)
+		if err != nil {
+			return nil, err
+		}
 	}
 
+	cancellations := make([]domain.Cancellation, 0, len(tickets))
+
+	for i := range tickets {
+		cancellation, err := a.cancelTicket(ctx, &tickets[i], policy, now)
+		if err != nil {
+			return nil, fmt.Errorf("canceled %d of %d tickets of booking %s: %w", i, len(tickets), reference, err)
+		}
+
+		cancellations = append(cancellations, *cancellation)
+	}
+
+	return cancellations, nil
+}
+
+func (a *APIAdapter) GetBookingByReference(ctx context.Context, reference string) (*domain.Booking, error) {
+	return a.databasePort.GetBookingByReference(ctx, reference)
+}
+
+func (a *APIAdapter) cancelTicket(ctx context.Context, ticket *domain.Ticket, policy *domain.RefundPolicy, now time.Time) (*domain.Cancellation, error) {
+	// Tickets the expiry job has not reached yet are expired all the same
+	if ticket.Status == domain.TicketExpired || ticket.IsExpired(now) {
+		return nil, fmt.Errorf("%w: ticket %d has expired", domain.ErrCancellationNotAllowed, ticket.ID)
+	}
+
+	refundAmount, err := policy.RefundAmount(ticket.Price, ticket.DepartureTime, now)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := ticket.Transition(domain.TicketCanceled, "canceled by passenger", now)
+	if err != nil {
+		return nil, err
+	}
+
+	history := []domain.TicketHistory{*entry}
+
 	seat := &domain.Seat{
 		ID:         ticket.SeatID,
 		TrainID:    ticket.TrainID,
+		UserID:     ticket.UserID,
 		SeatNumber: ticket.SeatNumber,
+		Segment:    ticket.Segment,
+	}
+
+	seatMessage, err := newSeatEvent(domain.SeatBookCanceledEvent, seat)
+	if err != nil {
+		return nil, err
 	}
 
-	err = a.eventPublisherPort.PublishSeatBookingCanceled(ctx, seat)
+	refunds, err := a.planTicketRefunds(ctx, ticket, refundAmount)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	cancellation := &domain.Cancellation{
+		TicketID:     ticket.ID,
+		UserID:       ticket.UserID,
+		TrainID:      ticket.TrainID,
+		SeatNumber:   ticket.SeatNumber,
+		RefundAmount: domain.RefundTotal(refunds),
+		Currency:     ticket.Currency,
+		CanceledAt:   now,
+	}
+
+	ticketMessage, err := newTicketCanceledEvent(cancellation)
+	if err != nil {
+		return nil, err
+	}
+
+	// The cancellation and its refunds are saved before any money moves, so
+	// a second cancel loses the status guard and pays nothing. The ticket
+	// turns refunded once the provider has paid every refund.
+	err = a.databasePort.TransitionTicket(ctx, ticket, history, refunds, []domain.OutboxMessage{*seatMessage, *ticketMessage})
+	if err != nil {
+		return nil, err
 	}
 
-	err = a.databasePort.UpdateCanceledAt(ctx, ticketID, time.Now())
+	a.payRefunds(ctx, refunds)
 
-	return err
+	return cancellation, nil
 }
@@ -0,0 +1,211 @@
+package api
+
+import (
+	"context"
+	"log"
+	"ticket/internal/application/core/domain"
+	"time"
+)
+
+func (a *APIAdapter) CreateCart(ctx context.Context, userID uint) (*domain.Cart, error) {
+	_, err := a.requestPort.RequestGetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	cart := domain.NewCart(userID)
+
+	err = a.databasePort.CreateCart(ctx, cart)
+	if err != nil {
+		return nil, err
+	}
+
+	return cart, nil
+}
+
+func (a *APIAdapter) GetCart(ctx context.Context, cartID uint) (*domain.Cart, error) {
+	return a.databasePort.GetCartByID(ctx, cartID)
+}
+
+// AddCartLeg adds a train with the number of tickets to book on it to the
+// open cart. Seats are only chosen at checkout.
+func (a *APIAdapter) AddCartLeg(ctx context.Context, cartID uint, leg *domain.CartLeg) (*domain.Cart, error) {
+	cart, err := a.databasePort.GetCartByID(ctx, cartID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = cart.AddLeg(leg)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = a.requestPort.RequestGetTrainByID(ctx, leg.TrainID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.databasePort.AddCartLeg(ctx, leg)
+	if err != nil {
+		return nil, err
+	}
+
+	cart.Legs = append(cart.Legs, *leg)
+
+	return cart, nil
+}
+
+func (a *APIAdapter) RemoveCartLeg(ctx context.Context, cartID, legID uint) (*domain.Cart, error) {
+	cart, err := a.databasePort.GetCartByID(ctx, cartID)
+	if err != nil {
+		return nil, err
+	}
+
+	if cart.Status != domain.CartOpen {
+		return nil, domain.ErrCartNotOpen
+	}
+
+	_, err = cart.Leg(legID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.databasePort.DeleteCartLeg(ctx, cartID, legID)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.databasePort.GetCartByID(ctx, cartID)
+}
+
+// CheckoutCart books every leg of the cart as a single booking. The cart
+// is claimed first so it cannot be checked out twice, and goes back to
+// open when any leg cannot be booked. A claim left behind by a crash is
+// settled by RecoverBookingSagas.
+func (a *APIAdapter) CheckoutCart(ctx context.Context, request *domain.CheckoutRequest) (*domain.Booking, error) {
+	booking, err := a.bookingOfIdempotentRequest(ctx, request.IdempotencyRecordID)
+	if err != nil || booking != nil {
+		return booking, err
+	}
+
+	cart, err := a.databasePort.GetCartByID(ctx, request.CartID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cart.Legs) == 0 {
+		return nil, domain.ErrCartEmpty
+	}
+
+	err = a.databasePort.UpdateCartStatus(ctx, cart, domain.CartCheckingOut, "")
+	if err != nil {
+		return nil, err
+	}
+
+	tickets, err := a.checkoutCart(ctx, cart, request)
+	if err != nil {
+		a.reopenCart(ctx, cart)
+
+		return nil, err
+	}
+
+	reference := tickets[0].BookingReference
+
+	// The booking is made, a cart left checking out is checked out on recovery
+	err = a.databasePort.UpdateCartStatus(ctx, cart, domain.CartCheckedOut, reference)
+	if err != nil {
+		log.Printf("check out cart %d error:%v\n", cart.ID, err)
+	}
+
+	return a.databasePort.GetBookingByReference(ctx, reference)
+}
+
+// checkoutCart selects and prices the seats of every leg in departure
+// order and books them together.
+func (a *APIAdapter) checkoutCart(ctx context.Context, cart *domain.Cart, checkout *domain.CheckoutRequest) ([]domain.Ticket, error) {
+	legs := make([]domain.BookingLeg, 0, len(cart.Legs))
+	now := time.Now()
+
+	for i := range cart.Legs {
+		request := cart.Legs[i].BookingRequest(cart.UserID, checkout.Passengers)
+
+		availableTrain, err := a.requestPort.RequestGetTrainByID(ctx, request.TrainID)
+		if err != nil {
+			return nil, err
+		}
+
+		seats, err := a.selectSeats(ctx, availableTrain, request)
+		if err != nil {
+			return nil, err
+		}
+
+		err = request.CheckPassengers(len(seats), now)
+		if err != nil {
+			return nil, err
+		}
+
+		fares, err := a.priceSeats(ctx, availableTrain, seats, request.Passengers)
+		if err != nil {
+			return nil, err
+		}
+
+		legs = append(legs, domain.BookingLeg{
+			Train:      availableTrain,
+			Seats:      seats,
+			Fares:      fares,
+			Passengers: request.Passengers,
+		})
+	}
+
+	err := domain.OrderLegs(legs)
+	if err != nil {
+		return nil, err
+	}
+
+	saga := domain.NewBookingSaga(cart.UserID, legs[0].Train.ID, domain.Segment{}, nil)
+	saga.CartID = cart.ID
+	saga.IdempotencyRecordID = checkout.IdempotencyRecordID
+
+	return a.bookLegs(ctx, saga, legs, nil, 0)
+}
+
+func (a *APIAdapter) reopenCart(ctx context.Context, cart *domain.Cart) {
+	err := a.databasePort.UpdateCartStatus(ctx, cart, domain.CartOpen, "")
+	if err != nil {
+		log.Printf("reopen cart %d error:%v\n", cart.ID, err)
+	}
+}
+
+// recoverCartCheckouts settles the carts left checking out by a crash once
+// their sagas are recovered. A cart whose saga completed is checked out
+// with its booking, any other cart is reopened. Carts checking out since
+// staleBefore or with an unfinished saga may still be booked and are left
+// alone.
+func (a *APIAdapter) recoverCartCheckouts(ctx context.Context, staleBefore time.Time) error {
+	carts, err := a.databasePort.ListStaleCartCheckouts(ctx, staleBefore)
+	if err != nil {
+		return err
+	}
+
+	for i := range carts {
+		cart := &carts[i]
+
+		reference, err := a.databasePort.GetCartBookingReference(ctx, cart.ID)
+		if err != nil {
+			log.Printf("recover checkout of cart %d error:%v\n", cart.ID, err)
+			continue
+		}
+
+		if reference == "" {
+			a.reopenCart(ctx, cart)
+			continue
+		}
+
+		err = a.databasePort.UpdateCartStatus(ctx, cart, domain.CartCheckedOut, reference)
+		if err != nil {
+			log.Printf("check out cart %d error:%v\n", cart.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"context"
+	"ticket/internal/application/core/domain"
+)
+
+// RenderTicketPDF renders a printable copy of a confirmed ticket.
+func (a *APIAdapter) RenderTicketPDF(ctx context.Context, ticketID uint) ([]byte, error) {
+	ticket, err := a.databasePort.GetTicketByID(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	train, err := a.requestPort.RequestGetTrainByID(ctx, ticket.TrainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.renderTickets(train, []domain.Ticket{*ticket})
+}
+
+// newBookingConfirmation builds the confirmation event of a paid booking
+// with its tickets attached as a PDF.
+func (a *APIAdapter) newBookingConfirmation(ctx context.Context, train *domain.Train, tickets []domain.Ticket) (*domain.OutboxMessage, error) {
+	user, err := a.requestPort.RequestGetUserByID(ctx, tickets[0].UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := a.renderTickets(train, tickets)
+	if err != nil {
+		return nil, err
+	}
+
+	return newBookingConfirmedEvent(&domain.BookingConfirmation{
+		Reference: tickets[0].BookingReference,
+		User:      user,
+		Train:     train,
+		Tickets:   tickets,
+		Document:  document,
+	})
+}
+
+func (a *APIAdapter) renderTickets(train *domain.Train, tickets []domain.Ticket) ([]byte, error) {
+	documents := make([]domain.TicketDocument, 0, len(tickets))
+
+	for i := range tickets {
+		token, err := a.eTicketToken(&tickets[i])
+		if err != nil {
+			return nil, err
+		}
+
+		documents = append(documents, domain.TicketDocument{
+			Ticket:    &tickets[i],
+			TrainName: train.Name,
+			ETicket:   token,
+		})
+	}
+
+	return a.ticketDocumentPort.RenderTickets(documents)
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"ticket/internal/application/core/domain"
+	"time"
+)
+
+// IssueETicket returns the signed e-ticket token of a confirmed ticket.
+// Ed25519 signatures are deterministic, so the token is the same every time
+// it is issued and does not need to be stored.
+func (a *APIAdapter) IssueETicket(ctx context.Context, ticketID uint) (string, error) {
+	ticket, err := a.databasePort.GetTicketByID(ctx, ticketID)
+	if err != nil {
+		return "", err
+	}
+
+	return a.eTicketToken(ticket)
+}
+
+func (a *APIAdapter) eTicketToken(ticket *domain.Ticket) (string, error) {
+	if ticket.Status != domain.TicketConfirmed && ticket.Status != domain.TicketCheckedIn {
+		return "", fmt.Errorf("%w: ticket %d is %s", domain.ErrETicketNotIssued, ticket.ID, ticket.Status)
+	}
+
+	payload, err := domain.NewETicketClaims(ticket).Payload()
+	if err != nil {
+		return "", err
+	}
+
+	return domain.ETicketToken(payload, a.ticketSignerPort.Sign(payload)), nil
+}
+
+// VerifyETicket validates a scanned token against the ticket it was issued
+// for and checks the ticket in. A token can only be checked in once, at any
+// time before the train arrives.
+func (a *APIAdapter) VerifyETicket(ctx context.Context, token string) (*domain.Ticket, error) {
+	claims, payload, signature, err := domain.ParseETicketToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if !a.ticketSignerPort.Verify(payload, signature) {
+		return nil, domain.ErrInvalidETicket
+	}
+
+	ticket, err := a.databasePort.GetTicketByID(ctx, claims.TicketID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !claims.Matches(ticket) {
+		return nil, fmt.Errorf("%w: ticket %d", domain.ErrETicketMismatch, ticket.ID)
+	}
+
+	now := time.Now()
+
+	switch ticket.Status {
+	case domain.TicketConfirmed:
+		if ticket.HasArrived(now) {
+			return nil, fmt.Errorf("%w: train of ticket %d has arrived", domain.ErrTicketNotValid, ticket.ID)
+		}
+	case domain.TicketCheckedIn, domain.TicketUsed:
+		return nil, fmt.Errorf("%w: ticket %d", domain.ErrTicketAlreadyUsed, ticket.ID)
+	default:
+		return nil, fmt.Errorf("%w: ticket %d is %s", domain.ErrTicketNotValid, ticket.ID, ticket.Status)
+	}
+
+	entry, err := ticket.Transition(domain.TicketCheckedIn, "checked in by conductor", now)
+	if err != nil {
+		return nil, err
+	}
+
+	// The status guard of the transition rejects a second scan racing this one
+	err = a.databasePort.TransitionTicket(ctx, ticket, []domain.TicketHistory{*entry}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ticket.History = append(ticket.History, *entry)
+
+	return ticket, nil
+}
+
+// GetETicketPublicKey returns the Ed25519 public key conductors use to check
+// token signatures offline.
+func (a *APIAdapter) GetETicketPublicKey(ctx context.Context) []byte {
+	return a.ticketSignerPort.PublicKey()
+}
@@ -0,0 +1,191 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"ticket/internal/application/core/domain"
+	"time"
+)
+
+// ExchangeTicket moves a confirmed ticket to a seat of another train. The
+// new seat is booked through a booking saga, so a failure at any step
+// releases it again and leaves the old ticket untouched. A dearer ticket is
+// topped up from the saga payment and a cheaper one refunded the
+// difference. The new ticket stays under the booking reference of the old.
+func (a *APIAdapter) ExchangeTicket(ctx context.Context, request *domain.ExchangeRequest) (*domain.Exchange, error) {
+	oldTicket, err := a.databasePort.GetTicketByID(ctx, request.TicketID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	err = oldTicket.CanExchange(now)
+	if err != nil {
+		return nil, err
+	}
+
+	train, err := a.requestPort.RequestGetTrainByID(ctx, request.TrainID)
+	if err != nil {
+		return nil, err
+	}
+
+	seats, err := a.selectSeats(ctx, train, request.BookingRequest(oldTicket.UserID))
+	if err != nil {
+		return nil, err
+	}
+
+	if seats[0].ID == oldTicket.SeatID {
+		return nil, domain.ErrExchangeSameSeat
+	}
+
+	fares, err := a.priceSeats(ctx, train, seats, []domain.Passenger{oldTicket.Passenger})
+	if err != nil {
+		return nil, err
+	}
+
+	exchange := &domain.Exchange{
+		OldTicket:      oldTicket,
+		FareDifference: fares[0].Price - oldTicket.Price,
+		Currency:       oldTicket.Currency,
+	}
+
+	saga := domain.NewBookingSaga(oldTicket.UserID, train.ID, train.Segment, seats)
+
+	err = a.databasePort.CreateBookingSaga(ctx, saga)
+	if err != nil {
+		return nil, err
+	}
+
+	var topUp *domain.Payment
+
+	if exchange.FareDifference > 0 {
+		topUp, err = a.authorizePayment(ctx, saga, exchange.FareDifference)
+		if err != nil {
+			a.abortBookingSaga(ctx, saga)
+
+			return nil, err
+		}
+	}
+
+	exchange.NewTicket, err = a.reserveExchangeSaga(ctx, saga, train, &fares[0], oldTicket)
+	if err != nil {
+		a.abortBookingSaga(ctx, saga)
+
+		return nil, err
+	}
+
+	if topUp != nil {
+		err = a.capturePayment(ctx, topUp)
+		if err != nil {
+			a.abortBookingSaga(ctx, saga)
+
+			return nil, err
+		}
+	}
+
+	err = a.completeTicketExchange(ctx, saga, exchange, now)
+	if err != nil {
+		a.abortBookingSaga(ctx, saga)
+
+		return nil, err
+	}
+
+	return exchange, nil
+}
+
+// reserveExchangeSaga writes the replacement ticket of the saga together
+// with the seat booked event. The ticket takes over the passenger, payment
+// and booking reference of the ticket it replaces.
+func (a *APIAdapter) reserveExchangeSaga(ctx context.Context, saga *domain.BookingSaga, train *domain.Train, fare *domain.Fare, oldTicket *domain.Ticket) (*domain.Ticket, error) {
+	seat := &domain.Seat{
+		ID:         saga.Seats[0].SeatID,
+		TrainID:    train.ID,
+		UserID:     saga.UserID,
+		SeatNumber: saga.Seats[0].SeatNumber,
+		Segment:    train.Segment,
+	}
+
+	ticket := domain.NewTicket(saga.UserID,
+		train.ID, seat.ID,
+		seat.SeatNumber,
+		train.DepartureTime,
+		train.TravelDetails,
+		train.Segment)
+
+	ticket.SagaID = saga.ID
+	ticket.PaymentID = oldTicket.PaymentID
+	ticket.Class = fare.Class
+	ticket.Price = fare.Price
+	ticket.Currency = oldTicket.Currency
+	ticket.PaymentStatus = domain.PaymentPending
+	ticket.BookingReference = oldTicket.BookingReference
+	ticket.Passenger = oldTicket.Passenger
+
+	message, err := newSeatEvent(domain.SeatBookedEvent, seat)
+	if err != nil {
+		return nil, err
+	}
+
+	tickets := []domain.Ticket{*ticket}
+
+	err = a.databasePort.ReserveBookingSaga(ctx, saga, nil, tickets, nil, []domain.OutboxMessage{*message})
+	if err != nil {
+		return nil, err
+	}
+
+	return &tickets[0], nil
+}
+
+// completeTicketExchange confirms the new ticket, retires the old one, gives
+// its seat back and records the refund of a cheaper fare, all in one
+// transaction. The refund is paid only once that transaction has committed,
+// so an exchange that fails to save never pays one out.
+func (a *APIAdapter) completeTicketExchange(ctx context.Context, saga *domain.BookingSaga, exchange *domain.Exchange, now time.Time) error {
+	newTicket := exchange.NewTicket
+	oldTicket := exchange.OldTicket
+
+	entry, err := newTicket.Transition(domain.TicketConfirmed, fmt.Sprintf("exchanged from ticket %d", oldTicket.ID), now)
+	if err != nil {
+		return err
+	}
+
+	newTicket.PaymentStatus = domain.PaymentPaid
+	history := []domain.TicketHistory{*entry}
+
+	oldEntry, err := oldTicket.Transition(domain.TicketExchanged, fmt.Sprintf("exchanged for ticket %d", newTicket.ID), now)
+	if err != nil {
+		return err
+	}
+
+	seat := &domain.Seat{
+		ID:         oldTicket.SeatID,
+		TrainID:    oldTicket.TrainID,
+		UserID:     oldTicket.UserID,
+		SeatNumber: oldTicket.SeatNumber,
+		Segment:    oldTicket.Segment,
+	}
+
+	message, err := newSeatEvent(domain.SeatBookCanceledEvent, seat)
+	if err != nil {
+		return err
+	}
+
+	var refunds []domain.Refund
+
+	if exchange.FareDifference < 0 {
+		refunds, err = a.planTicketRefunds(ctx, oldTicket, -exchange.FareDifference)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = a.databasePort.CompleteTicketExchange(ctx, saga, history, oldTicket, []domain.TicketHistory{*oldEntry}, refunds, []domain.OutboxMessage{*message})
+	if err != nil {
+		return err
+	}
+
+	a.payRefunds(ctx, refunds)
+
+	return nil
+}
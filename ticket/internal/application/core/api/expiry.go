@@ -0,0 +1,73 @@
+package api
+
+import (
+	"context"
+	"log"
+	"ticket/internal/application/core/domain"
+	"time"
+)
+
+const (
+	ticketExpiryInterval  = time.Minute
+	ticketExpiryBatchSize = 100
+)
+
+// ExpireTickets closes the tickets whose train has arrived until ctx is
+// canceled.
+func (a *APIAdapter) ExpireTickets(ctx context.Context) {
+	ticker := time.NewTicker(ticketExpiryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := a.expireTickets(ctx, time.Now())
+			if err != nil {
+				log.Printf("expire tickets error:%v\n", err)
+			}
+		}
+	}
+}
+
+// expireTickets works through the tickets of arrived trains in batches. A
+// ticket that fails to expire is left for the next run.
+func (a *APIAdapter) expireTickets(ctx context.Context, now time.Time) error {
+	for {
+		tickets, err := a.databasePort.ListExpiredTickets(ctx, now, ticketExpiryBatchSize)
+		if err != nil {
+			return err
+		}
+
+		expired := 0
+
+		for i := range tickets {
+			err := a.expireTicket(ctx, &tickets[i], now)
+			if err != nil {
+				log.Printf("expire ticket %d error:%v\n", tickets[i].ID, err)
+				continue
+			}
+
+			expired++
+		}
+
+		if len(tickets) < ticketExpiryBatchSize || expired == 0 {
+			return nil
+		}
+	}
+}
+
+func (a *APIAdapter) expireTicket(ctx context.Context, ticket *domain.Ticket, now time.Time) error {
+	entry, err := ticket.Expire(now)
+	if err != nil {
+		return err
+	}
+
+	message, err := newTicketExpiredEvent(ticket)
+	if err != nil {
+		return err
+	}
+
+	return a.databasePort.TransitionTicket(ctx, ticket, []domain.TicketHistory{*entry}, nil, []domain.OutboxMessage{*message})
+}
@@ -0,0 +1,156 @@
+package api
+
+import (
+	"context"
+	"log"
+	"ticket/internal/application/core/domain"
+	"time"
+)
+
+const holdSweepInterval = 30 * time.Second
+
+// HoldSeats reserves seats on the requested segment for the configured
+// hold duration without creating tickets. Seats are picked among those free
+// on the segment, and held only there. The passengers, when given, are kept
+// with the hold for the tickets it is confirmed into.
+func (a *APIAdapter) HoldSeats(ctx context.Context, request *domain.BookingRequest) (*domain.SeatHold, error) {
+	availableTrain, err := a.requestTrain(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	seats, err := a.selectSeats(ctx, availableTrain, request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = request.CheckPassengers(len(seats), time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	seatIDs := make([]uint, 0, len(seats))
+	for _, seat := range seats {
+		seatIDs = append(seatIDs, seat.ID)
+	}
+
+	expiresAt := time.Now().Add(a.options.HoldDuration)
+
+	heldSeats, err := a.requestPort.RequestHoldSeats(ctx, availableTrain.ID, request.UserID, seatIDs, availableTrain.Segment, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	hold := domain.NewSeatHold(request, availableTrain.Segment, heldSeats, expiresAt)
+
+	err = a.databasePort.CreateSeatHold(ctx, hold)
+	if err != nil {
+		return nil, err
+	}
+
+	return hold, nil
+}
+
+// ConfirmSeatHold books the held seats on the segment they were held for,
+// for the passengers given with the hold. When booking fails the hold is
+// released so the seats become free again.
+func (a *APIAdapter) ConfirmSeatHold(ctx context.Context, holdID uint) ([]domain.Ticket, error) {
+	hold, err := a.databasePort.GetSeatHoldByID(ctx, holdID)
+	if err != nil {
+		return nil, err
+	}
+
+	request := hold.BookingRequest()
+
+	err = request.CheckPassengers(len(hold.Seats), time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.databasePort.ClaimSeatHold(ctx, hold, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	availableTrain, err := a.requestTrain(ctx, request)
+	if err != nil {
+		a.abortSeatHold(ctx, hold)
+
+		return nil, err
+	}
+
+	tickets, err := a.bookSeats(ctx, request, availableTrain, hold.TrainSeats())
+	if err != nil {
+		a.abortSeatHold(ctx, hold)
+
+		return nil, err
+	}
+
+	a.fulfillWaitlistOffer(ctx, hold)
+
+	return tickets, nil
+}
+
+func (a *APIAdapter) ReleaseSeatHold(ctx context.Context, holdID uint) error {
+	hold, err := a.databasePort.GetSeatHoldByID(ctx, holdID)
+	if err != nil {
+		return err
+	}
+
+	if hold.Status != domain.SeatHoldHeld {
+		return domain.ErrSeatHoldNotActive
+	}
+
+	return a.releaseSeatHold(ctx, hold, domain.SeatHoldReleased)
+}
+
+// SweepSeatHolds expires overdue holds until ctx is canceled.
+func (a *APIAdapter) SweepSeatHolds(ctx context.Context) {
+	ticker := time.NewTicker(holdSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			holds, err := a.databasePort.ListExpiredSeatHolds(ctx, time.Now())
+			if err != nil {
+				log.Printf("list expired seat holds error:%v\n", err)
+				continue
+			}
+
+			for i := range holds {
+				err := a.releaseSeatHold(ctx, &holds[i], domain.SeatHoldExpired)
+				if err != nil {
+					log.Printf("expire seat hold %d error:%v\n", holds[i].ID, err)
+				}
+			}
+		}
+	}
+}
+
+func (a *APIAdapter) abortSeatHold(ctx context.Context, hold *domain.SeatHold) {
+	err := a.releaseSeatHold(ctx, hold, domain.SeatHoldReleased)
+	if err != nil {
+		log.Printf("release seat hold %d error:%v\n", hold.ID, err)
+	}
+}
+
+// releaseSeatHold marks the hold with status and publishes a release event
+// for every held seat through the outbox.
+func (a *APIAdapter) releaseSeatHold(ctx context.Context, hold *domain.SeatHold, status domain.SeatHoldStatus) error {
+	seats := hold.TrainSeats()
+	messages := make([]domain.OutboxMessage, 0, len(seats))
+
+	for i := range seats {
+		message, err := newSeatEvent(domain.SeatHoldReleasedEvent, &seats[i])
+		if err != nil {
+			return err
+		}
+
+		messages = append(messages, *message)
+	}
+
+	return a.databasePort.ReleaseSeatHold(ctx, hold, status, messages)
+}
@@ -0,0 +1,96 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"ticket/internal/application/core/domain"
+	"time"
+)
+
+// BeginIdempotentRequest claims the key for the request. When the key was
+// already used for the same request the stored record is returned, its
+// status tells whether the original reply can be replayed. A pending
+// record whose lease ran out is taken over and handled again, a booking
+// request then gets the booking of the attempt it takes over from
+// bookingOfIdempotentRequest.
+func (a *APIAdapter) BeginIdempotentRequest(ctx context.Context, scope, key string, request []byte) (*domain.IdempotencyRecord, error) {
+	record := domain.NewIdempotencyRecord(scope, key, request)
+
+	err := a.databasePort.CreateIdempotencyRecord(ctx, record)
+	if err == nil {
+		return record, nil
+	} else if !errors.Is(err, domain.ErrIdempotencyKeyExists) {
+		return nil, err
+	}
+
+	existing, err := a.databasePort.GetIdempotencyRecord(ctx, scope, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !existing.Matches(request) {
+		return nil, domain.ErrIdempotencyKeyReused
+	}
+
+	now := time.Now()
+
+	if existing.LeaseExpired(now) {
+		err = a.databasePort.TakeOverIdempotencyRecord(ctx, existing, now)
+		if err != nil {
+			return nil, err
+		}
+
+		return existing, nil
+	}
+
+	if existing.Status != domain.IdempotencyCompleted {
+		return nil, domain.ErrIdempotencyKeyInProgress
+	}
+
+	return existing, nil
+}
+
+func (a *APIAdapter) CompleteIdempotentRequest(ctx context.Context, record *domain.IdempotencyRecord, response []byte) error {
+	err := a.databasePort.CompleteIdempotencyRecord(ctx, record, response)
+	if err != nil {
+		return err
+	}
+
+	record.Status = domain.IdempotencyCompleted
+	record.Response = response
+
+	return nil
+}
+
+// AbortIdempotentRequest frees the key of a failed request so that it can
+// be retried.
+func (a *APIAdapter) AbortIdempotentRequest(ctx context.Context, record *domain.IdempotencyRecord) error {
+	return a.databasePort.DeleteIdempotencyRecord(ctx, record)
+}
+
+// bookingOfIdempotentRequest returns the booking made by an earlier attempt
+// of the request holding the idempotency record, so that a retry taking the
+// record over does not book again. It returns nil when no attempt booked
+// yet or its saga was compensated, and domain.ErrIdempotencyKeyInProgress
+// while the saga is still unfinished.
+func (a *APIAdapter) bookingOfIdempotentRequest(ctx context.Context, recordID uint) (*domain.Booking, error) {
+	if recordID == 0 {
+		return nil, nil
+	}
+
+	saga, err := a.databasePort.GetBookingSagaByIdempotencyRecordID(ctx, recordID)
+	if errors.Is(err, domain.ErrBookingSagaNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	switch saga.Status {
+	case domain.SagaCompleted:
+		return a.databasePort.GetBookingBySagaID(ctx, saga.ID)
+	case domain.SagaCompensated:
+		return nil, nil
+	default:
+		return nil, domain.ErrIdempotencyKeyInProgress
+	}
+}
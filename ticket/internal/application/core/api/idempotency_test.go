@@ -0,0 +1,181 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"ticket/internal/application/core/domain"
+	"ticket/internal/ports"
+	"time"
+)
+
+// idempotencyDatabase keeps a single idempotency record and the saga its
+// request started in memory.
+type idempotencyDatabase struct {
+	ports.DatabasePort
+
+	record *domain.IdempotencyRecord
+	saga   *domain.BookingSaga
+}
+
+func (d *idempotencyDatabase) CreateIdempotencyRecord(ctx context.Context, record *domain.IdempotencyRecord) error {
+	return domain.ErrIdempotencyKeyExists
+}
+
+func (d *idempotencyDatabase) GetIdempotencyRecord(ctx context.Context, scope, key string) (*domain.IdempotencyRecord, error) {
+	record := *d.record
+	return &record, nil
+}
+
+func (d *idempotencyDatabase) TakeOverIdempotencyRecord(ctx context.Context, record *domain.IdempotencyRecord, now time.Time) error {
+	if record.Token != d.record.Token {
+		return domain.ErrIdempotencyKeyInProgress
+	}
+
+	record.Token = domain.NewIdempotencyToken()
+	record.UpdatedAt = now
+	d.record.Token, d.record.UpdatedAt = record.Token, now
+
+	return nil
+}
+
+func (d *idempotencyDatabase) CompleteIdempotencyRecord(ctx context.Context, record *domain.IdempotencyRecord, response []byte) error {
+	if record.Token != d.record.Token {
+		return domain.ErrIdempotencyLeaseLost
+	}
+
+	d.record.Status = domain.IdempotencyCompleted
+
+	return nil
+}
+
+func (d *idempotencyDatabase) GetBookingSagaByIdempotencyRecordID(ctx context.Context, recordID uint) (*domain.BookingSaga, error) {
+	if d.saga == nil || d.saga.IdempotencyRecordID != recordID {
+		return nil, fmt.Errorf("%w: idempotency record ID %d", domain.ErrBookingSagaNotFound, recordID)
+	}
+
+	return d.saga, nil
+}
+
+func (d *idempotencyDatabase) GetBookingBySagaID(ctx context.Context, sagaID uint) (*domain.Booking, error) {
+	return &domain.Booking{Reference: "ABC234", SagaID: sagaID, Tickets: []domain.Ticket{{ID: 7, SagaID: sagaID}}}, nil
+}
+
+func newPendingRecord(updatedAt time.Time) *domain.IdempotencyRecord {
+	record := domain.NewIdempotencyRecord("ticket.book", "key", []byte("request"))
+	record.ID = 1
+	record.UpdatedAt = updatedAt
+
+	return record
+}
+
+func TestBeginIdempotentRequestTakesOverExpiredLease(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		updatedAt time.Time
+		wantErr   error
+	}{
+		{name: "lease running", updatedAt: now.Add(-time.Second), wantErr: domain.ErrIdempotencyKeyInProgress},
+		{name: "lease expired", updatedAt: now.Add(-2 * domain.IdempotencyLease)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &idempotencyDatabase{record: newPendingRecord(tt.updatedAt)}
+			a := &APIAdapter{databasePort: db}
+
+			record, err := a.BeginIdempotentRequest(context.Background(), "ticket.book", "key", []byte("request"))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("BeginIdempotentRequest() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr == nil && record.Token != db.record.Token {
+				t.Errorf("BeginIdempotentRequest() token = %s, want the renewed %s", record.Token, db.record.Token)
+			}
+		})
+	}
+}
+
+func TestCompleteIdempotentRequestAfterTakeover(t *testing.T) {
+	db := &idempotencyDatabase{record: newPendingRecord(time.Now().Add(-2 * domain.IdempotencyLease))}
+	a := &APIAdapter{databasePort: db}
+
+	stale := *db.record
+
+	_, err := a.BeginIdempotentRequest(context.Background(), "ticket.book", "key", []byte("request"))
+	if err != nil {
+		t.Fatalf("BeginIdempotentRequest() error = %v", err)
+	}
+
+	err = a.CompleteIdempotentRequest(context.Background(), &stale, []byte("reply"))
+	if !errors.Is(err, domain.ErrIdempotencyLeaseLost) {
+		t.Errorf("CompleteIdempotentRequest() of the attempt taken over error = %v, want %v", err, domain.ErrIdempotencyLeaseLost)
+	}
+}
+
+func TestBookTicketReturnsBookingOfEarlierAttempt(t *testing.T) {
+	tests := []struct {
+		name        string
+		saga        *domain.BookingSaga
+		wantTickets int
+		wantErr     error
+	}{
+		{
+			name:        "earlier attempt completed",
+			saga:        &domain.BookingSaga{ID: 3, IdempotencyRecordID: 1, Status: domain.SagaCompleted},
+			wantTickets: 1,
+		},
+		{
+			name:    "earlier attempt still booking",
+			saga:    &domain.BookingSaga{ID: 3, IdempotencyRecordID: 1, Status: domain.SagaStarted},
+			wantErr: domain.ErrIdempotencyKeyInProgress,
+		},
+		{
+			name:    "earlier attempt being compensated",
+			saga:    &domain.BookingSaga{ID: 3, IdempotencyRecordID: 1, Status: domain.SagaCompensating},
+			wantErr: domain.ErrIdempotencyKeyInProgress,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &idempotencyDatabase{saga: tt.saga}
+			a := &APIAdapter{databasePort: db}
+
+			tickets, err := a.BookTicket(context.Background(), &domain.BookingRequest{TrainID: 1, IdempotencyRecordID: 1})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("BookTicket() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if len(tickets) != tt.wantTickets {
+				t.Errorf("BookTicket() tickets = %v, want %d", tickets, tt.wantTickets)
+			}
+		})
+	}
+}
+
+func TestBookingOfIdempotentRequestWithoutBooking(t *testing.T) {
+	tests := []struct {
+		name     string
+		recordID uint
+		saga     *domain.BookingSaga
+	}{
+		{name: "no idempotency key", recordID: 0},
+		{name: "no saga started yet", recordID: 1},
+		{name: "earlier attempt compensated", recordID: 1, saga: &domain.BookingSaga{ID: 3, IdempotencyRecordID: 1, Status: domain.SagaCompensated}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &APIAdapter{databasePort: &idempotencyDatabase{saga: tt.saga}}
+
+			booking, err := a.bookingOfIdempotentRequest(context.Background(), tt.recordID)
+			if err != nil || booking != nil {
+				t.Errorf("bookingOfIdempotentRequest() = %v, %v, want no booking", booking, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"ticket/internal/application/core/domain"
+	"ticket/utils"
+	"time"
+)
+
+const (
+	outboxRelayInterval  = time.Second
+	outboxRelayBatchSize = 100
+	outboxPublishTimeout = 5 * time.Second
+)
+
+func (a *APIAdapter) RelayOutbox(ctx context.Context) {
+	ticker := time.NewTicker(outboxRelayInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := a.relayOutboxMessages(ctx)
+			if err != nil {
+				log.Printf("outbox relay error:%v\n", err)
+			}
+		}
+	}
+}
+
+// relayOutboxMessages claims and publishes due messages batch by batch
+// until none are left. A message that fails holds back only the later
+// messages of its own aggregate, is retried with a growing backoff and is
+// dead-lettered after domain.MaxOutboxAttempts.
+func (a *APIAdapter) relayOutboxMessages(ctx context.Context) error {
+	for ctx.Err() == nil {
+		messages, err := a.databasePort.ClaimOutboxMessages(ctx, outboxRelayBatchSize, time.Now())
+		if err != nil || len(messages) == 0 {
+			return err
+		}
+
+		for i := range messages {
+			err := a.publishOutboxMessage(ctx, &messages[i])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// publishOutboxMessage publishes a claimed message and saves the outcome.
+// Publishing gives up after outboxPublishTimeout, well within the claim
+// lease, so a stuck publish is not retried by another relay meanwhile.
+func (a *APIAdapter) publishOutboxMessage(ctx context.Context, message *domain.OutboxMessage) error {
+	publishCtx, cancel := context.WithTimeout(ctx, outboxPublishTimeout)
+	err := a.eventPublisherPort.PublishOutboxMessage(publishCtx, message)
+	cancel()
+
+	if err != nil {
+		log.Printf("publish outbox message %d attempt %d error:%v\n", message.ID, message.Attempts+1, err)
+		message.Fail(err.Error(), time.Now())
+	} else {
+		message.Sent(time.Now())
+	}
+
+	return a.databasePort.SaveOutboxMessage(ctx, message)
+}
+
+func newSeatEvent(subject string, seat *domain.Seat) (*domain.OutboxMessage, error) {
+	data, err := utils.MarshalSeat(seat)
+	if err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("seat:%d:%d", seat.TrainID, seat.SeatNumber)
+
+	return domain.NewOutboxMessage(key, subject, data), nil
+}
+
+func newTicketCanceledEvent(cancellation *domain.Cancellation) (*domain.OutboxMessage, error) {
+	data, err := utils.MarshalTicketCanceled(cancellation)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewOutboxMessage(domain.AggregateKey("ticket", cancellation.TicketID), domain.TicketCanceledEvent, data), nil
+}
+
+func newTicketExpiredEvent(ticket *domain.Ticket) (*domain.OutboxMessage, error) {
+	data, err := utils.MarshalTicket(ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewOutboxMessage(domain.AggregateKey("ticket", ticket.ID), domain.TicketExpiredEvent, data), nil
+}
+
+func newBookingConfirmedEvent(confirmation *domain.BookingConfirmation) (*domain.OutboxMessage, error) {
+	data, err := utils.MarshalBookingConfirmedNotice(confirmation)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewOutboxMessage("booking:"+confirmation.Reference, domain.BookingConfirmedEvent, data), nil
+}
+
+func newWaitlistOfferedEvent(entry *domain.WaitlistEntry) (*domain.OutboxMessage, error) {
+	data, err := utils.MarshalWaitlistEntry(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewOutboxMessage(domain.AggregateKey("waitlist", entry.ID), domain.WaitlistOfferedEvent, data), nil
+}
+
+func newTicketTransferEvent(subject string, notice *domain.TicketTransferNotice) (*domain.OutboxMessage, error) {
+	data, err := utils.MarshalTicketTransferNotice(notice)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewOutboxMessage(domain.AggregateKey("ticket", notice.Ticket.ID), subject, data), nil
+}
@@ -0,0 +1,106 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"ticket/internal/application/core/domain"
+	"ticket/internal/ports"
+	"time"
+)
+
+// outboxDatabase keeps outbox messages in memory and claims them like the
+// relay query does: due, pending and the oldest pending of their aggregate.
+type outboxDatabase struct {
+	ports.DatabasePort
+
+	messages []domain.OutboxMessage
+}
+
+func (d *outboxDatabase) ClaimOutboxMessages(ctx context.Context, limit int, now time.Time) ([]domain.OutboxMessage, error) {
+	var claimed []domain.OutboxMessage
+
+	held := map[string]bool{}
+
+	for i := range d.messages {
+		message := &d.messages[i]
+		if message.SentAt != nil || message.DeadAt != nil {
+			continue
+		}
+
+		due := message.NextAttemptAt == nil || !message.NextAttemptAt.After(now)
+		if due && !held[message.AggregateKey] && len(claimed) < limit {
+			message.Claim(now)
+			claimed = append(claimed, *message)
+		}
+
+		held[message.AggregateKey] = true
+	}
+
+	return claimed, nil
+}
+
+func (d *outboxDatabase) SaveOutboxMessage(ctx context.Context, message *domain.OutboxMessage) error {
+	for i := range d.messages {
+		if d.messages[i].ID == message.ID {
+			d.messages[i] = *message
+		}
+	}
+
+	return nil
+}
+
+// outboxPublisher fails to publish the subjects in failing and records the
+// IDs of the messages it published.
+type outboxPublisher struct {
+	ports.EventPublisherPort
+
+	failing   map[string]bool
+	published []uint
+}
+
+func (p *outboxPublisher) PublishOutboxMessage(ctx context.Context, message *domain.OutboxMessage) error {
+	if p.failing[message.Subject] {
+		return errors.New("nats: timeout")
+	}
+
+	p.published = append(p.published, message.ID)
+
+	return nil
+}
+
+func TestRelayOutboxMessagesBacksOffFailedMessage(t *testing.T) {
+	db := &outboxDatabase{messages: []domain.OutboxMessage{
+		{ID: 1, AggregateKey: "ticket:1", Subject: domain.TicketCanceledEvent},
+		{ID: 2, AggregateKey: "ticket:1", Subject: domain.TicketExpiredEvent},
+		{ID: 3, AggregateKey: "ticket:2", Subject: domain.TicketExpiredEvent},
+	}}
+	publisher := &outboxPublisher{failing: map[string]bool{domain.TicketCanceledEvent: true}}
+	a := &APIAdapter{databasePort: db, eventPublisherPort: publisher}
+
+	start := time.Now()
+
+	err := a.relayOutboxMessages(context.Background())
+	if err != nil {
+		t.Fatalf("relayOutboxMessages() error = %v", err)
+	}
+
+	if len(publisher.published) != 1 || publisher.published[0] != 3 {
+		t.Errorf("relayOutboxMessages() published %v, want only message 3", publisher.published)
+	}
+
+	failed := db.messages[0]
+	if failed.Attempts != 1 || failed.NextAttemptAt == nil || failed.NextAttemptAt.Before(start.Add(domain.OutboxBackoff(1))) {
+		t.Errorf("failed message attempts = %d, next attempt at %v, want 1 attempt backed off by %v", failed.Attempts, failed.NextAttemptAt, domain.OutboxBackoff(1))
+	}
+
+	// A relay run right away leaves the failed message to its backoff
+	err = a.relayOutboxMessages(context.Background())
+	if err != nil {
+		t.Fatalf("relayOutboxMessages() error = %v", err)
+	}
+
+	if db.messages[0].Attempts != 1 || db.messages[1].SentAt != nil {
+		t.Errorf("second relay attempts = %d, message 2 sent at %v, want the aggregate held back", db.messages[0].Attempts, db.messages[1].SentAt)
+	}
+}
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"ticket/internal/application/core/domain"
+	"time"
+)
+
+// paymentTimeout bounds every call to the payment provider.
+const paymentTimeout = 5 * time.Second
+
+func (a *APIAdapter) authorizePayment(ctx context.Context, saga *domain.BookingSaga, amount int64) (*domain.Payment, error) {
+	paymentCtx, cancel := context.WithTimeout(ctx, paymentTimeout)
+	defer cancel()
+
+	authorizationID, err := a.paymentPort.Authorize(paymentCtx, saga.UserID, amount, a.options.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	payment := domain.NewPayment(saga.ID, saga.UserID, authorizationID, amount, a.options.Currency)
+
+	err = a.databasePort.CreatePayment(ctx, payment)
+	if err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// capturePayment records the payment as capturing before the provider
+// captures it, so a capture whose outcome is lost to a failure or a crash
+// is still settled when the saga is compensated.
+func (a *APIAdapter) capturePayment(ctx context.Context, payment *domain.Payment) error {
+	err := a.databasePort.UpdatePaymentState(ctx, payment.ID, domain.PaymentCapturing)
+	if err != nil {
+		return err
+	}
+
+	payment.State = domain.PaymentCapturing
+
+	paymentCtx, cancel := context.WithTimeout(ctx, paymentTimeout)
+	defer cancel()
+
+	err = a.paymentPort.Capture(paymentCtx, payment.AuthorizationID, payment.Amount)
+	if err != nil {
+		return err
+	}
+
+	err = a.databasePort.UpdatePaymentState(ctx, payment.ID, domain.PaymentCaptured)
+	if err != nil {
+		return err
+	}
+
+	payment.State = domain.PaymentCaptured
+
+	return nil
+}
+
+// settleCapture asks the provider whether the capture of a capturing
+// payment went through and records the outcome.
+func (a *APIAdapter) settleCapture(ctx context.Context, payment *domain.Payment) error {
+	paymentCtx, cancel := context.WithTimeout(ctx, paymentTimeout)
+	defer cancel()
+
+	captured, err := a.paymentPort.CapturedAmount(paymentCtx, payment.AuthorizationID)
+	if err != nil {
+		return err
+	}
+
+	state := domain.PaymentAuthorized
+	if captured > 0 {
+		state = domain.PaymentCaptured
+	}
+
+	err = a.databasePort.UpdatePaymentState(ctx, payment.ID, state)
+	if err != nil {
+		return err
+	}
+
+	payment.State = state
+
+	return nil
+}
+
+func (a *APIAdapter) ticketPayments(ctx context.Context, ticket *domain.Ticket) ([]*domain.Payment, error) {
+	payment, err := a.databasePort.GetPaymentByID(ctx, ticket.PaymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	payments := []*domain.Payment{payment}
+
+	topUp, err := a.databasePort.GetPaymentBySagaID(ctx, ticket.SagaID)
+	if errors.Is(err, domain.ErrPaymentNotFound) {
+		return payments, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if topUp.ID != payment.ID {
+		payments = append(payments, topUp)
+	}
+
+	return payments, nil
+}
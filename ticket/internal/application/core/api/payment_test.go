@@ -0,0 +1,152 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"ticket/internal/application/core/domain"
+	"ticket/internal/ports"
+)
+
+// paymentDatabase keeps a single saga payment and its refunds in memory.
+type paymentDatabase struct {
+	ports.DatabasePort
+
+	payment *domain.Payment
+	refunds []domain.Refund
+}
+
+func (d *paymentDatabase) GetPaymentBySagaID(ctx context.Context, sagaID uint) (*domain.Payment, error) {
+	payment := *d.payment
+	return &payment, nil
+}
+
+func (d *paymentDatabase) GetPaymentByID(ctx context.Context, paymentID uint) (*domain.Payment, error) {
+	payment := *d.payment
+	return &payment, nil
+}
+
+func (d *paymentDatabase) UpdatePaymentState(ctx context.Context, paymentID uint, state domain.PaymentState) error {
+	d.payment.State = state
+	return nil
+}
+
+func (d *paymentDatabase) CreateRefund(ctx context.Context, refund *domain.Refund) error {
+	refund.ID = uint(len(d.refunds) + 1)
+	d.payment.RefundedAmount += refund.Amount
+	d.refunds = append(d.refunds, *refund)
+
+	return nil
+}
+
+func (d *paymentDatabase) CompleteRefund(ctx context.Context, refundID uint) error {
+	d.refunds[refundID-1].State = domain.RefundCompleted
+	return nil
+}
+
+// capturePaymentProvider captures and refunds payments in memory. A capture
+// can go through and still report an error, as when its reply is lost.
+type capturePaymentProvider struct {
+	ports.PaymentPort
+
+	db           *paymentDatabase
+	stateAtCall  domain.PaymentState
+	captureFails bool
+	captured     int64
+	refunded     int64
+}
+
+func (p *capturePaymentProvider) Capture(ctx context.Context, authorizationID string, amount int64) error {
+	p.stateAtCall = p.db.payment.State
+	p.captured += amount
+
+	if p.captureFails {
+		return domain.ErrPaymentTimeout
+	}
+
+	return nil
+}
+
+func (p *capturePaymentProvider) CapturedAmount(ctx context.Context, authorizationID string) (int64, error) {
+	return p.captured, nil
+}
+
+func (p *capturePaymentProvider) Refund(ctx context.Context, authorizationID, refundKey string, amount int64) error {
+	p.refunded += amount
+	return nil
+}
+
+func TestCapturePaymentRecordsCapturingFirst(t *testing.T) {
+	db := &paymentDatabase{payment: domain.NewPayment(1, 1, "auth", 1000, "EUR")}
+	provider := &capturePaymentProvider{db: db}
+	a := &APIAdapter{databasePort: db, paymentPort: provider}
+
+	err := a.capturePayment(context.Background(), db.payment)
+	if err != nil {
+		t.Fatalf("capturePayment() error = %v", err)
+	}
+
+	if provider.stateAtCall != domain.PaymentCapturing {
+		t.Errorf("payment state during capture = %s, want %s", provider.stateAtCall, domain.PaymentCapturing)
+	}
+
+	if db.payment.State != domain.PaymentCaptured {
+		t.Errorf("payment state = %s, want %s", db.payment.State, domain.PaymentCaptured)
+	}
+}
+
+func TestRefundBookingSagaSettlesCapturingPayment(t *testing.T) {
+	tests := []struct {
+		name         string
+		captured     int64
+		wantState    domain.PaymentState
+		wantRefunded int64
+	}{
+		{name: "capture went through", captured: 1000, wantState: domain.PaymentCaptured, wantRefunded: 1000},
+		{name: "capture never reached the provider", captured: 0, wantState: domain.PaymentAuthorized, wantRefunded: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payment := domain.NewPayment(1, 1, "auth", 1000, "EUR")
+			payment.State = domain.PaymentCapturing
+
+			db := &paymentDatabase{payment: payment}
+			provider := &capturePaymentProvider{db: db, captured: tt.captured}
+			a := &APIAdapter{databasePort: db, paymentPort: provider}
+
+			err := a.refundBookingSaga(context.Background(), &domain.BookingSaga{ID: 1})
+			if err != nil {
+				t.Fatalf("refundBookingSaga() error = %v", err)
+			}
+
+			if db.payment.State != tt.wantState {
+				t.Errorf("payment state = %s, want %s", db.payment.State, tt.wantState)
+			}
+
+			if provider.refunded != tt.wantRefunded {
+				t.Errorf("refunded = %d, want %d", provider.refunded, tt.wantRefunded)
+			}
+		})
+	}
+}
+
+func TestRefundBookingSagaAfterLostCaptureReply(t *testing.T) {
+	db := &paymentDatabase{payment: domain.NewPayment(1, 1, "auth", 1000, "EUR")}
+	provider := &capturePaymentProvider{db: db, captureFails: true}
+	a := &APIAdapter{databasePort: db, paymentPort: provider}
+
+	err := a.capturePayment(context.Background(), db.payment)
+	if !errors.Is(err, domain.ErrPaymentTimeout) {
+		t.Fatalf("capturePayment() error = %v, want %v", err, domain.ErrPaymentTimeout)
+	}
+
+	err = a.refundBookingSaga(context.Background(), &domain.BookingSaga{ID: 1})
+	if err != nil {
+		t.Fatalf("refundBookingSaga() error = %v", err)
+	}
+
+	if provider.refunded != provider.captured {
+		t.Errorf("refunded = %d, want the captured %d", provider.refunded, provider.captured)
+	}
+}
@@ -0,0 +1,54 @@
+package api
+
+import (
+	"context"
+	"ticket/internal/application/core/domain"
+	"time"
+)
+
+func (a *APIAdapter) CreatePromoCode(ctx context.Context, promo *domain.PromoCode) error {
+	err := promo.Validate()
+	if err != nil {
+		return err
+	}
+
+	promo.Active = true
+	promo.Uses = 0
+
+	return a.databasePort.CreatePromoCode(ctx, promo)
+}
+
+func (a *APIAdapter) GetPromoCode(ctx context.Context, code string) (*domain.PromoCode, error) {
+	return a.databasePort.GetPromoCodeByCode(ctx, domain.NormalizePromoCode(code))
+}
+
+func (a *APIAdapter) ListPromoCodes(ctx context.Context) ([]domain.PromoCode, error) {
+	return a.databasePort.ListPromoCodes(ctx)
+}
+
+// DeactivatePromoCode stops the code from being redeemed. Its redemptions
+// are kept.
+func (a *APIAdapter) DeactivatePromoCode(ctx context.Context, code string) error {
+	return a.databasePort.DeactivatePromoCode(ctx, domain.NormalizePromoCode(code))
+}
+
+// applyPromoCode checks the code against the train and takes its discount
+// off the fares. Without a code it does nothing.
+func (a *APIAdapter) applyPromoCode(ctx context.Context, code string, train *domain.Train, fares []domain.Fare) (*domain.PromoCode, int64, error) {
+	code = domain.NormalizePromoCode(code)
+	if code == "" {
+		return nil, 0, nil
+	}
+
+	promo, err := a.databasePort.GetPromoCodeByCode(ctx, code)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = promo.Check(train, time.Now())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return promo, promo.Apply(fares), nil
+}
@@ -0,0 +1,187 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log"
+	"ticket/internal/application/core/domain"
+	"time"
+)
+
+const (
+	refundRetryInterval  = time.Minute
+	refundRetryBatchSize = 100
+)
+
+// RetryRefunds pays the refunds still pending at the provider until ctx is
+// canceled.
+func (a *APIAdapter) RetryRefunds(ctx context.Context) {
+	ticker := time.NewTicker(refundRetryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := a.retryRefunds(ctx)
+			if err != nil {
+				log.Printf("retry refunds error:%v\n", err)
+			}
+		}
+	}
+}
+
+// retryRefunds works through one batch of pending refunds. A refund that
+// fails again is left for the next run.
+func (a *APIAdapter) retryRefunds(ctx context.Context) error {
+	refunds, err := a.databasePort.ListPendingRefunds(ctx, refundRetryBatchSize)
+	if err != nil {
+		return err
+	}
+
+	a.payRefunds(ctx, refunds)
+
+	return nil
+}
+
+// refundBookingSaga returns whatever is left of a captured saga payment.
+// A payment left capturing is first settled with the provider. An
+// authorization that was never captured simply lapses at the provider.
+func (a *APIAdapter) refundBookingSaga(ctx context.Context, saga *domain.BookingSaga) error {
+	payment, err := a.databasePort.GetPaymentBySagaID(ctx, saga.ID)
+	if errors.Is(err, domain.ErrPaymentNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if payment.State == domain.PaymentCapturing {
+		err = a.settleCapture(ctx, payment)
+		if err != nil {
+			return err
+		}
+	}
+
+	amount := payment.Refundable()
+	if amount == 0 {
+		return nil
+	}
+
+	refund := domain.NewRefund(payment.ID, 0, amount)
+
+	err = a.databasePort.CreateRefund(ctx, refund)
+	if err != nil {
+		return err
+	}
+
+	a.payRefunds(ctx, []domain.Refund{*refund})
+
+	return nil
+}
+
+// planTicketRefunds splits a refund of up to amount of a paid ticket over
+// its payments. A ticket exchanged for a dearer one is paid by its original
+// payment and the top-up of the exchange, refunded in that order. The
+// refunds are saved pending together with the change they are owed for and
+// paid afterwards.
+func (a *APIAdapter) planTicketRefunds(ctx context.Context, ticket *domain.Ticket, amount int64) ([]domain.Refund, error) {
+	if ticket.PaymentStatus != domain.PaymentPaid || amount == 0 {
+		return nil, nil
+	}
+
+	payments, err := a.ticketPayments(ctx, ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	refunds := []domain.Refund{}
+
+	for _, payment := range payments {
+		refundAmount := min(amount, payment.Refundable())
+		if refundAmount == 0 {
+			continue
+		}
+
+		amount -= refundAmount
+		refunds = append(refunds, *domain.NewRefund(payment.ID, ticket.ID, refundAmount))
+	}
+
+	return refunds, nil
+}
+
+// payRefunds pays saved refunds at the provider. A refund that fails stays
+// pending for RetryRefunds.
+func (a *APIAdapter) payRefunds(ctx context.Context, refunds []domain.Refund) {
+	for i := range refunds {
+		err := a.payRefund(ctx, &refunds[i])
+		if err != nil {
+			log.Printf("pay refund %d error:%v\n", refunds[i].ID, err)
+		}
+	}
+}
+
+// payRefund pays the refund at the provider under the key of the refund, so
+// paying it again after a lost reply does not pay twice.
+func (a *APIAdapter) payRefund(ctx context.Context, refund *domain.Refund) error {
+	payment, err := a.databasePort.GetPaymentByID(ctx, refund.PaymentID)
+	if err != nil {
+		return err
+	}
+
+	paymentCtx, cancel := context.WithTimeout(ctx, paymentTimeout)
+	defer cancel()
+
+	err = a.paymentPort.Refund(paymentCtx, payment.AuthorizationID, refund.Key(), refund.Amount)
+	if err != nil {
+		markErr := a.databasePort.MarkRefundFailed(ctx, refund.ID, err.Error())
+
+		return errors.Join(err, markErr)
+	}
+
+	err = a.databasePort.CompleteRefund(ctx, refund.ID)
+	if err != nil {
+		return err
+	}
+
+	refund.State = domain.RefundCompleted
+
+	if refund.TicketID == 0 {
+		return nil
+	}
+
+	return a.settleTicketRefund(ctx, refund.TicketID, time.Now())
+}
+
+// settleTicketRefund moves a canceled ticket to refunded once every refund
+// of the ticket is paid.
+func (a *APIAdapter) settleTicketRefund(ctx context.Context, ticketID uint, now time.Time) error {
+	unpaid, err := a.databasePort.CountUnpaidRefundsByTicketID(ctx, ticketID)
+	if err != nil || unpaid > 0 {
+		return err
+	}
+
+	ticket, err := a.databasePort.GetTicketByID(ctx, ticketID)
+	if err != nil {
+		return err
+	}
+
+	if ticket.Status != domain.TicketCanceled {
+		return nil
+	}
+
+	ticket.PaymentStatus = domain.PaymentRefunded
+
+	entry, err := ticket.Transition(domain.TicketRefunded, "payment refunded", now)
+	if err != nil {
+		return err
+	}
+
+	err = a.databasePort.TransitionTicket(ctx, ticket, []domain.TicketHistory{*entry}, nil, nil)
+	if errors.Is(err, domain.ErrInvalidTicketTransition) {
+		// Settled by a concurrent payment of another of its refunds
+		return nil
+	}
+
+	return err
+}
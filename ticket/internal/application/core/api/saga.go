@@ -0,0 +1,177 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log"
+	"ticket/internal/application/core/domain"
+	"time"
+)
+
+const sagaRecoveryInterval = time.Minute
+
+// RecoverBookingSagas periodically compensates the sagas whose lease
+// expired because the instance running them crashed, and settles the
+// carts they were checking out.
+func (a *APIAdapter) RecoverBookingSagas(ctx context.Context) {
+	ticker := time.NewTicker(sagaRecoveryInterval)
+	defer ticker.Stop()
+
+	for {
+		err := a.recoverBookingSagas(ctx, time.Now())
+		if err != nil {
+			log.Printf("recover booking sagas error:%v\n", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// recoverBookingSagas takes over and compensates every saga past its
+// lease. A saga that another instance takes over or finishes first is
+// skipped, and one that fails to compensate is logged so that it does not
+// hold back the others.
+func (a *APIAdapter) recoverBookingSagas(ctx context.Context, now time.Time) error {
+	staleBefore := now.Add(-domain.BookingSagaLease)
+
+	sagas, err := a.databasePort.ListUnfinishedBookingSagas(ctx, staleBefore)
+	if err != nil {
+		return err
+	}
+
+	for i := range sagas {
+		saga := &sagas[i]
+
+		err := a.databasePort.TakeOverBookingSaga(ctx, saga, now)
+		if errors.Is(err, domain.ErrSagaStatusChanged) {
+			continue
+		} else if err != nil {
+			log.Printf("take over booking saga %d error:%v\n", saga.ID, err)
+			continue
+		}
+
+		log.Printf("compensating unfinished booking saga %d\n", saga.ID)
+
+		err = a.compensateBookingSaga(ctx, saga)
+		if err != nil {
+			log.Printf("compensate booking saga %d error:%v\n", saga.ID, err)
+		}
+	}
+
+	return a.recoverCartCheckouts(ctx, staleBefore)
+}
+
+// reserveBookingSaga writes the booking and the tickets of every leg of
+// the saga together with the seat booked events and the promo code
+// redemption in a single transaction. The saga seats follow the legs in
+// order.
+func (a *APIAdapter) reserveBookingSaga(ctx context.Context, saga *domain.BookingSaga, legs []domain.BookingLeg, payment *domain.Payment, redemption *domain.PromoRedemption) ([]domain.Ticket, error) {
+	tickets := make([]domain.Ticket, 0, len(saga.Seats))
+	messages := make([]domain.OutboxMessage, 0, len(saga.Seats))
+
+	for _, leg := range legs {
+		train := leg.Train
+
+		for i := range leg.Seats {
+			sagaSeat := saga.Seats[len(tickets)]
+
+			seat := &domain.Seat{
+				ID:         sagaSeat.SeatID,
+				TrainID:    train.ID,
+				UserID:     saga.UserID,
+				SeatNumber: sagaSeat.SeatNumber,
+				Segment:    train.Segment,
+			}
+
+			ticket := domain.NewTicket(saga.UserID,
+				train.ID, seat.ID,
+				seat.SeatNumber,
+				train.DepartureTime,
+				train.TravelDetails,
+				train.Segment)
+
+			ticket.SagaID = saga.ID
+			ticket.PaymentID = payment.ID
+			ticket.Class = leg.Fares[i].Class
+			ticket.Price = leg.Fares[i].Price
+			ticket.Currency = payment.Currency
+			ticket.PaymentStatus = domain.PaymentPending
+
+			if i < len(leg.Passengers) {
+				ticket.Passenger = leg.Passengers[i]
+			}
+
+			message, err := newSeatEvent(domain.SeatBookedEvent, seat)
+			if err != nil {
+				return nil, err
+			}
+
+			tickets = append(tickets, *ticket)
+			messages = append(messages, *message)
+		}
+	}
+
+	booking := domain.NewBooking(saga.UserID, saga.TrainID, saga.ID)
+
+	err := a.databasePort.ReserveBookingSaga(ctx, saga, booking, tickets, redemption, messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return tickets, nil
+}
+
+// abortBookingSaga compensates a failed booking. A saga that completed or
+// was taken over by recovery is left alone. When compensation itself fails
+// the saga stays unfinished and is recovered once its lease expires.
+func (a *APIAdapter) abortBookingSaga(ctx context.Context, saga *domain.BookingSaga) {
+	err := a.databasePort.StartBookingSagaCompensation(ctx, saga)
+	if err != nil {
+		log.Printf("abort booking saga %d error:%v\n", saga.ID, err)
+		return
+	}
+
+	err = a.compensateBookingSaga(ctx, saga)
+	if err != nil {
+		log.Printf("compensate booking saga %d error:%v\n", saga.ID, err)
+	}
+}
+
+// compensateBookingSaga records the refund of a captured payment, then
+// deletes the tickets of the saga and releases every seat it booked on the
+// SEAT stream in one transaction. The saga must already be compensating.
+func (a *APIAdapter) compensateBookingSaga(ctx context.Context, saga *domain.BookingSaga) error {
+	err := a.refundBookingSaga(ctx, saga)
+	if err != nil {
+		return err
+	}
+
+	messages := []domain.OutboxMessage{}
+
+	for _, sagaSeat := range saga.Seats {
+		if sagaSeat.Status != domain.SagaSeatBooked {
+			continue
+		}
+
+		seat := &domain.Seat{
+			ID:         sagaSeat.SeatID,
+			TrainID:    saga.SeatTrainID(&sagaSeat),
+			UserID:     saga.UserID,
+			SeatNumber: sagaSeat.SeatNumber,
+			Segment:    sagaSeat.Segment,
+		}
+
+		message, err := newSeatEvent(domain.SeatBookCanceledEvent, seat)
+		if err != nil {
+			return err
+		}
+
+		messages = append(messages, *message)
+	}
+
+	return a.databasePort.CompensateBookingSaga(ctx, saga, messages)
+}
@@ -0,0 +1,175 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"ticket/internal/application/core/domain"
+	"ticket/internal/ports"
+	"time"
+)
+
+// sagaDatabase keeps booking sagas in memory. Only the methods used by the
+// saga code are implemented.
+type sagaDatabase struct {
+	ports.DatabasePort
+
+	sagas       map[uint]*domain.BookingSaga
+	listed      []domain.BookingSaga // Returned by ListUnfinishedBookingSagas instead of the stale sagas when set
+	staleBefore time.Time
+	compensated []uint
+}
+
+func newSagaDatabase(sagas ...domain.BookingSaga) *sagaDatabase {
+	db := &sagaDatabase{sagas: map[uint]*domain.BookingSaga{}}
+
+	for i := range sagas {
+		db.sagas[sagas[i].ID] = &sagas[i]
+	}
+
+	return db
+}
+
+func (d *sagaDatabase) ListUnfinishedBookingSagas(ctx context.Context, staleBefore time.Time) ([]domain.BookingSaga, error) {
+	d.staleBefore = staleBefore
+
+	if d.listed != nil {
+		return d.listed, nil
+	}
+
+	sagas := []domain.BookingSaga{}
+
+	for _, saga := range d.sagas {
+		unfinished := saga.Status == domain.SagaStarted || saga.Status == domain.SagaCompensating
+		if unfinished && saga.UpdatedAt.Before(staleBefore) {
+			sagas = append(sagas, *saga)
+		}
+	}
+
+	return sagas, nil
+}
+
+func (d *sagaDatabase) TakeOverBookingSaga(ctx context.Context, saga *domain.BookingSaga, now time.Time) error {
+	stored := d.sagas[saga.ID]
+	if !stored.UpdatedAt.Equal(saga.UpdatedAt) {
+		return fmt.Errorf("%w: saga ID:%d", domain.ErrSagaStatusChanged, saga.ID)
+	}
+
+	stored.Status, stored.UpdatedAt = domain.SagaCompensating, now
+	saga.Status, saga.UpdatedAt = domain.SagaCompensating, now
+
+	return nil
+}
+
+func (d *sagaDatabase) StartBookingSagaCompensation(ctx context.Context, saga *domain.BookingSaga) error {
+	stored := d.sagas[saga.ID]
+	if stored.Status != domain.SagaStarted {
+		return fmt.Errorf("%w: saga ID:%d", domain.ErrSagaStatusChanged, saga.ID)
+	}
+
+	stored.Status = domain.SagaCompensating
+	saga.Status = domain.SagaCompensating
+
+	return nil
+}
+
+func (d *sagaDatabase) GetPaymentBySagaID(ctx context.Context, sagaID uint) (*domain.Payment, error) {
+	return nil, domain.ErrPaymentNotFound
+}
+
+func (d *sagaDatabase) CompensateBookingSaga(ctx context.Context, saga *domain.BookingSaga, messages []domain.OutboxMessage) error {
+	stored := d.sagas[saga.ID]
+	if stored.Status != domain.SagaCompensating {
+		return fmt.Errorf("%w: saga ID:%d", domain.ErrSagaStatusChanged, saga.ID)
+	}
+
+	stored.Status = domain.SagaCompensated
+	d.compensated = append(d.compensated, saga.ID)
+
+	return nil
+}
+
+func (d *sagaDatabase) ListStaleCartCheckouts(ctx context.Context, staleBefore time.Time) ([]domain.Cart, error) {
+	return nil, nil
+}
+
+func TestRecoverBookingSagas(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	db := newSagaDatabase(
+		domain.BookingSaga{ID: 1, Status: domain.SagaStarted, UpdatedAt: now.Add(-time.Second)},
+		domain.BookingSaga{ID: 2, Status: domain.SagaStarted, UpdatedAt: now.Add(-time.Hour)},
+		domain.BookingSaga{ID: 3, Status: domain.SagaCompensating, UpdatedAt: now.Add(-time.Hour)},
+		domain.BookingSaga{ID: 4, Status: domain.SagaCompleted, UpdatedAt: now.Add(-time.Hour)},
+	)
+
+	a := &APIAdapter{databasePort: db}
+
+	err := a.recoverBookingSagas(context.Background(), now)
+	if err != nil {
+		t.Fatalf("recoverBookingSagas() error = %v", err)
+	}
+
+	if want := now.Add(-domain.BookingSagaLease); !db.staleBefore.Equal(want) {
+		t.Errorf("recoverBookingSagas() listed sagas stale before %v, want %v", db.staleBefore, want)
+	}
+
+	want := map[uint]domain.SagaStatus{
+		1: domain.SagaStarted,
+		2: domain.SagaCompensated,
+		3: domain.SagaCompensated,
+		4: domain.SagaCompleted,
+	}
+
+	for id, status := range want {
+		if db.sagas[id].Status != status {
+			t.Errorf("saga %d status = %s, want %s", id, db.sagas[id].Status, status)
+		}
+	}
+}
+
+func TestRecoverBookingSagasSkipsRenewedSaga(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	db := newSagaDatabase(domain.BookingSaga{ID: 1, Status: domain.SagaStarted, UpdatedAt: now.Add(-time.Hour)})
+
+	// Another instance renews the saga between listing and taking it over
+	db.listed = []domain.BookingSaga{*db.sagas[1]}
+	db.sagas[1].UpdatedAt = now.Add(-time.Second)
+
+	a := &APIAdapter{databasePort: db}
+
+	err := a.recoverBookingSagas(context.Background(), now)
+	if err != nil {
+		t.Fatalf("recoverBookingSagas() error = %v", err)
+	}
+
+	if len(db.compensated) != 0 || db.sagas[1].Status != domain.SagaStarted {
+		t.Errorf("recoverBookingSagas() compensated %v, saga status %s, want a started saga left alone", db.compensated, db.sagas[1].Status)
+	}
+}
+
+func TestAbortBookingSaga(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     domain.SagaStatus
+		wantStatus domain.SagaStatus
+	}{
+		{name: "started", status: domain.SagaStarted, wantStatus: domain.SagaCompensated},
+		{name: "taken over by recovery", status: domain.SagaCompensating, wantStatus: domain.SagaCompensating},
+		{name: "completed", status: domain.SagaCompleted, wantStatus: domain.SagaCompleted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newSagaDatabase(domain.BookingSaga{ID: 1, Status: tt.status})
+			a := &APIAdapter{databasePort: db}
+
+			a.abortBookingSaga(context.Background(), &domain.BookingSaga{ID: 1, Status: domain.SagaStarted})
+
+			if got := db.sagas[1].Status; got != tt.wantStatus {
+				t.Errorf("abortBookingSaga() saga status = %s, want %s", got, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"ticket/internal/application/core/domain"
+	"time"
+)
+
+// TransferTicket starts the transfer of a ticket to another user, found by
+// ID or by email. The ticket keeps its owner until the recipient accepts.
+func (a *APIAdapter) TransferTicket(ctx context.Context, request *domain.TransferRequest) (*domain.TicketTransfer, error) {
+	ticket, err := a.databasePort.GetTicketByID(ctx, request.TicketID)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := a.findTransferRecipient(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	transfer, err := domain.NewTicketTransfer(ticket, request.FromUserID, recipient.ID, now, a.options.TransferDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := a.requestPort.RequestGetUserByID(ctx, request.FromUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	newEvent := func(transfer *domain.TicketTransfer) (*domain.OutboxMessage, error) {
+		return newTicketTransferEvent(domain.TicketTransferRequestedEvent, &domain.TicketTransferNotice{
+			Transfer: transfer,
+			Ticket:   ticket,
+			From:     sender,
+			To:       recipient,
+		})
+	}
+
+	err = a.databasePort.CreateTicketTransfer(ctx, transfer, now, newEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+func (a *APIAdapter) GetTicketTransfer(ctx context.Context, transferID uint) (*domain.TicketTransfer, error) {
+	return a.databasePort.GetTicketTransferByID(ctx, transferID)
+}
+
+// AcceptTicketTransfer moves the ticket to the recipient, tells the train
+// service the seat changed hands and notifies both parties.
+func (a *APIAdapter) AcceptTicketTransfer(ctx context.Context, transferID, userID uint) (*domain.TicketTransfer, error) {
+	transfer, err := a.databasePort.GetTicketTransferByID(ctx, transferID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	err = transfer.Accept(userID, now)
+	if err != nil {
+		if transfer.Status == domain.TransferPending && !now.Before(transfer.ExpiresAt) {
+			expireErr := a.databasePort.ExpireTicketTransfer(ctx, transfer)
+			if expireErr != nil {
+				return nil, expireErr
+			}
+		}
+
+		return nil, err
+	}
+
+	ticket, err := a.databasePort.GetTicketByID(ctx, transfer.TicketID)
+	if err != nil {
+		return nil, err
+	}
+
+	if ticket.UserID != transfer.FromUserID {
+		return nil, fmt.Errorf("%w: ticket %d", domain.ErrTransferTicketReplaced, ticket.ID)
+	}
+
+	err = ticket.CanTransfer(now)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := a.requestPort.RequestGetUserByID(ctx, transfer.FromUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := a.requestPort.RequestGetUserByID(ctx, transfer.ToUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	ticket.UserID = transfer.ToUserID
+
+	seatMessage, err := newSeatEvent(domain.SeatTransferredEvent, &domain.Seat{
+		ID:         ticket.SeatID,
+		TrainID:    ticket.TrainID,
+		UserID:     ticket.UserID,
+		SeatNumber: ticket.SeatNumber,
+		Segment:    ticket.Segment,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	transferMessage, err := newTicketTransferEvent(domain.TicketTransferAcceptedEvent, &domain.TicketTransferNotice{
+		Transfer: transfer,
+		Ticket:   ticket,
+		From:     sender,
+		To:       recipient,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	history := &domain.TicketHistory{
+		TicketID:   ticket.ID,
+		FromStatus: ticket.Status,
+		ToStatus:   ticket.Status,
+		Reason:     fmt.Sprintf("transferred from user %d to user %d", transfer.FromUserID, transfer.ToUserID),
+		CreatedAt:  now,
+	}
+
+	err = a.databasePort.AcceptTicketTransfer(ctx, transfer, history, []domain.OutboxMessage{*seatMessage, *transferMessage})
+	if err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+func (a *APIAdapter) findTransferRecipient(ctx context.Context, request *domain.TransferRequest) (*domain.User, error) {
+	if request.ToEmail != "" {
+		return a.requestPort.RequestGetUserByEmail(ctx, request.ToEmail)
+	}
+
+	if request.ToUserID == 0 {
+		return nil, fmt.Errorf("%w: no recipient given", domain.ErrTransferNotAllowed)
+	}
+
+	return a.requestPort.RequestGetUserByID(ctx, request.ToUserID)
+}
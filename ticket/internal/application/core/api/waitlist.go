@@ -0,0 +1,144 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"ticket/internal/application/core/domain"
+)
+
+// JoinWaitlist puts the user in line for a train that does not have enough
+// free seats left.
+func (a *APIAdapter) JoinWaitlist(ctx context.Context, userID, trainID, ticketNumber uint) (*domain.WaitlistEntry, error) {
+	if ticketNumber == 0 {
+		return nil, fmt.Errorf("waitlist not allowed: ticket number must be positive")
+	}
+
+	availableTrain, err := a.requestPort.RequestGetTrainByID(ctx, trainID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(availableTrain.Seats) >= int(ticketNumber) {
+		return nil, domain.ErrSeatsAvailable
+	}
+
+	active, err := a.databasePort.CountActiveWaitlistEntries(ctx, userID, trainID)
+	if err != nil {
+		return nil, err
+	}
+
+	if active > 0 {
+		return nil, domain.ErrAlreadyWaitlisted
+	}
+
+	entry := domain.NewWaitlistEntry(userID, trainID, ticketNumber)
+
+	err = a.databasePort.CreateWaitlistEntry(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.databasePort.GetWaitlistEntryByID(ctx, entry.ID)
+}
+
+func (a *APIAdapter) GetWaitlistEntry(ctx context.Context, entryID uint) (*domain.WaitlistEntry, error) {
+	return a.databasePort.GetWaitlistEntryByID(ctx, entryID)
+}
+
+// LeaveWaitlist removes the entry from the waitlist and gives up a pending
+// offer, which hands the seats on to the next user in line.
+func (a *APIAdapter) LeaveWaitlist(ctx context.Context, entryID uint) error {
+	entry, err := a.databasePort.GetWaitlistEntryByID(ctx, entryID)
+	if err != nil {
+		return err
+	}
+
+	if !entry.IsActive() {
+		return domain.ErrWaitlistEntryNotActive
+	}
+
+	offered := entry.Status == domain.WaitlistOffered
+
+	err = a.databasePort.UpdateWaitlistEntryStatus(ctx, entry, domain.WaitlistLeft)
+	if err != nil {
+		return err
+	}
+
+	if !offered {
+		return nil
+	}
+
+	hold, err := a.databasePort.GetSeatHoldByID(ctx, entry.HoldID)
+	if err != nil {
+		return err
+	}
+
+	if hold.Status != domain.SeatHoldHeld {
+		return nil
+	}
+
+	return a.releaseSeatHold(ctx, hold, domain.SeatHoldReleased)
+}
+
+// PromoteWaitlist offers the freed seats of the train to the first waiting
+// user they are enough for by holding them on the user's behalf.
+func (a *APIAdapter) PromoteWaitlist(ctx context.Context, trainID uint) error {
+	availableTrain, err := a.requestPort.RequestGetTrainByID(ctx, trainID)
+	if err != nil {
+		return err
+	}
+
+	var freeSeats uint
+	for _, seat := range availableTrain.Seats {
+		if !seat.Held {
+			freeSeats++
+		}
+	}
+
+	if freeSeats == 0 {
+		return nil
+	}
+
+	entry, err := a.databasePort.GetNextWaitlistEntry(ctx, trainID, freeSeats)
+	if errors.Is(err, domain.ErrWaitlistEmpty) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	hold, err := a.HoldSeats(ctx, &domain.BookingRequest{
+		UserID:       entry.UserID,
+		TrainID:      entry.TrainID,
+		TicketNumber: entry.TicketNumber,
+	})
+	if err != nil {
+		return err
+	}
+
+	entry.Offer(hold)
+
+	message, err := newWaitlistOfferedEvent(entry)
+	if err != nil {
+		a.abortSeatHold(ctx, hold)
+
+		return err
+	}
+
+	err = a.databasePort.OfferWaitlistEntry(ctx, entry, []domain.OutboxMessage{*message})
+	if err != nil {
+		a.abortSeatHold(ctx, hold)
+
+		return err
+	}
+
+	return nil
+}
+
+func (a *APIAdapter) fulfillWaitlistOffer(ctx context.Context, hold *domain.SeatHold) {
+	err := a.databasePort.CloseWaitlistOffer(ctx, hold.ID, domain.WaitlistFulfilled)
+	if err != nil {
+		log.Printf("fulfill waitlist offer of hold %d error:%v\n", hold.ID, err)
+	}
+}
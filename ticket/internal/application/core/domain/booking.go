@@ -0,0 +1,239 @@
+package domain
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+var (
+	ErrSeatCountMismatch  = errors.New("ticket number does not match the chosen seats")
+	ErrDuplicateSeat      = errors.New("seat chosen more than once")
+	ErrTicketNotInBooking = errors.New("ticket is not part of the booking")
+	ErrNothingToCancel    = errors.New("booking has no ticket left to cancel")
+)
+
+// bookingReferenceAlphabet leaves out characters that are easily confused
+// when a reference is read out loud, like 0/O and 1/I.
+const (
+	bookingReferenceAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	bookingReferenceLength   = 6
+)
+
+// Booking groups the tickets bought together by a booker under a short
+// reference (PNR).
+type Booking struct {
+	ID        uint   `gorm:"primaryKey"`
+	Reference string `gorm:"uniqueIndex;size:6"`
+	UserID    uint   `gorm:"index"`
+	TrainID   uint
+	SagaID    uint     `gorm:"uniqueIndex"`
+	Tickets   []Ticket `gorm:"-"`
+	CreatedAt time.Time
+}
+
+func NewBooking(userID, trainID, sagaID uint) *Booking {
+	return &Booking{
+		Reference: NewBookingReference(),
+		UserID:    userID,
+		TrainID:   trainID,
+		SagaID:    sagaID,
+	}
+}
+
+func NewBookingReference() string {
+	reference := make([]byte, bookingReferenceLength)
+	max := big.NewInt(int64(len(bookingReferenceAlphabet)))
+
+	for i := range reference {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			panic(fmt.Sprintf("booking reference: %v", err))
+		}
+
+		reference[i] = bookingReferenceAlphabet[n.Int64()]
+	}
+
+	return string(reference)
+}
+
+// SelectTickets returns the tickets of the booking with the given IDs, or
+// every ticket that can still be canceled when no ID is given.
+func (b *Booking) SelectTickets(ticketIDs []uint) ([]Ticket, error) {
+	if len(ticketIDs) == 0 {
+		var tickets []Ticket
+
+		for _, ticket := range b.Tickets {
+			if ticket.CanTransition(TicketCanceled) {
+				tickets = append(tickets, ticket)
+			}
+		}
+
+		if len(tickets) == 0 {
+			return nil, ErrNothingToCancel
+		}
+
+		return tickets, nil
+	}
+
+	tickets := make([]Ticket, 0, len(ticketIDs))
+
+	for _, ticketID := range ticketIDs {
+		found := false
+
+		for _, ticket := range b.Tickets {
+			if ticket.ID == ticketID {
+				tickets = append(tickets, ticket)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("%w: ticket %d, booking %s", ErrTicketNotInBooking, ticketID, b.Reference)
+		}
+	}
+
+	return tickets, nil
+}
+
+// BookingRequest is made by the booker in UserID, who also owns the booked
+// tickets. Passengers, when given, are the people travelling on them in seat
+// order.
+type BookingRequest struct {
+	UserID              uint
+	TrainID             uint
+	TicketNumber        uint
+	SeatIDs             []uint
+	SeatNumbers         []uint
+	Passengers          []Passenger
+	PromoCode           string
+	Origin              string // Station to board at, the first stop when empty
+	Destination         string // Station to leave at, the last stop when empty
+	Class               string // Seat class to book in when no seat is chosen, any when empty
+	IdempotencyRecordID uint   // Idempotency record of the request, zero without an idempotency key
+}
+
+// SeatsOfClass keeps the seats of the requested class, all of them when no
+// class is requested.
+func (r *BookingRequest) SeatsOfClass(seats []Seat) []Seat {
+	class := NormalizeSeatClass(r.Class)
+	if class == "" {
+		return seats
+	}
+
+	classSeats := make([]Seat, 0, len(seats))
+	for _, seat := range seats {
+		seatClass := seat.Class
+		if seatClass == "" {
+			seatClass = SeatClassStandard
+		}
+
+		if seatClass == class {
+			classSeats = append(classSeats, seat)
+		}
+	}
+
+	return classSeats
+}
+
+// CheckPassengers validates the passengers against the number of booked
+// seats. A request without passengers books tickets without their details.
+func (r *BookingRequest) CheckPassengers(seatCount int, now time.Time) error {
+	if len(r.Passengers) == 0 {
+		return nil
+	}
+
+	if len(r.Passengers) != seatCount {
+		return fmt.Errorf("%w: %d passengers for %d seats", ErrPassengerCountMismatch, len(r.Passengers), seatCount)
+	}
+
+	for i := range r.Passengers {
+		err := r.Passengers[i].Validate(now)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// HasSeatChoice reports whether the passenger picked seats from the seat map
+// instead of letting the service assign the first free ones.
+func (r *BookingRequest) HasSeatChoice() bool {
+	return len(r.SeatIDs) > 0 || len(r.SeatNumbers) > 0
+}
+
+// ChooseSeats resolves the requested seat IDs and numbers against every seat
+// of the train and makes sure all of them are still free.
+func (r *BookingRequest) ChooseSeats(trainSeats []Seat) ([]Seat, error) {
+	chosenCount := len(r.SeatIDs) + len(r.SeatNumbers)
+	if r.TicketNumber != 0 && int(r.TicketNumber) != chosenCount {
+		return nil, fmt.Errorf("%w: %d tickets for %d seats", ErrSeatCountMismatch, r.TicketNumber, chosenCount)
+	}
+
+	seatsByID := make(map[uint]Seat, len(trainSeats))
+	seatsByNumber := make(map[uint]Seat, len(trainSeats))
+
+	for _, seat := range trainSeats {
+		seatsByID[seat.ID] = seat
+		seatsByNumber[seat.SeatNumber] = seat
+	}
+
+	chosen := make([]Seat, 0, chosenCount)
+	seen := make(map[uint]bool, chosenCount)
+
+	choose := func(seat Seat) error {
+		if seen[seat.ID] {
+			return fmt.Errorf("%w: seat number %d", ErrDuplicateSeat, seat.SeatNumber)
+		}
+
+		if seat.Booked {
+			return fmt.Errorf("%w: seat number %d", ErrSeatAlreadyBooked, seat.SeatNumber)
+		}
+
+		if seat.Held {
+			return fmt.Errorf("%w: seat number %d", ErrSeatHeld, seat.SeatNumber)
+		}
+
+		seen[seat.ID] = true
+		chosen = append(chosen, seat)
+
+		return nil
+	}
+
+	for _, seatID := range r.SeatIDs {
+		seat, ok := seatsByID[seatID]
+		if !ok {
+			return nil, fmt.Errorf("%w: seat ID %d", ErrSeatNotOnTrain, seatID)
+		}
+
+		if err := choose(seat); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, seatNumber := range r.SeatNumbers {
+		seat, ok := seatsByNumber[seatNumber]
+		if !ok {
+			return nil, fmt.Errorf("%w: seat number %d", ErrSeatNotOnTrain, seatNumber)
+		}
+
+		if err := choose(seat); err != nil {
+			return nil, err
+		}
+	}
+
+	return chosen, nil
+}
+
+// BookingLeg is the part of a booking travelling on one train. Seats,
+// fares and passengers belong together by position.
+type BookingLeg struct {
+	Train      *Train
+	Seats      []Seat
+	Fares      []Fare
+	Passengers []Passenger
+}
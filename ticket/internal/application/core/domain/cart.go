@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+type CartStatus string
+
+const (
+	CartOpen        CartStatus = "open"
+	CartCheckingOut CartStatus = "checking_out"
+	CartCheckedOut  CartStatus = "checked_out"
+)
+
+var (
+	ErrCartNotOpen     = errors.New("cart conflict: cart is no longer open")
+	ErrCartLegExists   = errors.New("cart conflict: train is already in the cart")
+	ErrCartLegNotFound = errors.New("cart not allowed: leg is not in the cart")
+	ErrCartEmpty       = errors.New("cart not allowed: cart has no leg")
+	ErrInvalidCartLeg  = errors.New("cart not allowed: a leg needs a train and at least one ticket")
+	ErrCartLegsOverlap = errors.New("cart not allowed: a leg departs before the previous one arrives")
+)
+
+// Cart collects the legs of a trip, like the outward and return train of a
+// round trip, so that they are checked out as a single booking. Either
+// every leg is booked or none is.
+type Cart struct {
+	ID               uint `gorm:"primaryKey"`
+	UserID           uint `gorm:"index"`
+	Status           CartStatus
+	BookingReference string    `gorm:"size:6"`
+	Legs             []CartLeg `gorm:"foreignKey:CartID;constraint:OnDelete:CASCADE"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// CartLeg books TicketNumber seats on the train, one for each passenger.
+type CartLeg struct {
+	ID           uint `gorm:"primaryKey"`
+	CartID       uint `gorm:"uniqueIndex:idx_cart_leg_train"` // Foreign key to Cart
+	TrainID      uint `gorm:"uniqueIndex:idx_cart_leg_train"`
+	TicketNumber uint
+}
+
+// CheckoutRequest books every leg of the cart. Passengers, when given,
+// travel on every leg, so each leg must have one ticket for each of them.
+type CheckoutRequest struct {
+	CartID              uint
+	Passengers          []Passenger
+	IdempotencyRecordID uint // Idempotency record of the request, zero without an idempotency key
+}
+
+func NewCart(userID uint) *Cart {
+	return &Cart{
+		UserID: userID,
+		Status: CartOpen,
+	}
+}
+
+// AddLeg checks that the leg can be added to the open cart.
+func (c *Cart) AddLeg(leg *CartLeg) error {
+	if c.Status != CartOpen {
+		return ErrCartNotOpen
+	}
+
+	if leg.TrainID == 0 || leg.TicketNumber == 0 {
+		return ErrInvalidCartLeg
+	}
+
+	for _, existing := range c.Legs {
+		if existing.TrainID == leg.TrainID {
+			return fmt.Errorf("%w: train %d", ErrCartLegExists, leg.TrainID)
+		}
+	}
+
+	leg.CartID = c.ID
+
+	return nil
+}
+
+// Leg returns the leg of the cart with the given ID.
+func (c *Cart) Leg(legID uint) (*CartLeg, error) {
+	for i := range c.Legs {
+		if c.Legs[i].ID == legID {
+			return &c.Legs[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: leg %d, cart %d", ErrCartLegNotFound, legID, c.ID)
+}
+
+// BookingRequest is the request booking the seats of the leg for the user.
+func (l *CartLeg) BookingRequest(userID uint, passengers []Passenger) *BookingRequest {
+	return &BookingRequest{
+		UserID:       userID,
+		TrainID:      l.TrainID,
+		TicketNumber: l.TicketNumber,
+		Passengers:   passengers,
+	}
+}
+
+// OrderLegs sorts the legs by departure and makes sure each one leaves
+// after the previous one arrived.
+func OrderLegs(legs []BookingLeg) error {
+	sort.SliceStable(legs, func(i, j int) bool {
+		return legs[i].Train.DepartureTime.Before(legs[j].Train.DepartureTime)
+	})
+
+	for i := 1; i < len(legs); i++ {
+		if legs[i].Train.DepartureTime.Before(legs[i-1].Train.ArrivalTime) {
+			return fmt.Errorf("%w: train %d and train %d", ErrCartLegsOverlap, legs[i-1].Train.ID, legs[i].Train.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package domain
+
+// TicketDocument is what a printed ticket shows: the ticket, the name of its
+// train and the e-ticket token rendered as a QR code.
+type TicketDocument struct {
+	Ticket    *Ticket
+	TrainName string
+	ETicket   string
+}
+
+// BookingConfirmation is sent to the booker once every ticket of a booking
+// is paid, with the printable tickets attached as a PDF.
+type BookingConfirmation struct {
+	Reference string
+	User      *User
+	Train     *Train
+	Tickets   []Ticket
+	Document  []byte
+}
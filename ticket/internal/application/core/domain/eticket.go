@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrInvalidETicket    = errors.New("e-ticket not allowed: token is malformed or its signature is invalid")
+	ErrETicketNotIssued  = errors.New("e-ticket not allowed: ticket is not confirmed")
+	ErrETicketMismatch   = errors.New("e-ticket not allowed: token does not match the ticket")
+	ErrTicketAlreadyUsed = errors.New("ticket conflict: ticket was already checked in")
+	ErrTicketNotValid    = errors.New("ticket not allowed: ticket is no longer valid for travel")
+)
+
+// ETicketClaims is the payload signed into an e-ticket token. It holds
+// everything a conductor needs to check a ticket without going online. The
+// owner is part of it so that a transferred ticket gets a new token.
+type ETicketClaims struct {
+	TicketID      uint  `json:"tid"`
+	UserID        uint  `json:"uid"`
+	TrainID       uint  `json:"trn"`
+	SeatNumber    uint  `json:"seat"`
+	DepartureTime int64 `json:"dep"`
+}
+
+func NewETicketClaims(ticket *Ticket) *ETicketClaims {
+	return &ETicketClaims{
+		TicketID:      ticket.ID,
+		UserID:        ticket.UserID,
+		TrainID:       ticket.TrainID,
+		SeatNumber:    ticket.SeatNumber,
+		DepartureTime: ticket.DepartureTime.Unix(),
+	}
+}
+
+// Payload returns the encoded claims, which is the part of the token that
+// gets signed.
+func (c *ETicketClaims) Payload() ([]byte, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(base64.RawURLEncoding.EncodeToString(data)), nil
+}
+
+// Matches reports whether the claims were issued for the ticket as it is now.
+func (c *ETicketClaims) Matches(ticket *Ticket) bool {
+	return c.TicketID == ticket.ID &&
+		c.UserID == ticket.UserID &&
+		c.TrainID == ticket.TrainID &&
+		c.SeatNumber == ticket.SeatNumber &&
+		c.DepartureTime == ticket.DepartureTime.Unix()
+}
+
+// ETicketToken joins the payload and its signature as "payload.signature",
+// both base64url encoded.
+func ETicketToken(payload, signature []byte) string {
+	return string(payload) + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// ParseETicketToken splits a token into its claims, the signed payload and
+// the signature. The signature still has to be verified by the caller.
+func ParseETicketToken(token string) (*ETicketClaims, []byte, []byte, error) {
+	encodedPayload, encodedSignature, ok := strings.Cut(strings.TrimSpace(token), ".")
+	if !ok {
+		return nil, nil, nil, ErrInvalidETicket
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: %v", ErrInvalidETicket, err)
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: %v", ErrInvalidETicket, err)
+	}
+
+	claims := &ETicketClaims{}
+
+	err = json.Unmarshal(data, claims)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: %v", ErrInvalidETicket, err)
+	}
+
+	return claims, []byte(encodedPayload), signature, nil
+}
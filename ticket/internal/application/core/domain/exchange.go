@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrExchangeNotAllowed = errors.New("exchange not allowed")
+	ErrExchangeSameSeat   = errors.New("exchange not allowed: the ticket already has this seat")
+)
+
+// ExchangeRequest moves a ticket to another train, optionally to a chosen
+// seat of it.
+type ExchangeRequest struct {
+	TicketID   uint
+	TrainID    uint
+	SeatID     uint
+	SeatNumber uint
+}
+
+func (r *ExchangeRequest) BookingRequest(userID uint) *BookingRequest {
+	request := &BookingRequest{
+		UserID:       userID,
+		TrainID:      r.TrainID,
+		TicketNumber: 1,
+	}
+
+	if r.SeatID != 0 {
+		request.SeatIDs = []uint{r.SeatID}
+	} else if r.SeatNumber != 0 {
+		request.SeatNumbers = []uint{r.SeatNumber}
+	}
+
+	return request
+}
+
+// Exchange is the outcome of an exchange. A positive fare difference was
+// charged, a negative one refunded.
+type Exchange struct {
+	OldTicket      *Ticket
+	NewTicket      *Ticket
+	FareDifference int64
+	Currency       string
+}
+
+// CanExchange reports why the ticket cannot be exchanged, if it cannot.
+func (t *Ticket) CanExchange(now time.Time) error {
+	if !t.CanTransition(TicketExchanged) {
+		return fmt.Errorf("%w: ticket %d is %s", ErrExchangeNotAllowed, t.ID, t.Status)
+	}
+
+	if !now.Before(t.DepartureTime) {
+		return fmt.Errorf("%w: train of ticket %d has already departed", ErrExchangeNotAllowed, t.ID)
+	}
+
+	return nil
+}
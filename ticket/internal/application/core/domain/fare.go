@@ -0,0 +1,226 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Seat classes as set on the seats by the train service.
+const (
+	SeatClassStandard = "standard"
+	SeatClassFirst    = "first"
+	SeatClassSleeper  = "sleeper"
+)
+
+// NormalizeSeatClass lowercases the class, second class being the standard
+// one.
+func NormalizeSeatClass(class string) string {
+	class = strings.ToLower(strings.TrimSpace(class))
+	if class == "second" {
+		return SeatClassStandard
+	}
+
+	return class
+}
+
+// RouteFare sets the base fare of each seat class between two stations.
+type RouteFare struct {
+	Origin      string           `json:"origin"`
+	Destination string           `json:"destination"`
+	Fares       map[string]int64 `json:"fares"`
+}
+
+// OccupancyRule adds SurchargePercent to the fare once at least
+// MinOccupancyPercent of the train is booked.
+type OccupancyRule struct {
+	MinOccupancyPercent int64 `json:"min_occupancy_percent"`
+	SurchargePercent    int64 `json:"surcharge_percent"`
+}
+
+// FareTable prices a seat in three steps. The base fare is the route fare
+// of the seat class, or the standard fare of the route scaled by the class
+// multiplier when the route has no fare for the class. Routes without a
+// fare start from DefaultFare. The occupancy surcharge is added next and
+// the passenger category discount taken off last. Multipliers, surcharges
+// and discounts are percentages.
+type FareTable struct {
+	DefaultFare       int64                       `json:"default_fare"`
+	Routes            []RouteFare                 `json:"routes"`
+	ClassMultipliers  map[string]int64            `json:"class_multipliers"`
+	CategoryDiscounts map[PassengerCategory]int64 `json:"category_discounts"`
+	Occupancy         []OccupancyRule             `json:"occupancy"`
+}
+
+// Fare is the price of one seat for one passenger and how it was reached.
+// A promo code discount is taken off last.
+type Fare struct {
+	SeatID        uint
+	SeatNumber    uint
+	Class         string
+	Category      PassengerCategory
+	BaseFare      int64
+	Surcharge     int64
+	Discount      int64
+	PromoDiscount int64
+	Price         int64
+}
+
+// Quote prices a prospective booking without making it.
+type Quote struct {
+	TrainID       uint
+	Fares         []Fare
+	PromoCode     string
+	PromoDiscount int64
+	Total         int64
+	Currency      string
+}
+
+func DefaultFareTable(defaultFare int64) *FareTable {
+	return &FareTable{
+		DefaultFare: defaultFare,
+		ClassMultipliers: map[string]int64{
+			SeatClassStandard: 100,
+			SeatClassFirst:    150,
+			SeatClassSleeper:  200,
+		},
+		CategoryDiscounts: map[PassengerCategory]int64{
+			PassengerChild:   50,
+			PassengerInfant:  100,
+			PassengerSenior:  30,
+			PassengerStudent: 20,
+		},
+	}
+}
+
+func (t *FareTable) Validate() error {
+	if t.DefaultFare < 0 {
+		return fmt.Errorf("invalid default fare %d: must not be negative", t.DefaultFare)
+	}
+
+	for _, route := range t.Routes {
+		for class, fare := range route.Fares {
+			if fare < 0 {
+				return fmt.Errorf("invalid %s fare %d from %s to %s: must not be negative", class, fare, route.Origin, route.Destination)
+			}
+		}
+	}
+
+	for class, multiplier := range t.ClassMultipliers {
+		if multiplier <= 0 {
+			return fmt.Errorf("invalid %s class multiplier %d: must be positive", class, multiplier)
+		}
+	}
+
+	for category, discount := range t.CategoryDiscounts {
+		if !passengerCategories[category] {
+			return fmt.Errorf("invalid discount for unknown passenger category %q", category)
+		}
+
+		if discount < 0 || discount > 100 {
+			return fmt.Errorf("invalid %s discount %d: must be between 0 and 100", category, discount)
+		}
+	}
+
+	for _, rule := range t.Occupancy {
+		if rule.MinOccupancyPercent < 0 || rule.MinOccupancyPercent > 100 {
+			return fmt.Errorf("invalid occupancy threshold %d: must be between 0 and 100", rule.MinOccupancyPercent)
+		}
+
+		if rule.SurchargePercent < 0 {
+			return fmt.Errorf("invalid occupancy surcharge %d: must not be negative", rule.SurchargePercent)
+		}
+	}
+
+	return nil
+}
+
+// Price prices the seat of the train for the passenger, or for an adult
+// when passenger is nil. The passenger category must match their age on
+// the day of departure.
+func (t *FareTable) Price(train *Train, seat *Seat, passenger *Passenger) (Fare, error) {
+	class := seat.Class
+	if class == "" {
+		class = SeatClassStandard
+	}
+
+	category := PassengerAdult
+	if passenger != nil && passenger.Category != "" {
+		err := passenger.CheckAge(train.DepartureTime)
+		if err != nil {
+			return Fare{}, err
+		}
+
+		category = passenger.Category
+	}
+
+	fare := Fare{
+		SeatID:     seat.ID,
+		SeatNumber: seat.SeatNumber,
+		Class:      class,
+		Category:   category,
+		BaseFare:   t.baseFare(train.Origin, train.Destination, class),
+	}
+
+	fare.Surcharge = fare.BaseFare * t.surchargePercent(train.Occupancy()) / 100
+	fare.Discount = (fare.BaseFare + fare.Surcharge) * t.CategoryDiscounts[category] / 100
+	fare.Price = fare.BaseFare + fare.Surcharge - fare.Discount
+
+	return fare, nil
+}
+
+func (t *FareTable) baseFare(origin, destination, class string) int64 {
+	standardFare := t.DefaultFare
+
+	for _, route := range t.Routes {
+		if route.Origin != origin || route.Destination != destination {
+			continue
+		}
+
+		if fare, ok := route.Fares[class]; ok {
+			return fare
+		}
+
+		if fare, ok := route.Fares[SeatClassStandard]; ok {
+			standardFare = fare
+		}
+	}
+
+	multiplier, ok := t.ClassMultipliers[class]
+	if !ok {
+		multiplier = 100
+	}
+
+	return standardFare * multiplier / 100
+}
+
+// surchargePercent applies the rule with the largest threshold the
+// occupancy reaches.
+func (t *FareTable) surchargePercent(occupancy int64) int64 {
+	var matched *OccupancyRule
+
+	for i, rule := range t.Occupancy {
+		if occupancy < rule.MinOccupancyPercent {
+			continue
+		}
+
+		if matched == nil || rule.MinOccupancyPercent > matched.MinOccupancyPercent {
+			matched = &t.Occupancy[i]
+		}
+	}
+
+	if matched == nil {
+		return 0
+	}
+
+	return matched.SurchargePercent
+}
+
+func FareTotal(fares []Fare) int64 {
+	var total int64
+
+	for _, fare := range fares {
+		total += fare.Price
+	}
+
+	return total
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// trainWithOccupancy returns a train of 100 seats with occupancy percent of
+// them booked. The train only carries its free seats.
+func trainWithOccupancy(occupancy int) *Train {
+	return &Train{
+		ID:       1,
+		Capacity: 100,
+		Seats:    make([]Seat, 100-occupancy),
+		TravelDetails: TravelDetails{
+			Origin:        "A",
+			Destination:   "B",
+			DepartureTime: time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC),
+		},
+	}
+}
+
+func TestFareTablePriceSurcharge(t *testing.T) {
+	table := DefaultFareTable(1000)
+	table.Occupancy = []OccupancyRule{
+		{MinOccupancyPercent: 90, SurchargePercent: 50},
+		{MinOccupancyPercent: 70, SurchargePercent: 20},
+	}
+
+	tests := []struct {
+		name          string
+		occupancy     int
+		wantSurcharge int64
+	}{
+		{name: "empty train", occupancy: 0, wantSurcharge: 0},
+		{name: "just under the first threshold", occupancy: 69, wantSurcharge: 0},
+		{name: "at the first threshold", occupancy: 70, wantSurcharge: 200},
+		{name: "between thresholds", occupancy: 89, wantSurcharge: 200},
+		{name: "at the second threshold", occupancy: 90, wantSurcharge: 500},
+		{name: "nearly full", occupancy: 99, wantSurcharge: 500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fare, err := table.Price(trainWithOccupancy(tt.occupancy), &Seat{}, nil)
+			if err != nil {
+				t.Fatalf("Price() error = %v", err)
+			}
+
+			if fare.Surcharge != tt.wantSurcharge {
+				t.Errorf("Price() surcharge = %d, want %d", fare.Surcharge, tt.wantSurcharge)
+			}
+
+			if fare.Price != 1000+tt.wantSurcharge {
+				t.Errorf("Price() price = %d, want %d", fare.Price, 1000+tt.wantSurcharge)
+			}
+		})
+	}
+}
+
+func TestFareTablePriceCategory(t *testing.T) {
+	table := DefaultFareTable(1000)
+	train := trainWithOccupancy(0)
+
+	birth := func(years int) *time.Time {
+		date := train.DepartureTime.AddDate(-years, 0, 0)
+		return &date
+	}
+
+	tests := []struct {
+		name      string
+		passenger *Passenger
+		want      int64
+		err       error
+	}{
+		{name: "no passenger", passenger: nil, want: 1000},
+		{name: "child", passenger: &Passenger{Category: PassengerChild, DateOfBirth: birth(8)}, want: 500},
+		{name: "child turned 16", passenger: &Passenger{Category: PassengerChild, DateOfBirth: birth(16)}, err: ErrPassengerAgeMismatch},
+		{name: "senior", passenger: &Passenger{Category: PassengerSenior, DateOfBirth: birth(60)}, want: 700},
+		{name: "senior without date of birth", passenger: &Passenger{Category: PassengerSenior}, err: ErrPassengerAgeMismatch},
+		{name: "adult without date of birth", passenger: &Passenger{Category: PassengerAdult}, want: 1000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fare, err := table.Price(train, &Seat{}, tt.passenger)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Price() error = %v, want %v", err, tt.err)
+			}
+
+			if fare.Price != tt.want {
+				t.Errorf("Price() price = %d, want %d", fare.Price, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+type SeatHoldStatus string
+
+const (
+	SeatHoldHeld      SeatHoldStatus = "held"
+	SeatHoldConfirmed SeatHoldStatus = "confirmed"
+	SeatHoldReleased  SeatHoldStatus = "released"
+	SeatHoldExpired   SeatHoldStatus = "expired"
+)
+
+var ErrSeatHoldNotActive = errors.New("hold conflict: seat hold is no longer active")
+
+// SeatHold reserves seats for a user for a limited time. Tickets are only
+// created once the hold is confirmed, for the segment between the stations
+// the seats were held for and the passengers given with the hold.
+type SeatHold struct {
+	ID          uint `gorm:"primaryKey"`
+	UserID      uint
+	TrainID     uint
+	Origin      string         // Station to board at, the first stop when empty
+	Destination string         // Station to leave at, the last stop when empty
+	Segment                    // Stops of the route the seats are held between
+	Status      SeatHoldStatus `gorm:"index"`
+	ExpiresAt   time.Time      `gorm:"index"`
+	Seats       []SeatHoldSeat `gorm:"foreignKey:HoldID;constraint:OnDelete:CASCADE"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type SeatHoldSeat struct {
+	ID         uint `gorm:"primaryKey"`
+	HoldID     uint // Foreign key to SeatHold
+	SeatID     uint
+	SeatNumber uint
+	Class      string
+	Passenger  Passenger `gorm:"embedded;embeddedPrefix:passenger_"` // Zero when the hold came without passengers
+}
+
+func NewSeatHold(request *BookingRequest, segment Segment, seats []Seat, expiresAt time.Time) *SeatHold {
+	hold := &SeatHold{
+		UserID:      request.UserID,
+		TrainID:     request.TrainID,
+		Origin:      request.Origin,
+		Destination: request.Destination,
+		Segment:     segment,
+		Status:      SeatHoldHeld,
+		ExpiresAt:   expiresAt,
+	}
+
+	for i, seat := range seats {
+		holdSeat := SeatHoldSeat{
+			SeatID:     seat.ID,
+			SeatNumber: seat.SeatNumber,
+			Class:      seat.Class,
+		}
+
+		if i < len(request.Passengers) {
+			holdSeat.Passenger = request.Passengers[i]
+		}
+
+		hold.Seats = append(hold.Seats, holdSeat)
+	}
+
+	return hold
+}
+
+// BookingRequest is the request confirming the hold books the held seats
+// with, for the holding user and the passengers given with the hold.
+func (h *SeatHold) BookingRequest() *BookingRequest {
+	request := &BookingRequest{UserID: h.UserID, TrainID: h.TrainID, Origin: h.Origin, Destination: h.Destination}
+
+	for _, holdSeat := range h.Seats {
+		if holdSeat.Passenger == (Passenger{}) {
+			continue
+		}
+
+		request.Passengers = append(request.Passengers, holdSeat.Passenger)
+	}
+
+	return request
+}
+
+// TrainSeats returns the held seats as seats of the train owned by the
+// holding user on the held segment.
+func (h *SeatHold) TrainSeats() []Seat {
+	seats := make([]Seat, 0, len(h.Seats))
+
+	for _, holdSeat := range h.Seats {
+		seats = append(seats, Seat{
+			ID:         holdSeat.SeatID,
+			TrainID:    h.TrainID,
+			UserID:     h.UserID,
+			SeatNumber: holdSeat.SeatNumber,
+			Class:      holdSeat.Class,
+			Segment:    h.Segment,
+		})
+	}
+
+	return seats
+}
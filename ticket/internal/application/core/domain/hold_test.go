@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSeatHoldTrainSeatsKeepSegment(t *testing.T) {
+	segment := Segment{FromStop: 1, ToStop: 3}
+	request := &BookingRequest{UserID: 4, TrainID: 2, Origin: "Dijon", Destination: "Lille"}
+	seats := []Seat{{ID: 7, TrainID: 2, SeatNumber: 12, Class: "standard"}}
+
+	hold := NewSeatHold(request, segment, seats, time.Now().Add(10*time.Minute))
+
+	for _, seat := range hold.TrainSeats() {
+		if seat.Segment != segment {
+			t.Errorf("TrainSeats() seat %d segment = %v, want the held %v", seat.ID, seat.Segment, segment)
+		}
+
+		if seat.UserID != request.UserID {
+			t.Errorf("TrainSeats() seat %d user = %d, want %d", seat.ID, seat.UserID, request.UserID)
+		}
+	}
+}
+
+func TestSeatHoldBookingRequest(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+	birth := time.Date(1990, time.May, 4, 0, 0, 0, 0, time.UTC)
+	passenger := Passenger{FirstName: "Sara", LastName: "Karimi", DateOfBirth: &birth, DocumentType: DocumentPassport, DocumentNumber: "P123"}
+	seats := []Seat{{ID: 7, SeatNumber: 12}, {ID: 8, SeatNumber: 13}}
+
+	tests := []struct {
+		name           string
+		passengers     []Passenger
+		wantPassengers int
+	}{
+		{name: "held for passengers", passengers: []Passenger{passenger, passenger}, wantPassengers: 2},
+		{name: "held without passengers", wantPassengers: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := &BookingRequest{UserID: 4, TrainID: 2, Passengers: tt.passengers}
+			hold := NewSeatHold(request, Segment{}, seats, now.Add(10*time.Minute))
+
+			confirm := hold.BookingRequest()
+			if len(confirm.Passengers) != tt.wantPassengers {
+				t.Fatalf("BookingRequest() passengers = %v, want %d", confirm.Passengers, tt.wantPassengers)
+			}
+
+			err := confirm.CheckPassengers(len(hold.Seats), now)
+			if err != nil {
+				t.Errorf("CheckPassengers() of the confirmed hold error = %v", err)
+			}
+		})
+	}
+}
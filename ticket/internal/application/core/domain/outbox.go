@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	SeatBookedEvent              = "seat.book.booked"
+	SeatBookCanceledEvent        = "seat.book.canceled"
+	SeatTransferredEvent         = "seat.book.transferred"
+	SeatHoldReleasedEvent        = "seat.hold.released"
+	TicketCanceledEvent          = "ticket.canceled"
+	TicketExpiredEvent           = "ticket.expired"
+	BookingConfirmedEvent        = "ticket.booking.confirmed"
+	WaitlistOfferedEvent         = "ticket.waitlist.offered"
+	TicketTransferRequestedEvent = "ticket.transfer.requested"
+	TicketTransferAcceptedEvent  = "ticket.transfer.accepted"
+)
+
+// MaxOutboxAttempts is how often publishing a message is tried before it
+// is dead-lettered.
+const MaxOutboxAttempts = 10
+
+// A claimed message is leased to its relay for OutboxClaimLease, other
+// relays skip it until then. A failed message is retried after a backoff
+// that doubles with every attempt, from outboxBaseBackoff up to
+// outboxMaxBackoff.
+const (
+	OutboxClaimLease  = time.Minute
+	outboxBaseBackoff = time.Second
+	outboxMaxBackoff  = 10 * time.Minute
+)
+
+// OutboxMessage is a domain event stored in the same transaction as the
+// change that produced it, and relayed to the event bus afterwards.
+// Messages of the same aggregate are published in the order they were
+// stored.
+type OutboxMessage struct {
+	ID            uint   `gorm:"primaryKey"`
+	AggregateKey  string `gorm:"index"`
+	Subject       string
+	Payload       []byte
+	Attempts      uint
+	LastError     string
+	NextAttemptAt *time.Time `gorm:"index"` // Claimed or backing off until then, due when nil
+	SentAt        *time.Time `gorm:"index"`
+	DeadAt        *time.Time `gorm:"index"` // Given up after MaxOutboxAttempts
+	CreatedAt     time.Time
+}
+
+func NewOutboxMessage(aggregateKey, subject string, payload []byte) *OutboxMessage {
+	return &OutboxMessage{
+		AggregateKey: aggregateKey,
+		Subject:      subject,
+		Payload:      payload,
+	}
+}
+
+// AggregateKey names the aggregate an event is about, like "ticket:12".
+func AggregateKey(kind string, ID uint) string {
+	return fmt.Sprintf("%s:%d", kind, ID)
+}
+
+// Fail records a failed publish and backs the message off until its next
+// attempt, or dead-letters it once it has used up its attempts. A dead
+// message no longer holds back the later messages of its aggregate.
+func (m *OutboxMessage) Fail(reason string, now time.Time) {
+	m.Attempts++
+	m.LastError = reason
+
+	if m.Attempts >= MaxOutboxAttempts {
+		m.DeadAt = &now
+		return
+	}
+
+	nextAttemptAt := now.Add(OutboxBackoff(m.Attempts))
+	m.NextAttemptAt = &nextAttemptAt
+}
+
+// Claim leases the message to the relay about to publish it.
+func (m *OutboxMessage) Claim(now time.Time) {
+	nextAttemptAt := now.Add(OutboxClaimLease)
+	m.NextAttemptAt = &nextAttemptAt
+}
+
+// Sent records that the message was published.
+func (m *OutboxMessage) Sent(now time.Time) {
+	m.SentAt = &now
+	m.NextAttemptAt = nil
+}
+
+// OutboxBackoff is how long a message waits for its next attempt after
+// failing attempts times.
+func OutboxBackoff(attempts uint) time.Duration {
+	backoff := outboxBaseBackoff
+
+	for i := uint(1); i < attempts && backoff < outboxMaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, outboxMaxBackoff)
+}
+
+// TicketTransferMessageFactory builds the event of a ticket transfer once
+// the row is stored and its ID is known.
+type TicketTransferMessageFactory func(transfer *TicketTransfer) (*OutboxMessage, error)
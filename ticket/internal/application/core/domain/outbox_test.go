@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOutboxBackoff(t *testing.T) {
+	tests := []struct {
+		attempts uint
+		want     time.Duration
+	}{
+		{attempts: 1, want: time.Second},
+		{attempts: 2, want: 2 * time.Second},
+		{attempts: 4, want: 8 * time.Second},
+		{attempts: 10, want: 512 * time.Second},
+		{attempts: 20, want: 10 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := OutboxBackoff(tt.attempts); got != tt.want {
+			t.Errorf("OutboxBackoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestOutboxMessageFail(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	message := NewOutboxMessage("ticket:1", TicketCanceledEvent, nil)
+	message.Claim(now)
+
+	message.Fail("nats: timeout", now)
+
+	if message.NextAttemptAt == nil || !message.NextAttemptAt.Equal(now.Add(time.Second)) {
+		t.Errorf("Fail() next attempt at = %v, want %v", message.NextAttemptAt, now.Add(time.Second))
+	}
+
+	if message.DeadAt != nil {
+		t.Errorf("Fail() dead at = %v after the first attempt, want nil", message.DeadAt)
+	}
+
+	message.Attempts = MaxOutboxAttempts - 1
+	message.Fail("nats: timeout", now)
+
+	if message.DeadAt == nil || !message.DeadAt.Equal(now) {
+		t.Errorf("Fail() dead at = %v after the last attempt, want %v", message.DeadAt, now)
+	}
+}
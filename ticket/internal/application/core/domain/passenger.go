@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type PassengerCategory string
+
+const (
+	PassengerAdult   PassengerCategory = "adult"
+	PassengerChild   PassengerCategory = "child"
+	PassengerInfant  PassengerCategory = "infant"
+	PassengerSenior  PassengerCategory = "senior"
+	PassengerStudent PassengerCategory = "student"
+)
+
+type DocumentType string
+
+const (
+	DocumentPassport       DocumentType = "passport"
+	DocumentNationalID     DocumentType = "national_id"
+	DocumentDrivingLicense DocumentType = "driving_license"
+)
+
+var (
+	ErrInvalidPassenger       = errors.New("passenger not allowed: details are missing or invalid")
+	ErrPassengerCountMismatch = errors.New("passenger not allowed: passenger count does not match the tickets")
+	ErrPassengerAgeMismatch   = errors.New("passenger not allowed: category does not match the age")
+)
+
+var passengerCategories = map[PassengerCategory]bool{
+	PassengerAdult:   true,
+	PassengerChild:   true,
+	PassengerInfant:  true,
+	PassengerSenior:  true,
+	PassengerStudent: true,
+}
+
+// ageRange bounds the age in whole years a passenger category is sold to.
+// Max is exclusive and zero when there is no upper bound.
+type ageRange struct {
+	Min int
+	Max int
+}
+
+// categoryAges are the ages of each category. Every category but adult is
+// a discount by age and needs a date of birth.
+var categoryAges = map[PassengerCategory]ageRange{
+	PassengerAdult:   {Min: 16},
+	PassengerChild:   {Min: 2, Max: 16},
+	PassengerInfant:  {Min: 0, Max: 2},
+	PassengerSenior:  {Min: 60},
+	PassengerStudent: {Min: 16, Max: 26},
+}
+
+var documentTypes = map[DocumentType]bool{
+	DocumentPassport:       true,
+	DocumentNationalID:     true,
+	DocumentDrivingLicense: true,
+}
+
+// Passenger is the person travelling on a ticket. It is kept apart from the
+// booker, who made the booking, and from the account that owns the ticket.
+type Passenger struct {
+	FirstName      string
+	LastName       string
+	DateOfBirth    *time.Time `gorm:"type:date"`
+	DocumentType   DocumentType
+	DocumentNumber string
+	Category       PassengerCategory
+}
+
+// Validate checks the passenger details and defaults the category to adult.
+func (p *Passenger) Validate(now time.Time) error {
+	p.FirstName = strings.TrimSpace(p.FirstName)
+	p.LastName = strings.TrimSpace(p.LastName)
+	p.DocumentNumber = strings.TrimSpace(p.DocumentNumber)
+
+	if p.FirstName == "" || p.LastName == "" {
+		return fmt.Errorf("%w: first and last name are required", ErrInvalidPassenger)
+	}
+
+	if p.DateOfBirth == nil || p.DateOfBirth.After(now) {
+		return fmt.Errorf("%w: date of birth of %s %s", ErrInvalidPassenger, p.FirstName, p.LastName)
+	}
+
+	if p.Category == "" {
+		p.Category = PassengerAdult
+	}
+
+	if !passengerCategories[p.Category] {
+		return fmt.Errorf("%w: unknown category %q", ErrInvalidPassenger, p.Category)
+	}
+
+	err := p.CheckAge(now)
+	if err != nil {
+		return err
+	}
+
+	if p.DocumentType == "" && p.DocumentNumber == "" {
+		return nil
+	}
+
+	if !documentTypes[p.DocumentType] {
+		return fmt.Errorf("%w: unknown document type %q", ErrInvalidPassenger, p.DocumentType)
+	}
+
+	if p.DocumentNumber == "" {
+		return fmt.Errorf("%w: document number of %s %s", ErrInvalidPassenger, p.FirstName, p.LastName)
+	}
+
+	return nil
+}
+
+// Age is the age of the passenger in whole years at the given time.
+func (p *Passenger) Age(at time.Time) int {
+	birth := *p.DateOfBirth
+	age := at.Year() - birth.Year()
+
+	if at.Month() < birth.Month() || (at.Month() == birth.Month() && at.Day() < birth.Day()) {
+		age--
+	}
+
+	return age
+}
+
+// CheckAge checks the category of the passenger against their age at the
+// given time. A passenger without a category is an adult, and only an
+// adult may leave out the date of birth.
+func (p *Passenger) CheckAge(at time.Time) error {
+	category := p.Category
+	if category == "" {
+		category = PassengerAdult
+	}
+
+	ages, ok := categoryAges[category]
+	if !ok {
+		return nil
+	}
+
+	if p.DateOfBirth == nil {
+		if category == PassengerAdult {
+			return nil
+		}
+
+		return fmt.Errorf("%w: %s fare needs a date of birth", ErrPassengerAgeMismatch, category)
+	}
+
+	age := p.Age(at)
+	if age < ages.Min || (ages.Max > 0 && age >= ages.Max) {
+		return fmt.Errorf("%w: %s %s is %d, too young or old for a %s fare", ErrPassengerAgeMismatch, p.FirstName, p.LastName, age, category)
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// PaymentStatus is the payment state of a single ticket.
+type PaymentStatus string
+
+const (
+	PaymentPending  PaymentStatus = "pending_payment"
+	PaymentPaid     PaymentStatus = "paid"
+	PaymentRefunded PaymentStatus = "refunded"
+)
+
+// PaymentState is the state of a payment at the provider.
+type PaymentState string
+
+const (
+	PaymentAuthorized PaymentState = "authorized"
+	PaymentCapturing  PaymentState = "capturing" // Capture sent to the provider, its outcome is not recorded yet
+	PaymentCaptured   PaymentState = "captured"
+)
+
+// RefundState is the state of a refund at the provider.
+type RefundState string
+
+const (
+	RefundPending   RefundState = "pending"
+	RefundCompleted RefundState = "completed"
+	RefundFailed    RefundState = "failed" // Given up after MaxRefundAttempts, left to an operator
+)
+
+// MaxRefundAttempts is how often a pending refund is tried at the provider
+// before it is marked failed.
+const MaxRefundAttempts = 10
+
+var (
+	ErrPaymentDeclined = errors.New("payment declined")
+	ErrPaymentTimeout  = errors.New("payment provider timed out")
+	ErrPaymentNotFound = errors.New("payment not found")
+)
+
+// Payment is the single provider payment covering every ticket of a
+// booking saga.
+type Payment struct {
+	ID              uint `gorm:"primaryKey"`
+	SagaID          uint `gorm:"uniqueIndex"`
+	UserID          uint
+	AuthorizationID string
+	Amount          int64
+	RefundedAmount  int64 // Includes refunds still pending at the provider
+	Currency        string
+	State           PaymentState
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func NewPayment(sagaID, userID uint, authorizationID string, amount int64, currency string) *Payment {
+	return &Payment{
+		SagaID:          sagaID,
+		UserID:          userID,
+		AuthorizationID: authorizationID,
+		Amount:          amount,
+		Currency:        currency,
+		State:           PaymentAuthorized,
+	}
+}
+
+// Refundable returns the captured amount that has not been refunded yet.
+// A capturing payment has to be settled with the provider first.
+func (p *Payment) Refundable() int64 {
+	if p.State != PaymentCaptured {
+		return 0
+	}
+
+	return p.Amount - p.RefundedAmount
+}
+
+// Refund is money returned to the passenger for a payment. It is saved
+// pending together with the change it is owed for and paid at the provider
+// afterwards, so a refund is never paid without being recorded.
+type Refund struct {
+	ID        uint `gorm:"primaryKey"`
+	PaymentID uint `gorm:"index"`
+	TicketID  uint `gorm:"index"` // Ticket the refund is for, 0 for a compensated booking saga
+	Amount    int64
+	State     RefundState `gorm:"index"`
+	Attempts  uint
+	LastError string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewRefund(paymentID, ticketID uint, amount int64) *Refund {
+	return &Refund{
+		PaymentID: paymentID,
+		TicketID:  ticketID,
+		Amount:    amount,
+		State:     RefundPending,
+	}
+}
+
+// Key identifies the refund at the provider, so a refund retried after a
+// lost reply is paid only once.
+func (r *Refund) Key() string {
+	return fmt.Sprintf("refund-%d", r.ID)
+}
+
+func RefundTotal(refunds []Refund) int64 {
+	var total int64
+
+	for _, refund := range refunds {
+		total += refund.Amount
+	}
+
+	return total
+}
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrCancellationNotAllowed = errors.New("cancellation not allowed")
+
+// RefundRule refunds RefundPercent of the ticket price when the ticket is
+// canceled at least MinHoursBeforeDeparture hours before departure.
+type RefundRule struct {
+	MinHoursBeforeDeparture float64 `json:"min_hours_before_departure"`
+	RefundPercent           int64   `json:"refund_percent"`
+}
+
+// RefundPolicy decides whether a ticket may be canceled and how much of its
+// price is refunded. A cancellation matching no rule is not allowed.
+type RefundPolicy struct {
+	Rules []RefundRule `json:"rules"`
+}
+
+func DefaultRefundPolicy() *RefundPolicy {
+	return &RefundPolicy{
+		Rules: []RefundRule{
+			{MinHoursBeforeDeparture: 48, RefundPercent: 100},
+			{MinHoursBeforeDeparture: 2, RefundPercent: 50},
+			{MinHoursBeforeDeparture: 0, RefundPercent: 0},
+		},
+	}
+}
+
+func (p *RefundPolicy) Validate() error {
+	for _, rule := range p.Rules {
+		if rule.RefundPercent < 0 || rule.RefundPercent > 100 {
+			return fmt.Errorf("invalid refund percent %d: must be between 0 and 100", rule.RefundPercent)
+		}
+	}
+
+	return nil
+}
+
+// RefundAmount applies the rule with the largest threshold that is still
+// met at now.
+func (p *RefundPolicy) RefundAmount(price int64, departure, now time.Time) (int64, error) {
+	hoursBeforeDeparture := departure.Sub(now).Hours()
+
+	var matched *RefundRule
+
+	for i, rule := range p.Rules {
+		if hoursBeforeDeparture < rule.MinHoursBeforeDeparture {
+			continue
+		}
+
+		if matched == nil || rule.MinHoursBeforeDeparture > matched.MinHoursBeforeDeparture {
+			matched = &p.Rules[i]
+		}
+	}
+
+	if matched == nil {
+		return 0, fmt.Errorf("%w: %.1f hours before departure", ErrCancellationNotAllowed, hoursBeforeDeparture)
+	}
+
+	return price * matched.RefundPercent / 100, nil
+}
+
+// Cancellation is the outcome of canceling a ticket.
+type Cancellation struct {
+	TicketID     uint
+	UserID       uint
+	TrainID      uint
+	SeatNumber   uint
+	RefundAmount int64
+	Currency     string
+	CanceledAt   time.Time
+}
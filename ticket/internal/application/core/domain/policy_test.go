@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRefundPolicyRefundAmount(t *testing.T) {
+	departure := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		before time.Duration
+		want   int64
+		err    error
+	}{
+		{name: "well ahead", before: 72 * time.Hour, want: 1000},
+		{name: "exactly 48 hours", before: 48 * time.Hour, want: 1000},
+		{name: "just under 48 hours", before: 48*time.Hour - time.Second, want: 500},
+		{name: "exactly 2 hours", before: 2 * time.Hour, want: 500},
+		{name: "just under 2 hours", before: 2*time.Hour - time.Second, want: 0},
+		{name: "at departure", before: 0, want: 0},
+		{name: "after departure", before: -time.Minute, err: ErrCancellationNotAllowed},
+	}
+
+	policy := DefaultRefundPolicy()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := policy.RefundAmount(1000, departure, departure.Add(-tt.before))
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("RefundAmount() error = %v, want %v", err, tt.err)
+			}
+
+			if got != tt.want {
+				t.Errorf("RefundAmount() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRefundPolicyRefundAmountWithoutFallbackRule(t *testing.T) {
+	departure := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	policy := &RefundPolicy{
+		Rules: []RefundRule{
+			{MinHoursBeforeDeparture: 24, RefundPercent: 80},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		before time.Duration
+		want   int64
+		err    error
+	}{
+		{name: "exactly 24 hours", before: 24 * time.Hour, want: 800},
+		{name: "just under 24 hours", before: 24*time.Hour - time.Second, err: ErrCancellationNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := policy.RefundAmount(1000, departure, departure.Add(-tt.before))
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("RefundAmount() error = %v, want %v", err, tt.err)
+			}
+
+			if got != tt.want {
+				t.Errorf("RefundAmount() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
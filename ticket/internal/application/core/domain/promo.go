@@ -0,0 +1,184 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type DiscountType string
+
+const (
+	DiscountPercentage DiscountType = "percentage"
+	DiscountFixed      DiscountType = "fixed"
+)
+
+var (
+	ErrInvalidPromoCode       = errors.New("promo code not allowed: invalid definition")
+	ErrPromoCodeNotFound      = errors.New("promo code not allowed: unknown code")
+	ErrPromoCodeInactive      = errors.New("promo code not allowed: code is not active")
+	ErrPromoCodeOutsideWindow = errors.New("promo code not allowed: code is not valid at this time")
+	ErrPromoCodeNotApplicable = errors.New("promo code not allowed: code does not apply to this train")
+	ErrPromoCodeExhausted     = errors.New("promo code not allowed: code has no uses left")
+	ErrPromoCodeUserLimit     = errors.New("promo code not allowed: user has no uses of the code left")
+	ErrPromoCodeExists        = errors.New("promo code conflict: code already exists")
+)
+
+// PromoCode discounts a booking by a percentage of its fare or by a fixed
+// amount in minor currency units. Zero use limits mean unlimited uses. A
+// code with restrictions only applies to trains matching one of them.
+type PromoCode struct {
+	ID             uint   `gorm:"primaryKey"`
+	Code           string `gorm:"uniqueIndex;size:32"`
+	DiscountType   DiscountType
+	Value          int64
+	MaxUses        uint
+	MaxUsesPerUser uint
+	Uses           uint
+	ValidFrom      time.Time
+	ValidUntil     time.Time
+	Active         bool
+	Restrictions   []PromoRestriction `gorm:"foreignKey:PromoCodeID;constraint:OnDelete:CASCADE"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// PromoRestriction matches a single train when TrainID is set, otherwise
+// every train running between Origin and Destination.
+type PromoRestriction struct {
+	ID          uint `gorm:"primaryKey"`
+	PromoCodeID uint `gorm:"index"`
+	TrainID     uint
+	Origin      string
+	Destination string
+}
+
+// PromoRedemption records a use of a promo code by the booking saga that
+// redeemed it.
+type PromoRedemption struct {
+	ID          uint `gorm:"primaryKey"`
+	PromoCodeID uint `gorm:"index"`
+	UserID      uint `gorm:"index"`
+	SagaID      uint `gorm:"index"`
+	Discount    int64
+	CreatedAt   time.Time
+}
+
+// NormalizePromoCode makes codes case insensitive.
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func (p *PromoCode) Validate() error {
+	p.Code = NormalizePromoCode(p.Code)
+
+	if p.Code == "" || len(p.Code) > 32 {
+		return fmt.Errorf("%w: code must have 1 to 32 characters", ErrInvalidPromoCode)
+	}
+
+	switch p.DiscountType {
+	case DiscountPercentage:
+		if p.Value <= 0 || p.Value > 100 {
+			return fmt.Errorf("%w: percentage must be between 1 and 100", ErrInvalidPromoCode)
+		}
+	case DiscountFixed:
+		if p.Value <= 0 {
+			return fmt.Errorf("%w: amount must be positive", ErrInvalidPromoCode)
+		}
+	default:
+		return fmt.Errorf("%w: unknown discount type %q", ErrInvalidPromoCode, p.DiscountType)
+	}
+
+	if !p.ValidUntil.IsZero() && !p.ValidUntil.After(p.ValidFrom) {
+		return fmt.Errorf("%w: validity window ends before it starts", ErrInvalidPromoCode)
+	}
+
+	for _, restriction := range p.Restrictions {
+		if restriction.TrainID == 0 && (restriction.Origin == "" || restriction.Destination == "") {
+			return fmt.Errorf("%w: a restriction needs a train or a route", ErrInvalidPromoCode)
+		}
+	}
+
+	return nil
+}
+
+// Check reports why the code cannot be used on the train at now, if it
+// cannot. Use limits are checked again when the code is redeemed.
+func (p *PromoCode) Check(train *Train, now time.Time) error {
+	if !p.Active {
+		return fmt.Errorf("%w: %s", ErrPromoCodeInactive, p.Code)
+	}
+
+	if now.Before(p.ValidFrom) || (!p.ValidUntil.IsZero() && !now.Before(p.ValidUntil)) {
+		return fmt.Errorf("%w: %s", ErrPromoCodeOutsideWindow, p.Code)
+	}
+
+	if p.MaxUses != 0 && p.Uses >= p.MaxUses {
+		return fmt.Errorf("%w: %s", ErrPromoCodeExhausted, p.Code)
+	}
+
+	if !p.AppliesTo(train) {
+		return fmt.Errorf("%w: %s on train %d", ErrPromoCodeNotApplicable, p.Code, train.ID)
+	}
+
+	return nil
+}
+
+func (p *PromoCode) AppliesTo(train *Train) bool {
+	if len(p.Restrictions) == 0 {
+		return true
+	}
+
+	for _, restriction := range p.Restrictions {
+		if restriction.TrainID != 0 {
+			if restriction.TrainID == train.ID {
+				return true
+			}
+
+			continue
+		}
+
+		if restriction.Origin == train.Origin && restriction.Destination == train.Destination {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Apply takes the discount off the fares and returns the total discount. A
+// percentage comes off every fare, a fixed amount off the fares in order
+// until it is used up. No fare goes below zero.
+func (p *PromoCode) Apply(fares []Fare) int64 {
+	var total int64
+
+	remaining := p.Value
+
+	for i := range fares {
+		var discount int64
+
+		switch p.DiscountType {
+		case DiscountPercentage:
+			discount = fares[i].Price * p.Value / 100
+		case DiscountFixed:
+			discount = min(remaining, fares[i].Price)
+			remaining -= discount
+		}
+
+		fares[i].PromoDiscount = discount
+		fares[i].Price -= discount
+		total += discount
+	}
+
+	return total
+}
+
+func NewPromoRedemption(promo *PromoCode, userID, sagaID uint, discount int64) *PromoRedemption {
+	return &PromoRedemption{
+		PromoCodeID: promo.ID,
+		UserID:      userID,
+		SagaID:      sagaID,
+		Discount:    discount,
+	}
+}
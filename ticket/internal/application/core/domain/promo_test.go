@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPromoCodeCheck(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+	train := &Train{ID: 7, TravelDetails: TravelDetails{Origin: "A", Destination: "B"}}
+
+	valid := func() *PromoCode {
+		return &PromoCode{
+			Code:         "SPRING",
+			DiscountType: DiscountPercentage,
+			Value:        10,
+			MaxUses:      5,
+			Uses:         4,
+			ValidFrom:    now.Add(-time.Hour),
+			ValidUntil:   now.Add(time.Hour),
+			Active:       true,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(p *PromoCode)
+		err    error
+	}{
+		{name: "valid with one use left", modify: func(p *PromoCode) {}},
+		{name: "exhausted", modify: func(p *PromoCode) { p.Uses = 5 }, err: ErrPromoCodeExhausted},
+		{name: "used beyond its limit", modify: func(p *PromoCode) { p.Uses = 6 }, err: ErrPromoCodeExhausted},
+		{name: "unlimited uses", modify: func(p *PromoCode) { p.MaxUses, p.Uses = 0, 1000 }},
+		{name: "expired at the end of its window", modify: func(p *PromoCode) { p.ValidUntil = now }, err: ErrPromoCodeOutsideWindow},
+		{name: "expired before now", modify: func(p *PromoCode) { p.ValidUntil = now.Add(-time.Minute) }, err: ErrPromoCodeOutsideWindow},
+		{name: "not yet valid", modify: func(p *PromoCode) { p.ValidFrom = now.Add(time.Minute) }, err: ErrPromoCodeOutsideWindow},
+		{name: "valid from now", modify: func(p *PromoCode) { p.ValidFrom = now }},
+		{name: "open ended", modify: func(p *PromoCode) { p.ValidUntil = time.Time{} }},
+		{name: "inactive", modify: func(p *PromoCode) { p.Active = false }, err: ErrPromoCodeInactive},
+		{
+			name:   "restricted to another train",
+			modify: func(p *PromoCode) { p.Restrictions = []PromoRestriction{{TrainID: 8}} },
+			err:    ErrPromoCodeNotApplicable,
+		},
+		{
+			name:   "restricted to the route of the train",
+			modify: func(p *PromoCode) { p.Restrictions = []PromoRestriction{{Origin: "A", Destination: "B"}} },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			promo := valid()
+			tt.modify(promo)
+
+			err := promo.Check(train, now)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Check() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// BookingSagaLease is how long a saga may go without progress before
+// recovery takes it over from the instance running it. It outlasts every
+// provider and train service timeout of a booking.
+const BookingSagaLease = 2 * time.Minute
+
+var (
+	ErrSagaStatusChanged   = errors.New("saga conflict: booking saga was finished or taken over")
+	ErrBookingSagaNotFound = errors.New("booking saga not found")
+)
+
+type SagaStatus string
+
+const (
+	SagaStarted      SagaStatus = "started"
+	SagaCompleted    SagaStatus = "completed"
+	SagaCompensating SagaStatus = "compensating"
+	SagaCompensated  SagaStatus = "compensated"
+)
+
+type SagaSeatStatus string
+
+const (
+	SagaSeatPending  SagaSeatStatus = "pending"
+	SagaSeatBooked   SagaSeatStatus = "booked"
+	SagaSeatReleased SagaSeatStatus = "released"
+)
+
+// BookingSaga keeps track of a multi-seat booking so that it either books
+// every seat or gets fully compensated, even across a service restart.
+type BookingSaga struct {
+	ID                  uint `gorm:"primaryKey"`
+	UserID              uint
+	TrainID             uint
+	CartID              uint `gorm:"index"` // Cart checked out by the saga, zero for a direct booking
+	IdempotencyRecordID uint `gorm:"index"` // Idempotency record of the request that started the saga, zero without a key
+	Status              SagaStatus
+	Seats               []BookingSagaSeat `gorm:"foreignKey:SagaID;constraint:OnDelete:CASCADE"`
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+type BookingSagaSeat struct {
+	ID         uint `gorm:"primaryKey"`
+	SagaID     uint // Foreign key to BookingSaga
+	TrainID    uint // Zero for seats booked before multi-leg bookings, which are on the saga train
+	SeatID     uint
+	SeatNumber uint
+	Segment    // Zero for seats booked before multi-stop routes, which are on the whole route
+	Status     SagaSeatStatus
+}
+
+func NewBookingSaga(userID, trainID uint, segment Segment, seats []Seat) *BookingSaga {
+	saga := &BookingSaga{
+		UserID:  userID,
+		TrainID: trainID,
+		Status:  SagaStarted,
+	}
+
+	saga.AddSeats(trainID, segment, seats)
+
+	return saga
+}
+
+// AddSeats adds the seats of another leg of the booking to the saga.
+func (s *BookingSaga) AddSeats(trainID uint, segment Segment, seats []Seat) {
+	for _, seat := range seats {
+		s.Seats = append(s.Seats, BookingSagaSeat{
+			TrainID:    trainID,
+			SeatID:     seat.ID,
+			SeatNumber: seat.SeatNumber,
+			Segment:    segment,
+			Status:     SagaSeatPending,
+		})
+	}
+}
+
+// SeatTrainID is the train the saga seat is on.
+func (s *BookingSaga) SeatTrainID(seat *BookingSagaSeat) uint {
+	if seat.TrainID == 0 {
+		return s.TrainID
+	}
+
+	return seat.TrainID
+}
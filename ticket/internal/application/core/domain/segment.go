@@ -0,0 +1,51 @@
+package domain
+
+// Segment is the part of a train route between two of its stops, by
+// sequence. Tickets booked before routes had stops are for the zero
+// segment, the whole route.
+type Segment struct {
+	FromStop uint
+	ToStop   uint
+}
+
+// TicketSeatLeg claims the seat of an active ticket on one leg of the
+// route, the stretch from stop Leg to the next one. A seat can be claimed
+// only once per leg, so a seat sold from A to B can be sold again from B to
+// C but not from A to C.
+type TicketSeatLeg struct {
+	ID       uint `gorm:"primaryKey"`
+	TicketID uint `gorm:"index"`
+	SeatID   uint `gorm:"uniqueIndex:idx_ticket_seat_leg"`
+	Leg      uint `gorm:"uniqueIndex:idx_ticket_seat_leg"`
+}
+
+// Legs are the legs of the route the segment covers.
+func (s Segment) Legs() []uint {
+	if s.ToStop == 0 {
+		return []uint{0}
+	}
+
+	legs := make([]uint, 0, s.ToStop-s.FromStop)
+	for leg := s.FromStop; leg < s.ToStop; leg++ {
+		legs = append(legs, leg)
+	}
+
+	return legs
+}
+
+// SeatLegs are the legs the seats of the tickets claim.
+func SeatLegs(tickets []Ticket) []TicketSeatLeg {
+	var seatLegs []TicketSeatLeg
+
+	for _, ticket := range tickets {
+		for _, leg := range ticket.Segment.Legs() {
+			seatLegs = append(seatLegs, TicketSeatLeg{
+				TicketID: ticket.ID,
+				SeatID:   ticket.SeatID,
+				Leg:      leg,
+			})
+		}
+	}
+
+	return seatLegs
+}
@@ -1,28 +1,168 @@
 package domain
 
 import (
+	"errors"
+	"fmt"
+	"slices"
 	"time"
 )
 
+type TicketStatus string
+
+const (
+	TicketReserved  TicketStatus = "reserved"
+	TicketConfirmed TicketStatus = "confirmed"
+	TicketCheckedIn TicketStatus = "checked_in"
+	TicketUsed      TicketStatus = "used"
+	TicketCanceled  TicketStatus = "canceled"
+	TicketExpired   TicketStatus = "expired"
+	TicketRefunded  TicketStatus = "refunded"
+	TicketExchanged TicketStatus = "exchanged"
+)
+
+var ErrInvalidTicketTransition = errors.New("invalid ticket status transition")
+
+// TicketTimeframe selects tickets by whether their train has departed.
+type TicketTimeframe string
+
+const (
+	TicketsAll      TicketTimeframe = ""
+	TicketsUpcoming TicketTimeframe = "upcoming"
+	TicketsPast     TicketTimeframe = "past"
+)
+
+var ErrInvalidTicketTimeframe = errors.New("ticket timeframe not allowed: use upcoming or past")
+
+// ticketTransitions lists the statuses a ticket may move to from each status.
+var ticketTransitions = map[TicketStatus][]TicketStatus{
+	TicketReserved:  {TicketConfirmed, TicketCanceled, TicketExpired},
+	TicketConfirmed: {TicketCheckedIn, TicketCanceled, TicketExpired, TicketExchanged},
+	TicketCheckedIn: {TicketUsed, TicketExpired},
+	TicketCanceled:  {TicketRefunded},
+}
+
+// Ticket is owned by the account in UserID, which is not necessarily the
+// passenger travelling on it.
 type Ticket struct {
 	ID         uint `gorm:"primaryKey"`
 	UserID     uint
+	Passenger  Passenger `gorm:"embedded;embeddedPrefix:passenger_"`
 	TrainID    uint
-	SeatID uint
+	SeatID     uint // Claimed on every leg of the segment through TicketSeatLeg while active
 	SeatNumber uint
+	Class      string
+	SagaID     uint `gorm:"index"`
+	BookingReference string `gorm:"index;size:6"`
+	PaymentID  uint `gorm:"index"`
+	Price      int64
+	Currency   string
+	PaymentStatus PaymentStatus
+	Status     TicketStatus `gorm:"index"`
+	History    []TicketHistory `gorm:"foreignKey:TicketID;constraint:OnDelete:CASCADE"`
 	TravelDetails
-	ExpiresAt  time.Time
+	Segment
+	ExpiresAt  time.Time `gorm:"index"`
 	CanceledAt *time.Time 
 	CreatedAt  time.Time
 }
 
-func NewTicket(userID, trainID,seatID , seatNumber uint, expiresAt time.Time, travelDetails TravelDetails) *Ticket {
+// TicketHistory records a single status transition of a ticket.
+type TicketHistory struct {
+	ID         uint `gorm:"primaryKey"`
+	TicketID   uint `gorm:"index"`
+	FromStatus TicketStatus
+	ToStatus   TicketStatus
+	Reason     string
+	CreatedAt  time.Time
+}
+
+func NewTicket(userID, trainID,seatID , seatNumber uint, expiresAt time.Time, travelDetails TravelDetails, segment Segment) *Ticket {
 	return &Ticket{
 		UserID:        userID,
 		TrainID:       trainID,
 		SeatID: seatID,
 		SeatNumber:    seatNumber,
 		TravelDetails: travelDetails,
+		Segment:       segment,
 		ExpiresAt:     expiresAt,
+		Status:        TicketReserved,
+		History: []TicketHistory{
+			{ToStatus: TicketReserved, Reason: "seat reserved"},
+		},
+	}
+}
+
+func (s TicketStatus) Valid() bool {
+	switch s {
+	case TicketReserved, TicketConfirmed, TicketCheckedIn, TicketUsed, TicketCanceled, TicketExpired, TicketRefunded, TicketExchanged:
+		return true
+	default:
+		return false
+	}
+}
+
+func (t *Ticket) CanTransition(to TicketStatus) bool {
+	return slices.Contains(ticketTransitions[t.Status], to)
+}
+
+// Transition moves the ticket to the given status and returns the history
+// entry recording the change.
+func (t *Ticket) Transition(to TicketStatus, reason string, at time.Time) (*TicketHistory, error) {
+	if !t.CanTransition(to) {
+		return nil, fmt.Errorf("%w: %s -> %s", ErrInvalidTicketTransition, t.Status, to)
+	}
+
+	entry := TicketHistory{
+		TicketID:   t.ID,
+		FromStatus: t.Status,
+		ToStatus:   to,
+		Reason:     reason,
+		CreatedAt:  at,
+	}
+
+	t.Status = to
+
+	// Canceled and exchanged tickets give their seat back
+	if to == TicketCanceled || to == TicketExchanged {
+		t.CanceledAt = &at
+	}
+
+	t.History = append(t.History, entry)
+
+	return &entry, nil
+}
+
+func (f TicketTimeframe) Validate() error {
+	switch f {
+	case TicketsAll, TicketsUpcoming, TicketsPast:
+		return nil
+	default:
+		return fmt.Errorf("%w: %q", ErrInvalidTicketTimeframe, f)
 	}
 }
+
+// IsExpired reports whether the train of the ticket has departed at now.
+func (t *Ticket) IsExpired(now time.Time) bool {
+	return !t.ExpiresAt.IsZero() && !now.Before(t.ExpiresAt)
+}
+
+// HasArrived reports whether the train of the ticket has ended its trip at
+// now. A ticket stays valid on board from departure until then.
+func (t *Ticket) HasArrived(now time.Time) bool {
+	end := t.ExpiresAt
+	if t.ArrivalTime.After(end) {
+		end = t.ArrivalTime
+	}
+
+	return !end.IsZero() && !now.Before(end)
+}
+
+// Expire closes a ticket whose train has arrived. A checked in ticket was
+// travelled on and becomes used, any other ticket expires unused.
+func (t *Ticket) Expire(now time.Time) (*TicketHistory, error) {
+	if t.Status == TicketCheckedIn {
+		return t.Transition(TicketUsed, "train arrived after check-in", now)
+	}
+
+	return t.Transition(TicketExpired, "train arrived", now)
+}
@@ -0,0 +1,171 @@
+package domain
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TicketSort orders a ticket listing by departure or creation time, a
+// leading "-" sorting newest first. Ties are broken by ticket ID.
+type TicketSort string
+
+const (
+	SortByDeparture     TicketSort = "departure"
+	SortByDepartureDesc TicketSort = "-departure"
+	SortByCreated       TicketSort = "created"
+	SortByCreatedDesc   TicketSort = "-created"
+)
+
+const (
+	DefaultTicketPageSize = 20
+	MaxTicketPageSize     = 100
+)
+
+var (
+	ErrInvalidTicketFilter = errors.New("ticket filter not allowed")
+	ErrInvalidTicketCursor = errors.New("ticket cursor not allowed: cursor is malformed or was issued for another sort")
+)
+
+// TicketFilter selects a page of tickets. Zero values leave a criterion
+// out, DepartureFrom is inclusive and DepartureTo exclusive. Canceled
+// selects only canceled or only active tickets when set. The cursor is
+// the NextCursor of the previous page.
+type TicketFilter struct {
+	UserID        uint
+	TrainID       uint
+	Timeframe     TicketTimeframe
+	Statuses      []TicketStatus
+	DepartureFrom time.Time
+	DepartureTo   time.Time
+	Origin        string
+	Destination   string
+	Canceled      *bool
+	Sort          TicketSort
+	Cursor        string
+	Limit         int
+}
+
+// TicketPage is a page of a ticket listing. NextCursor is empty on the
+// last page.
+type TicketPage struct {
+	Tickets    []Ticket
+	NextCursor string
+}
+
+// TicketCursor is the position after the last ticket of a page.
+type TicketCursor struct {
+	Sort TicketSort
+	Key  time.Time
+	ID   uint
+}
+
+// Normalize validates the filter and fills in the default sort and page
+// size. Upcoming tickets are listed soonest first and past tickets most
+// recent first unless another sort is asked for.
+func (f *TicketFilter) Normalize() error {
+	err := f.Timeframe.Validate()
+	if err != nil {
+		return err
+	}
+
+	for _, status := range f.Statuses {
+		if !status.Valid() {
+			return fmt.Errorf("%w: unknown status %q", ErrInvalidTicketFilter, status)
+		}
+	}
+
+	if !f.DepartureFrom.IsZero() && !f.DepartureTo.IsZero() && !f.DepartureFrom.Before(f.DepartureTo) {
+		return fmt.Errorf("%w: departure range is empty", ErrInvalidTicketFilter)
+	}
+
+	if f.Sort == "" {
+		switch f.Timeframe {
+		case TicketsUpcoming:
+			f.Sort = SortByDeparture
+		case TicketsPast:
+			f.Sort = SortByDepartureDesc
+		default:
+			f.Sort = SortByCreated
+		}
+	}
+
+	switch f.Sort {
+	case SortByDeparture, SortByDepartureDesc, SortByCreated, SortByCreatedDesc:
+	default:
+		return fmt.Errorf("%w: unknown sort %q, use departure, -departure, created or -created", ErrInvalidTicketFilter, f.Sort)
+	}
+
+	if f.Limit < 0 || f.Limit > MaxTicketPageSize {
+		return fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidTicketFilter, MaxTicketPageSize)
+	}
+
+	if f.Limit == 0 {
+		f.Limit = DefaultTicketPageSize
+	}
+
+	return nil
+}
+
+// Descending reports whether the sort lists newest tickets first.
+func (s TicketSort) Descending() bool {
+	return strings.HasPrefix(string(s), "-")
+}
+
+// Key is the time of the ticket the sort orders by.
+func (s TicketSort) Key(ticket *Ticket) time.Time {
+	if strings.TrimPrefix(string(s), "-") == string(SortByDeparture) {
+		return ticket.DepartureTime
+	}
+
+	return ticket.CreatedAt
+}
+
+// NewTicketCursor points after the ticket in the given sort.
+func NewTicketCursor(sort TicketSort, ticket *Ticket) *TicketCursor {
+	return &TicketCursor{
+		Sort: sort,
+		Key:  sort.Key(ticket),
+		ID:   ticket.ID,
+	}
+}
+
+// Encode makes the cursor opaque to clients.
+func (c *TicketCursor) Encode() string {
+	raw := fmt.Sprintf("%s:%d:%d", c.Sort, c.Key.UnixNano(), c.ID)
+
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeTicketCursor reads a cursor, which must have been issued for the
+// given sort.
+func DecodeTicketCursor(cursor string, sort TicketSort) (*TicketCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidTicketCursor
+	}
+
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 3 || TicketSort(parts[0]) != sort {
+		return nil, ErrInvalidTicketCursor
+	}
+
+	key, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidTicketCursor
+	}
+
+	ID, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidTicketCursor
+	}
+
+	return &TicketCursor{
+		Sort: sort,
+		Key:  time.Unix(0, key).UTC(),
+		ID:   uint(ID),
+	}, nil
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+var ticketStatuses = []TicketStatus{
+	TicketReserved,
+	TicketConfirmed,
+	TicketCheckedIn,
+	TicketUsed,
+	TicketCanceled,
+	TicketExpired,
+	TicketRefunded,
+	TicketExchanged,
+}
+
+func TestTicketCanTransition(t *testing.T) {
+	allowed := map[[2]TicketStatus]bool{
+		{TicketReserved, TicketConfirmed}:  true,
+		{TicketReserved, TicketCanceled}:   true,
+		{TicketReserved, TicketExpired}:    true,
+		{TicketConfirmed, TicketCheckedIn}: true,
+		{TicketConfirmed, TicketCanceled}:  true,
+		{TicketConfirmed, TicketExpired}:   true,
+		{TicketConfirmed, TicketExchanged}: true,
+		{TicketCheckedIn, TicketUsed}:      true,
+		{TicketCheckedIn, TicketExpired}:   true,
+		{TicketCanceled, TicketRefunded}:   true,
+	}
+
+	for _, from := range ticketStatuses {
+		for _, to := range ticketStatuses {
+			t.Run(string(from)+"->"+string(to), func(t *testing.T) {
+				ticket := &Ticket{Status: from}
+
+				got := ticket.CanTransition(to)
+				if got != allowed[[2]TicketStatus{from, to}] {
+					t.Errorf("CanTransition() = %v, want %v", got, !got)
+				}
+			})
+		}
+	}
+}
+
+func TestTicketTransition(t *testing.T) {
+	at := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		from         TicketStatus
+		to           TicketStatus
+		releasesSeat bool
+		err          error
+	}{
+		{name: "confirm reserved", from: TicketReserved, to: TicketConfirmed},
+		{name: "cancel confirmed", from: TicketConfirmed, to: TicketCanceled, releasesSeat: true},
+		{name: "exchange confirmed", from: TicketConfirmed, to: TicketExchanged, releasesSeat: true},
+		{name: "refund canceled", from: TicketCanceled, to: TicketRefunded},
+		{name: "use checked in", from: TicketCheckedIn, to: TicketUsed},
+		{name: "reopen canceled", from: TicketCanceled, to: TicketConfirmed, err: ErrInvalidTicketTransition},
+		{name: "refund used", from: TicketUsed, to: TicketRefunded, err: ErrInvalidTicketTransition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ticket := &Ticket{ID: 3, Status: tt.from}
+
+			entry, err := ticket.Transition(tt.to, "test", at)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Transition() error = %v, want %v", err, tt.err)
+			}
+
+			if tt.err != nil {
+				if ticket.Status != tt.from || len(ticket.History) != 0 || ticket.CanceledAt != nil {
+					t.Errorf("Transition() changed the ticket on error: %+v", ticket)
+				}
+
+				return
+			}
+
+			want := TicketHistory{TicketID: 3, FromStatus: tt.from, ToStatus: tt.to, Reason: "test", CreatedAt: at}
+			if *entry != want {
+				t.Errorf("Transition() entry = %+v, want %+v", *entry, want)
+			}
+
+			if ticket.Status != tt.to || len(ticket.History) != 1 || ticket.History[0] != want {
+				t.Errorf("Transition() ticket = %+v, want status %s with the entry recorded", ticket, tt.to)
+			}
+
+			if (ticket.CanceledAt != nil) != tt.releasesSeat {
+				t.Errorf("Transition() CanceledAt = %v, want set %v", ticket.CanceledAt, tt.releasesSeat)
+			}
+		})
+	}
+}
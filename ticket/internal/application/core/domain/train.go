@@ -1,12 +1,33 @@
 package domain
 
-import "time"
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrSeatNotOnTrain    = errors.New("seat does not belong to the train")
+	ErrSeatAlreadyBooked = errors.New("seat conflict: seat is already booked")
+	ErrSeatHeld          = errors.New("seat conflict: seat is held by another passenger")
+)
 
 type Train struct {
 	ID uint
 	Name string
     Seats []Seat
+	Capacity uint
 	TravelDetails
+	Segment // Part of the route the train was requested for, with its free seats
+}
+
+// Occupancy is the booked share of the train in percent. The train only
+// carries its free seats.
+func (t *Train) Occupancy() int64 {
+	if t.Capacity == 0 || uint(len(t.Seats)) >= t.Capacity {
+		return 0
+	}
+
+	return int64(t.Capacity-uint(len(t.Seats))) * 100 / int64(t.Capacity)
 }
 
 type TravelDetails struct {
@@ -21,6 +42,10 @@ type Seat struct{
 	TrainID uint
 	UserID uint
     SeatNumber uint
+	Class string
+	Booked bool
+	Held bool // Held by someone until the hold is confirmed or expires
+	Segment   // Part of the route a seat event is about
 }
 
 
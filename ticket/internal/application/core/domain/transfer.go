@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+type TransferStatus string
+
+const (
+	TransferPending  TransferStatus = "pending"
+	TransferAccepted TransferStatus = "accepted"
+	TransferExpired  TransferStatus = "expired"
+)
+
+var (
+	ErrTransferNotAllowed     = errors.New("transfer not allowed")
+	ErrTransferToSelf         = errors.New("transfer not allowed: ticket already belongs to the recipient")
+	ErrTransferNotOwner       = errors.New("transfer not allowed: only the ticket owner can transfer it")
+	ErrTransferNotRecipient   = errors.New("transfer not allowed: only the recipient can accept the transfer")
+	ErrTransferExpired        = errors.New("transfer not allowed: the transfer deadline has passed")
+	ErrTransferInProgress     = errors.New("transfer conflict: ticket already has a pending transfer")
+	ErrTransferNotPending     = errors.New("transfer conflict: transfer is no longer pending")
+	ErrTransferTicketReplaced = errors.New("transfer conflict: ticket changed since the transfer was made")
+)
+
+// TicketTransfer hands a ticket from its owner to another user once the
+// recipient accepts it before ExpiresAt.
+type TicketTransfer struct {
+	ID         uint `gorm:"primaryKey"`
+	TicketID   uint `gorm:"index;uniqueIndex:idx_ticket_transfers_pending,where:status = 'pending'"`
+	FromUserID uint
+	ToUserID   uint           `gorm:"index"`
+	Status     TransferStatus `gorm:"index"`
+	ExpiresAt  time.Time
+	AcceptedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// NewTicketTransfer starts a transfer of the ticket to the recipient. The
+// deadline never runs past the departure of the train.
+func NewTicketTransfer(ticket *Ticket, fromUserID, toUserID uint, now time.Time, ttl time.Duration) (*TicketTransfer, error) {
+	if ticket.UserID != fromUserID {
+		return nil, fmt.Errorf("%w: ticket %d", ErrTransferNotOwner, ticket.ID)
+	}
+
+	if ticket.UserID == toUserID {
+		return nil, fmt.Errorf("%w: ticket %d", ErrTransferToSelf, ticket.ID)
+	}
+
+	err := ticket.CanTransfer(now)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := now.Add(ttl)
+	if ticket.DepartureTime.Before(expiresAt) {
+		expiresAt = ticket.DepartureTime
+	}
+
+	return &TicketTransfer{
+		TicketID:   ticket.ID,
+		FromUserID: fromUserID,
+		ToUserID:   toUserID,
+		Status:     TransferPending,
+		ExpiresAt:  expiresAt,
+	}, nil
+}
+
+// CanTransfer reports why the ticket cannot change hands, if it cannot.
+// Only confirmed tickets of trains that have not left can be transferred.
+func (t *Ticket) CanTransfer(now time.Time) error {
+	if t.Status != TicketConfirmed {
+		return fmt.Errorf("%w: ticket %d is %s", ErrTransferNotAllowed, t.ID, t.Status)
+	}
+
+	if !now.Before(t.DepartureTime) {
+		return fmt.Errorf("%w: train of ticket %d has already departed", ErrTransferNotAllowed, t.ID)
+	}
+
+	return nil
+}
+
+// Accept completes the transfer for the recipient in userID.
+func (t *TicketTransfer) Accept(userID uint, now time.Time) error {
+	if t.ToUserID != userID {
+		return fmt.Errorf("%w: transfer %d", ErrTransferNotRecipient, t.ID)
+	}
+
+	if t.Status != TransferPending {
+		return fmt.Errorf("%w: transfer %d is %s", ErrTransferNotPending, t.ID, t.Status)
+	}
+
+	if !now.Before(t.ExpiresAt) {
+		return fmt.Errorf("%w: transfer %d", ErrTransferExpired, t.ID)
+	}
+
+	t.Status = TransferAccepted
+	t.AcceptedAt = &now
+
+	return nil
+}
+
+// TransferRequest asks to transfer a ticket to a user given by ID or email.
+type TransferRequest struct {
+	TicketID   uint
+	FromUserID uint
+	ToUserID   uint
+	ToEmail    string
+}
+
+// TicketTransferNotice is published to tell both parties about a transfer.
+type TicketTransferNotice struct {
+	Transfer *TicketTransfer
+	Ticket   *Ticket
+	From     *User
+	To       *User
+}
@@ -0,0 +1,16 @@
+package domain
+
+import "fmt"
+
+// User is the part of a user account the ticket service needs to address
+// its owner, read from the user service.
+type User struct {
+	ID        uint
+	FirstName string
+	LastName  string
+	Email     string
+}
+
+func (u *User) FullName() string {
+	return fmt.Sprintf("%s %s", u.FirstName, u.LastName)
+}
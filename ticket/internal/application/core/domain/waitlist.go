@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+type WaitlistStatus string
+
+const (
+	WaitlistWaiting   WaitlistStatus = "waiting"
+	WaitlistOffered   WaitlistStatus = "offered"
+	WaitlistFulfilled WaitlistStatus = "fulfilled"
+	WaitlistLeft      WaitlistStatus = "left"
+	WaitlistExpired   WaitlistStatus = "expired"
+)
+
+var (
+	ErrWaitlistEmpty          = errors.New("waitlist is empty")
+	ErrAlreadyWaitlisted      = errors.New("waitlist conflict: user is already waiting for this train")
+	ErrWaitlistEntryNotActive = errors.New("waitlist conflict: entry is no longer on the waitlist")
+	ErrSeatsAvailable         = errors.New("waitlist not allowed: the train has enough free seats")
+)
+
+// WaitlistEntry is a user waiting for seats on a full train. When seats
+// free up the first waiting entry is offered a seat hold.
+type WaitlistEntry struct {
+	ID             uint `gorm:"primaryKey"`
+	UserID         uint `gorm:"index"`
+	TrainID        uint `gorm:"index"`
+	TicketNumber   uint
+	Status         WaitlistStatus `gorm:"index"`
+	HoldID         uint           `gorm:"index"`
+	OfferExpiresAt *time.Time
+	Position       uint `gorm:"-"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func NewWaitlistEntry(userID, trainID, ticketNumber uint) *WaitlistEntry {
+	return &WaitlistEntry{
+		UserID:       userID,
+		TrainID:      trainID,
+		TicketNumber: ticketNumber,
+		Status:       WaitlistWaiting,
+	}
+}
+
+func (e *WaitlistEntry) IsActive() bool {
+	return e.Status == WaitlistWaiting || e.Status == WaitlistOffered
+}
+
+// Offer hands the seats held by hold to the entry until the hold expires.
+func (e *WaitlistEntry) Offer(hold *SeatHold) {
+	e.Status = WaitlistOffered
+	e.HoldID = hold.ID
+	e.OfferExpiresAt = &hold.ExpiresAt
+	e.Position = 0
+}
@@ -7,8 +7,41 @@ import (
 
 type APIPort interface {
 	GetTicketByID(ctx context.Context, ticketID uint) (*domain.Ticket, error)
-	GetTicketsByUserID(ctx context.Context, userID uint) ([]domain.Ticket, error)
-	GetTicketsByTrainID(ctx context.Context, trainID uint) ([]domain.Ticket, error)
-	BookTicket(ctx context.Context, userID, trainID, ticketNumber uint) ([]domain.Ticket, error)
-	CancelTicket(ctx context.Context, ticketID uint) error
+	GetTicketsByUserID(ctx context.Context, userID uint, filter *domain.TicketFilter) (*domain.TicketPage, error)
+	GetTicketsByTrainID(ctx context.Context, trainID uint, filter *domain.TicketFilter) (*domain.TicketPage, error)
+	BookTicket(ctx context.Context, request *domain.BookingRequest) ([]domain.Ticket, error)
+	QuoteBooking(ctx context.Context, request *domain.BookingRequest) (*domain.Quote, error)
+	CreatePromoCode(ctx context.Context, promo *domain.PromoCode) error
+	GetPromoCode(ctx context.Context, code string) (*domain.PromoCode, error)
+	ListPromoCodes(ctx context.Context) ([]domain.PromoCode, error)
+	DeactivatePromoCode(ctx context.Context, code string) error
+	CancelTicket(ctx context.Context, ticketID uint) (*domain.Cancellation, error)
+	GetBookingByReference(ctx context.Context, reference string) (*domain.Booking, error)
+	CancelBooking(ctx context.Context, reference string, ticketIDs []uint) ([]domain.Cancellation, error)
+	IssueETicket(ctx context.Context, ticketID uint) (string, error)
+	RenderTicketPDF(ctx context.Context, ticketID uint) ([]byte, error)
+	VerifyETicket(ctx context.Context, token string) (*domain.Ticket, error)
+	GetETicketPublicKey(ctx context.Context) []byte
+	TransferTicket(ctx context.Context, request *domain.TransferRequest) (*domain.TicketTransfer, error)
+	GetTicketTransfer(ctx context.Context, transferID uint) (*domain.TicketTransfer, error)
+	AcceptTicketTransfer(ctx context.Context, transferID, userID uint) (*domain.TicketTransfer, error)
+	ExchangeTicket(ctx context.Context, request *domain.ExchangeRequest) (*domain.Exchange, error)
+	CreateCart(ctx context.Context, userID uint) (*domain.Cart, error)
+	GetCart(ctx context.Context, cartID uint) (*domain.Cart, error)
+	AddCartLeg(ctx context.Context, cartID uint, leg *domain.CartLeg) (*domain.Cart, error)
+	RemoveCartLeg(ctx context.Context, cartID, legID uint) (*domain.Cart, error)
+	CheckoutCart(ctx context.Context, request *domain.CheckoutRequest) (*domain.Booking, error)
+	HoldSeats(ctx context.Context, request *domain.BookingRequest) (*domain.SeatHold, error)
+	ConfirmSeatHold(ctx context.Context, holdID uint) ([]domain.Ticket, error)
+	ReleaseSeatHold(ctx context.Context, holdID uint) error
+	SweepSeatHolds(ctx context.Context)
+	JoinWaitlist(ctx context.Context, userID, trainID, ticketNumber uint) (*domain.WaitlistEntry, error)
+	GetWaitlistEntry(ctx context.Context, entryID uint) (*domain.WaitlistEntry, error)
+	LeaveWaitlist(ctx context.Context, entryID uint) error
+	PromoteWaitlist(ctx context.Context, trainID uint) error
+	BeginIdempotentRequest(ctx context.Context, scope, key string, request []byte) (*domain.IdempotencyRecord, error)
+	CompleteIdempotentRequest(ctx context.Context, record *domain.IdempotencyRecord, response []byte) error
+	AbortIdempotentRequest(ctx context.Context, record *domain.IdempotencyRecord) error
+	RecoverBookingSagas(ctx context.Context)
+	RelayOutbox(ctx context.Context)
 }
@@ -8,13 +8,71 @@ import (
 
 type DatabasePort interface {
 	GetTicketByID(ctx context.Context,ticketID uint) (*domain.Ticket, error)
-	GetTicketsByUserID(ctx context.Context,userID uint) ([]domain.Ticket, error)
-	GetTicketsByTrainID(ctx context.Context,trainID uint) ([]domain.Ticket, error)
-	CreateTicket(ctx context.Context,ticket *domain.Ticket) (Tx,error)
-	UpdateCanceledAt(ctx context.Context,ticketID uint, canceledAt time.Time) error
-}
+	ListTickets(ctx context.Context, filter *domain.TicketFilter, now time.Time) (*domain.TicketPage, error)
+	TransitionTicket(ctx context.Context, ticket *domain.Ticket, history []domain.TicketHistory, refunds []domain.Refund, messages []domain.OutboxMessage) error
+	ListExpiredTickets(ctx context.Context, now time.Time, limit int) ([]domain.Ticket, error)
+
+	GetBookingByReference(ctx context.Context, reference string) (*domain.Booking, error)
+	GetBookingBySagaID(ctx context.Context, sagaID uint) (*domain.Booking, error)
+
+	CreateBookingSaga(ctx context.Context, saga *domain.BookingSaga) error
+	GetBookingSagaByIdempotencyRecordID(ctx context.Context, recordID uint) (*domain.BookingSaga, error)
+	ReserveBookingSaga(ctx context.Context, saga *domain.BookingSaga, booking *domain.Booking, tickets []domain.Ticket, redemption *domain.PromoRedemption, messages []domain.OutboxMessage) error
+	CompensateBookingSaga(ctx context.Context, saga *domain.BookingSaga, messages []domain.OutboxMessage) error
+	CompleteBookingSaga(ctx context.Context, saga *domain.BookingSaga, history []domain.TicketHistory, messages []domain.OutboxMessage) error
+	CompleteTicketExchange(ctx context.Context, saga *domain.BookingSaga, history []domain.TicketHistory, oldTicket *domain.Ticket, oldHistory []domain.TicketHistory, refunds []domain.Refund, messages []domain.OutboxMessage) error
+	StartBookingSagaCompensation(ctx context.Context, saga *domain.BookingSaga) error
+	TakeOverBookingSaga(ctx context.Context, saga *domain.BookingSaga, now time.Time) error
+	ListUnfinishedBookingSagas(ctx context.Context, staleBefore time.Time) ([]domain.BookingSaga, error)
+
+	CreatePayment(ctx context.Context, payment *domain.Payment) error
+	GetPaymentByID(ctx context.Context, paymentID uint) (*domain.Payment, error)
+	GetPaymentBySagaID(ctx context.Context, sagaID uint) (*domain.Payment, error)
+	UpdatePaymentState(ctx context.Context, paymentID uint, state domain.PaymentState) error
+	CreateRefund(ctx context.Context, refund *domain.Refund) error
+	ListPendingRefunds(ctx context.Context, limit int) ([]domain.Refund, error)
+	CountUnpaidRefundsByTicketID(ctx context.Context, ticketID uint) (int64, error)
+	CompleteRefund(ctx context.Context, refundID uint) error
+	MarkRefundFailed(ctx context.Context, refundID uint, reason string) error
+
+	CreatePromoCode(ctx context.Context, promo *domain.PromoCode) error
+	GetPromoCodeByCode(ctx context.Context, code string) (*domain.PromoCode, error)
+	ListPromoCodes(ctx context.Context) ([]domain.PromoCode, error)
+	DeactivatePromoCode(ctx context.Context, code string) error
+
+	CreateCart(ctx context.Context, cart *domain.Cart) error
+	GetCartByID(ctx context.Context, cartID uint) (*domain.Cart, error)
+	AddCartLeg(ctx context.Context, leg *domain.CartLeg) error
+	DeleteCartLeg(ctx context.Context, cartID, legID uint) error
+	UpdateCartStatus(ctx context.Context, cart *domain.Cart, status domain.CartStatus, bookingReference string) error
+	ListStaleCartCheckouts(ctx context.Context, staleBefore time.Time) ([]domain.Cart, error)
+	GetCartBookingReference(ctx context.Context, cartID uint) (string, error)
 
-type Tx interface {
-	Commit() error
-	Rollback() error
-}
\ No newline at end of file
+	CreateSeatHold(ctx context.Context, hold *domain.SeatHold) error
+	GetSeatHoldByID(ctx context.Context, holdID uint) (*domain.SeatHold, error)
+	ClaimSeatHold(ctx context.Context, hold *domain.SeatHold, now time.Time) error
+	ReleaseSeatHold(ctx context.Context, hold *domain.SeatHold, status domain.SeatHoldStatus, messages []domain.OutboxMessage) error
+	ListExpiredSeatHolds(ctx context.Context, now time.Time) ([]domain.SeatHold, error)
+
+	CreateTicketTransfer(ctx context.Context, transfer *domain.TicketTransfer, now time.Time, newEvent domain.TicketTransferMessageFactory) error
+	GetTicketTransferByID(ctx context.Context, transferID uint) (*domain.TicketTransfer, error)
+	ExpireTicketTransfer(ctx context.Context, transfer *domain.TicketTransfer) error
+	AcceptTicketTransfer(ctx context.Context, transfer *domain.TicketTransfer, history *domain.TicketHistory, messages []domain.OutboxMessage) error
+
+	CreateWaitlistEntry(ctx context.Context, entry *domain.WaitlistEntry) error
+	GetWaitlistEntryByID(ctx context.Context, entryID uint) (*domain.WaitlistEntry, error)
+	CountActiveWaitlistEntries(ctx context.Context, userID, trainID uint) (int64, error)
+	GetNextWaitlistEntry(ctx context.Context, trainID, freeSeats uint) (*domain.WaitlistEntry, error)
+	OfferWaitlistEntry(ctx context.Context, entry *domain.WaitlistEntry, messages []domain.OutboxMessage) error
+	UpdateWaitlistEntryStatus(ctx context.Context, entry *domain.WaitlistEntry, status domain.WaitlistStatus) error
+	CloseWaitlistOffer(ctx context.Context, holdID uint, status domain.WaitlistStatus) error
+
+	CreateIdempotencyRecord(ctx context.Context, record *domain.IdempotencyRecord) error
+	GetIdempotencyRecord(ctx context.Context, scope, key string) (*domain.IdempotencyRecord, error)
+	TakeOverIdempotencyRecord(ctx context.Context, record *domain.IdempotencyRecord, now time.Time) error
+	CompleteIdempotencyRecord(ctx context.Context, record *domain.IdempotencyRecord, response []byte) error
+	DeleteIdempotencyRecord(ctx context.Context, record *domain.IdempotencyRecord) error
+
+	ClaimOutboxMessages(ctx context.Context, limit int, now time.Time) ([]domain.OutboxMessage, error)
+	SaveOutboxMessage(ctx context.Context, message *domain.OutboxMessage) error
+}
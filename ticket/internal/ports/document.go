@@ -0,0 +1,7 @@
+package ports
+
+import "ticket/internal/application/core/domain"
+
+type TicketDocumentPort interface {
+	RenderTickets(documents []domain.TicketDocument) ([]byte, error)
+}
@@ -3,13 +3,18 @@ package ports
 import (
 	"context"
 	"ticket/internal/application/core/domain"
+	"time"
 )
 
 type EventPublisherPort interface {
-	PublishSeatBooked(ctx context.Context, seat *domain.Seat) error
-	PublishSeatBookingCanceled(ctx context.Context, seat *domain.Seat) error
+	PublishOutboxMessage(ctx context.Context, message *domain.OutboxMessage) error
 }
 
 type RequestPort interface {
 	RequestGetTrainByID(ctx context.Context, trainID uint) (*domain.Train, error)
-}
\ No newline at end of file
+	RequestGetTrainSegment(ctx context.Context, trainID uint, origin, destination string) (*domain.Train, error)
+	RequestListSeatsBySegment(ctx context.Context, trainID uint, segment domain.Segment) ([]domain.Seat, error)
+	RequestHoldSeats(ctx context.Context, trainID, userID uint, seatIDs []uint, segment domain.Segment, heldUntil time.Time) ([]domain.Seat, error)
+	RequestGetUserByID(ctx context.Context, userID uint) (*domain.User, error)
+	RequestGetUserByEmail(ctx context.Context, email string) (*domain.User, error)
+}
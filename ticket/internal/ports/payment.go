@@ -0,0 +1,12 @@
+package ports
+
+import "context"
+
+type PaymentPort interface {
+	Authorize(ctx context.Context, userID uint, amount int64, currency string) (string, error)
+	Capture(ctx context.Context, authorizationID string, amount int64) error
+	// CapturedAmount returns how much of the authorization was captured
+	CapturedAmount(ctx context.Context, authorizationID string) (int64, error)
+	// Refund pays amount back once per refundKey, a repeated key is a no-op
+	Refund(ctx context.Context, authorizationID, refundKey string, amount int64) error
+}
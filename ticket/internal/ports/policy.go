@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+	"ticket/internal/application/core/domain"
+)
+
+type RefundPolicyPort interface {
+	GetRefundPolicy(ctx context.Context) (*domain.RefundPolicy, error)
+}
+
+type FareTablePort interface {
+	GetFareTable(ctx context.Context) (*domain.FareTable, error)
+}
@@ -0,0 +1,7 @@
+package ports
+
+type TicketSignerPort interface {
+	Sign(payload []byte) []byte
+	Verify(payload, signature []byte) bool
+	PublicKey() []byte
+}
@@ -9,7 +9,11 @@ import (
 	"ticket/config"
 	"ticket/internal/adapters/database"
 	"ticket/internal/adapters/database/postgres"
+	"ticket/internal/adapters/document/pdf"
 	"ticket/internal/adapters/event/nats"
+	"ticket/internal/adapters/payment/fake"
+	"ticket/internal/adapters/policy/file"
+	"ticket/internal/adapters/signer/keyfile"
 	"ticket/internal/application/core/api"
 )
 
@@ -49,7 +53,33 @@ func main() {
 
 	requestSenderAdapter := nats.NewTicketRequestSenderAdapter(natsConn)
 
-	apiAdapter := api.NewAPIAdapter(databaseAdapter, eventPublisherAdapter, requestSenderAdapter)
+	paymentAdapter, err := fake.NewFakePaymentAdapter(config.GetPaymentMode())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	refundPolicyAdapter := file.NewFileRefundPolicyAdapter(config.GetRefundPolicyPath())
+
+	fareTableAdapter := file.NewFileFareTableAdapter(config.GetFareTablePath(), config.GetTicketPrice())
+
+	ticketSignerAdapter, err := keyfile.NewKeyFileSignerAdapter(config.GetTicketSigningKeyPath(), config.GetGenerateTicketSigningKey())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ticketDocumentAdapter := pdf.NewPDFDocumentAdapter()
+
+	apiAdapter := api.NewAPIAdapter(databaseAdapter, eventPublisherAdapter, requestSenderAdapter, paymentAdapter, refundPolicyAdapter, fareTableAdapter, ticketSignerAdapter, ticketDocumentAdapter, api.Options{
+		HoldDuration:     config.GetSeatHoldDuration(),
+		TransferDuration: config.GetTicketTransferDuration(),
+		Currency:         config.GetCurrency(),
+	})
+
+	go apiAdapter.RecoverBookingSagas(ctx)
+	go apiAdapter.RelayOutbox(ctx)
+	go apiAdapter.SweepSeatHolds(ctx)
+	go apiAdapter.ExpireTickets(ctx)
+	go apiAdapter.RetryRefunds(ctx)
 
 	eventResponderAdapter := nats.NewTicketEventResponderAdapter(natsConn, apiAdapter)
 
@@ -88,6 +118,200 @@ func main() {
 		}
 	}()
 
+	go func() {
+		err := eventResponderAdapter.ReplyToIssueETicket(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToIssueETicket:%v", err)
+		}
+	}()
+
+	go func() {
+		err := eventResponderAdapter.ReplyToRenderTicketPDF(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToRenderTicketPDF:%v", err)
+		}
+	}()
+
+	go func() {
+		err := eventResponderAdapter.ReplyToVerifyETicket(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToVerifyETicket:%v", err)
+		}
+	}()
+
+	go func() {
+		err := eventResponderAdapter.ReplyToGetETicketPublicKey(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToGetETicketPublicKey:%v", err)
+		}
+	}()
+
+	go func() {
+		err := eventResponderAdapter.ReplyToTransferTicket(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToTransferTicket:%v", err)
+		}
+	}()
+
+	go func() {
+		err := eventResponderAdapter.ReplyToGetTicketTransfer(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToGetTicketTransfer:%v", err)
+		}
+	}()
+
+	go func() {
+		err := eventResponderAdapter.ReplyToAcceptTicketTransfer(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToAcceptTicketTransfer:%v", err)
+		}
+	}()
+
+	go func() {
+		err := eventResponderAdapter.ReplyToQuoteTicket(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToQuoteTicket:%v", err)
+		}
+	}()
+
+	go func() {
+		err := eventResponderAdapter.ReplyToCreatePromoCode(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToCreatePromoCode:%v", err)
+		}
+	}()
+
+	go func() {
+		err := eventResponderAdapter.ReplyToGetPromoCode(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToGetPromoCode:%v", err)
+		}
+	}()
+
+	go func() {
+		err := eventResponderAdapter.ReplyToListPromoCodes(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToListPromoCodes:%v", err)
+		}
+	}()
+
+	go func() {
+		err := eventResponderAdapter.ReplyToDeactivatePromoCode(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToDeactivatePromoCode:%v", err)
+		}
+	}()
+
+	go func() {
+		err := eventResponderAdapter.ReplyToExchangeTicket(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToExchangeTicket:%v", err)
+		}
+	}()
+
+	go func() {
+		err := eventResponderAdapter.ReplyToHoldTicket(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToHoldTicket:%v", err)
+		}
+	}()
+
+	go func() {
+		err := eventResponderAdapter.ReplyToConfirmTicketHold(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToConfirmTicketHold:%v", err)
+		}
+	}()
+
+	go func() {
+		err := eventResponderAdapter.ReplyToReleaseTicketHold(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToReleaseTicketHold:%v", err)
+		}
+	}()
+
+	go func() {
+		err := eventResponderAdapter.ReplyToGetBookingByReference(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToGetBookingByReference:%v", err)
+		}
+	}()
+
+	go func() {
+		err := eventResponderAdapter.ReplyToCancelBooking(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToCancelBooking:%v", err)
+		}
+	}()
+
+	go func() {
+		err := eventResponderAdapter.ReplyToJoinWaitlist(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToJoinWaitlist:%v", err)
+		}
+	}()
+
+	go func() {
+		err := eventResponderAdapter.ReplyToGetWaitlistEntry(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToGetWaitlistEntry:%v", err)
+		}
+	}()
+
+	go func() {
+		err := eventResponderAdapter.ReplyToLeaveWaitlist(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToLeaveWaitlist:%v", err)
+		}
+	}()
+
+	go func() {
+		err := eventResponderAdapter.ReplyToCreateCart(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToCreateCart:%v", err)
+		}
+	}()
+
+	go func() {
+		err := eventResponderAdapter.ReplyToGetCart(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToGetCart:%v", err)
+		}
+	}()
+
+	go func() {
+		err := eventResponderAdapter.ReplyToAddCartLeg(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToAddCartLeg:%v", err)
+		}
+	}()
+
+	go func() {
+		err := eventResponderAdapter.ReplyToRemoveCartLeg(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToRemoveCartLeg:%v", err)
+		}
+	}()
+
+	go func() {
+		err := eventResponderAdapter.ReplyToCheckoutCart(ctx)
+		if err != nil {
+			log.Fatalf("error ReplyToCheckoutCart:%v", err)
+		}
+	}()
+
+	eventConsumerAdapter, err := nats.NewTicketEventConsumerAdapter(natsConn, apiAdapter)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	go func() {
+		errStream := eventConsumerAdapter.ConsumeSeatReleases(ctx)
+		for err := range errStream {
+			log.Println(err)
+		}
+	}()
+
 	log.Println("Ticket service is starting...")
 	
 	<- ctx.Done()
@@ -3,21 +3,173 @@ package utils
 import (
 	"ticket/gen"
 	"ticket/internal/application/core/domain"
+	"time"
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 
-func UnmarshalBookTicketRequest(data []byte)(uint,uint,uint,error){
+func UnmarshalBookTicketRequest(data []byte) (*domain.BookingRequest, error) {
 	protoBookTicketRequest := &gen.BookTicketRequest{}
 
-	err := proto.Unmarshal(data,protoBookTicketRequest)
+	err := proto.Unmarshal(data, protoBookTicketRequest)
 	if err != nil {
-		return 0,0,0, err
+		return nil, err
+	}
+
+	bookingRequest := &domain.BookingRequest{
+		UserID:       uint(protoBookTicketRequest.UserId),
+		TrainID:      uint(protoBookTicketRequest.TrainId),
+		TicketNumber: uint(protoBookTicketRequest.TicketNumber),
+		PromoCode:    protoBookTicketRequest.PromoCode,
+		Origin:       protoBookTicketRequest.Origin,
+		Destination:  protoBookTicketRequest.Destination,
+		Class:        protoBookTicketRequest.Class,
+	}
+
+	for _, seatID := range protoBookTicketRequest.SeatIds {
+		bookingRequest.SeatIDs = append(bookingRequest.SeatIDs, uint(seatID))
+	}
+
+	for _, seatNumber := range protoBookTicketRequest.SeatNumbers {
+		bookingRequest.SeatNumbers = append(bookingRequest.SeatNumbers, uint(seatNumber))
+	}
+
+	for _, protoPassenger := range protoBookTicketRequest.Passengers {
+		bookingRequest.Passengers = append(bookingRequest.Passengers, convertProtoPassengerToPassenger(protoPassenger))
+	}
+
+	return bookingRequest, nil
+}
+
+func UnmarshalListTicketsRequest(data []byte) (*domain.TicketFilter, error) {
+	protoListTicketsRequest := &gen.ListTicketsRequest{}
+
+	err := proto.Unmarshal(data, protoListTicketsRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &domain.TicketFilter{
+		UserID:      uint(protoListTicketsRequest.UserId),
+		TrainID:     uint(protoListTicketsRequest.TrainId),
+		Timeframe:   domain.TicketTimeframe(protoListTicketsRequest.Timeframe),
+		Origin:      protoListTicketsRequest.Origin,
+		Destination: protoListTicketsRequest.Destination,
+		Canceled:    protoListTicketsRequest.Canceled,
+		Sort:        domain.TicketSort(protoListTicketsRequest.Sort),
+		Cursor:      protoListTicketsRequest.Cursor,
+		Limit:       int(protoListTicketsRequest.Limit),
+	}
+
+	for _, status := range protoListTicketsRequest.Statuses {
+		filter.Statuses = append(filter.Statuses, domain.TicketStatus(status))
+	}
+
+	if protoListTicketsRequest.DepartureFrom != nil {
+		filter.DepartureFrom = protoListTicketsRequest.DepartureFrom.AsTime()
+	}
+
+	if protoListTicketsRequest.DepartureTo != nil {
+		filter.DepartureTo = protoListTicketsRequest.DepartureTo.AsTime()
+	}
+
+	return filter, nil
+}
+
+func MarshalHoldSeatsRequest(trainID, userID uint, seatIDs []uint, segment domain.Segment, heldUntil time.Time) ([]byte, error) {
+	protoHoldSeatsRequest := &gen.HoldSeatsRequest{
+		UserId:    uint32(userID),
+		TrainId:   uint32(trainID),
+		HeldUntil: timestamppb.New(heldUntil),
+		FromStop:  uint32(segment.FromStop),
+		ToStop:    uint32(segment.ToStop),
+	}
+
+	for _, seatID := range seatIDs {
+		protoHoldSeatsRequest.SeatIds = append(protoHoldSeatsRequest.SeatIds, uint32(seatID))
+	}
+
+	return proto.Marshal(protoHoldSeatsRequest)
+}
+
+func MarshalTrainSegmentRequest(trainID uint, origin, destination string) ([]byte, error) {
+	return proto.Marshal(&gen.TrainSegmentRequest{
+		TrainId:     uint32(trainID),
+		Origin:      origin,
+		Destination: destination,
+	})
+}
+
+func MarshalListSegmentSeatsRequest(trainID uint, segment domain.Segment) ([]byte, error) {
+	return proto.Marshal(&gen.ListSegmentSeatsRequest{
+		TrainId:  uint32(trainID),
+		FromStop: uint32(segment.FromStop),
+		ToStop:   uint32(segment.ToStop),
+	})
+}
+
+func MarshalSeatHold(hold *domain.SeatHold) ([]byte, error) {
+	protoSeatHold := &gen.SeatHold{
+		ID:        uint32(hold.ID),
+		UserId:    uint32(hold.UserID),
+		TrainId:   uint32(hold.TrainID),
+		Status:    string(hold.Status),
+		ExpiresAt: timestamppb.New(hold.ExpiresAt),
+	}
+
+	for _, seat := range hold.TrainSeats() {
+		protoSeatHold.Seats = append(protoSeatHold.Seats, &gen.Seat{
+			ID:         uint32(seat.ID),
+			TrainId:    uint32(seat.TrainID),
+			UserId:     uint32(seat.UserID),
+			SeatNumber: uint32(seat.SeatNumber),
+			Held:       true,
+		})
+	}
+
+	return proto.Marshal(protoSeatHold)
+}
+
+func UnmarshalJoinWaitlistRequest(data []byte) (userID, trainID, ticketNumber uint, err error) {
+	protoJoinWaitlistRequest := &gen.JoinWaitlistRequest{}
+
+	err = proto.Unmarshal(data, protoJoinWaitlistRequest)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return uint(protoJoinWaitlistRequest.UserId), uint(protoJoinWaitlistRequest.TrainId), uint(protoJoinWaitlistRequest.TicketNumber), nil
+}
+
+func MarshalWaitlistEntry(entry *domain.WaitlistEntry) ([]byte, error) {
+	protoWaitlistEntry := &gen.WaitlistEntry{
+		ID:           uint32(entry.ID),
+		UserId:       uint32(entry.UserID),
+		TrainId:      uint32(entry.TrainID),
+		TicketNumber: uint32(entry.TicketNumber),
+		Status:       string(entry.Status),
+		Position:     uint32(entry.Position),
+		HoldId:       uint32(entry.HoldID),
+	}
+
+	if entry.OfferExpiresAt != nil {
+		protoWaitlistEntry.OfferExpiresAt = timestamppb.New(*entry.OfferExpiresAt)
+	}
+
+	return proto.Marshal(protoWaitlistEntry)
+}
+
+func UnmarshalSeat(data []byte) (*domain.Seat, error) {
+	protoSeat := &gen.Seat{}
+
+	err := proto.Unmarshal(data, protoSeat)
+	if err != nil {
+		return nil, err
 	}
 
-	return uint(protoBookTicketRequest.UserId),uint(protoBookTicketRequest.TrainId),uint(protoBookTicketRequest.TicketNumber),nil
+	return convertProtoSeatToSeat(protoSeat), nil
 }
 
 func UnmarshalTrain(data []byte) (*domain.Train, error) {
@@ -28,35 +180,52 @@ func UnmarshalTrain(data []byte) (*domain.Train, error) {
 	}
 
 	train := &domain.Train{
-		ID:   uint(protoTrain.ID),
-		Name: protoTrain.Name,
+		ID:       uint(protoTrain.ID),
+		Name:     protoTrain.Name,
+		Capacity: uint(protoTrain.Capacity),
 		TravelDetails: domain.TravelDetails{
 			Origin:        protoTrain.Origin,
 			Destination:   protoTrain.Destination,
 			DepartureTime: protoTrain.DepartureTime.AsTime(),
 			ArrivalTime:   protoTrain.ArrivalTime.AsTime(),
 		},
+		Segment: domain.Segment{
+			FromStop: uint(protoTrain.FromStop),
+			ToStop:   uint(protoTrain.ToStop),
+		},
 	}
 
 	for _, protoSeat := range protoTrain.Seats {
-		seat := domain.Seat{
-			ID:         uint(protoSeat.ID),
-			TrainID:    uint(protoSeat.TrainId),
-			SeatNumber: uint(protoSeat.SeatNumber),
-		}
-
-		train.Seats = append(train.Seats, seat)
+		train.Seats = append(train.Seats, *convertProtoSeatToSeat(protoSeat))
 	}
 
 	return train, nil
 }
 
+func UnmarshalSeats(data []byte) ([]domain.Seat, error) {
+	protoSeats := gen.ListSeatsReplay{}
+	err := proto.Unmarshal(data, &protoSeats)
+	if err != nil {
+		return nil, err
+	}
+
+	seats := make([]domain.Seat, 0, len(protoSeats.Seats))
+
+	for _, protoSeat := range protoSeats.Seats {
+		seats = append(seats, *convertProtoSeatToSeat(protoSeat))
+	}
+
+	return seats, nil
+}
+
 func MarshalSeat(seat *domain.Seat) ([]byte, error) {
 	var protoSeat = gen.Seat{
 		ID:         uint32(seat.ID),
 		TrainId:    uint32(seat.TrainID),
 		SeatNumber: uint32(seat.SeatNumber),
 		UserId:     uint32(seat.UserID),
+		FromStop:   uint32(seat.FromStop),
+		ToStop:     uint32(seat.ToStop),
 	}
 
 	data, err := proto.Marshal(&protoSeat)
@@ -67,6 +236,114 @@ func MarshalSeat(seat *domain.Seat) ([]byte, error) {
 	return data, nil
 }
 
+func MarshalTicketCanceled(cancellation *domain.Cancellation) ([]byte, error) {
+	protoTicketCanceled := &gen.TicketCanceled{
+		TicketId:     uint32(cancellation.TicketID),
+		UserId:       uint32(cancellation.UserID),
+		TrainId:      uint32(cancellation.TrainID),
+		SeatNumber:   uint32(cancellation.SeatNumber),
+		RefundAmount: cancellation.RefundAmount,
+		Currency:     cancellation.Currency,
+		CanceledAt:   timestamppb.New(cancellation.CanceledAt),
+	}
+
+	return proto.Marshal(protoTicketCanceled)
+}
+
+func MarshalQuoteTicketReply(quote *domain.Quote) ([]byte, error) {
+	protoQuoteTicketReply := &gen.QuoteTicketReply{
+		TrainId:       uint32(quote.TrainID),
+		Total:         quote.Total,
+		Currency:      quote.Currency,
+		PromoCode:     quote.PromoCode,
+		PromoDiscount: quote.PromoDiscount,
+	}
+
+	for _, fare := range quote.Fares {
+		protoQuoteTicketReply.Fares = append(protoQuoteTicketReply.Fares, &gen.Fare{
+			SeatId:        uint32(fare.SeatID),
+			SeatNumber:    uint32(fare.SeatNumber),
+			Class:         fare.Class,
+			Category:      string(fare.Category),
+			BaseFare:      fare.BaseFare,
+			Surcharge:     fare.Surcharge,
+			Discount:      fare.Discount,
+			PromoDiscount: fare.PromoDiscount,
+			Price:         fare.Price,
+		})
+	}
+
+	return proto.Marshal(protoQuoteTicketReply)
+}
+
+func MarshalCancelTicketReply(cancellation *domain.Cancellation) ([]byte, error) {
+	return proto.Marshal(convertCancellationToProtoCancelTicketReply(cancellation))
+}
+
+func UnmarshalCancelBookingRequest(data []byte) (string, []uint, error) {
+	protoCancelBookingRequest := &gen.CancelBookingRequest{}
+
+	err := proto.Unmarshal(data, protoCancelBookingRequest)
+	if err != nil {
+		return "", nil, err
+	}
+
+	ticketIDs := make([]uint, 0, len(protoCancelBookingRequest.TicketIds))
+	for _, ticketID := range protoCancelBookingRequest.TicketIds {
+		ticketIDs = append(ticketIDs, uint(ticketID))
+	}
+
+	return protoCancelBookingRequest.Reference, ticketIDs, nil
+}
+
+func MarshalCancelBookingReply(reference string, cancellations []domain.Cancellation) ([]byte, error) {
+	protoCancelBookingReply := &gen.CancelBookingReply{
+		Reference: reference,
+	}
+
+	for i := range cancellations {
+		protoCancelBookingReply.Cancellations = append(protoCancelBookingReply.Cancellations, convertCancellationToProtoCancelTicketReply(&cancellations[i]))
+	}
+
+	return proto.Marshal(protoCancelBookingReply)
+}
+
+func MarshalBooking(booking *domain.Booking) ([]byte, error) {
+	protoBooking := &gen.Booking{
+		ID:        uint32(booking.ID),
+		Reference: booking.Reference,
+		UserId:    uint32(booking.UserID),
+		TrainId:   uint32(booking.TrainID),
+		CreatedAt: timestamppb.New(booking.CreatedAt),
+	}
+
+	for i := range booking.Tickets {
+		protoBooking.Tickets = append(protoBooking.Tickets, convertTicketToProtoTicket(&booking.Tickets[i]))
+	}
+
+	return proto.Marshal(protoBooking)
+}
+
+func convertCancellationToProtoCancelTicketReply(cancellation *domain.Cancellation) *gen.CancelTicketReply {
+	return &gen.CancelTicketReply{
+		TicketId:     uint32(cancellation.TicketID),
+		RefundAmount: cancellation.RefundAmount,
+		Currency:     cancellation.Currency,
+	}
+}
+
+func MarshalTicketPage(page *domain.TicketPage) ([]byte, error) {
+	protoListTickets := &gen.ListTickets{
+		NextCursor: page.NextCursor,
+	}
+
+	for i := range page.Tickets {
+		protoListTickets.Tickets = append(protoListTickets.Tickets, convertTicketToProtoTicket(&page.Tickets[i]))
+	}
+
+	return proto.Marshal(protoListTickets)
+}
+
 func MarshalTickets(tickets []domain.Ticket) ([]byte, error) {
 	protoListTickets := &gen.ListTickets{}
 
@@ -97,15 +374,31 @@ func MarshalTicket(ticket *domain.Ticket) ([]byte, error) {
 
 func convertTicketToProtoTicket(ticket *domain.Ticket) *gen.Ticket {
 	protoTicket := &gen.Ticket{
-		ID:            uint32(ticket.ID),
-		UserId:        uint32(ticket.UserID),
-		TrainId:       uint32(ticket.TrainID),
-		SeatNumber:    uint32(ticket.SeatNumber),
-		Origin:        ticket.Origin,
-		Destination:   ticket.Destination,
-		DepartureTime: timestamppb.New(ticket.DepartureTime),
-		ArrivalTime:   timestamppb.New(ticket.ArrivalTime),
-		ExpiresAt:     timestamppb.New(ticket.ExpiresAt),
+		ID:               uint32(ticket.ID),
+		UserId:           uint32(ticket.UserID),
+		TrainId:          uint32(ticket.TrainID),
+		SeatNumber:       uint32(ticket.SeatNumber),
+		Class:            ticket.Class,
+		Origin:           ticket.Origin,
+		Destination:      ticket.Destination,
+		DepartureTime:    timestamppb.New(ticket.DepartureTime),
+		ArrivalTime:      timestamppb.New(ticket.ArrivalTime),
+		ExpiresAt:        timestamppb.New(ticket.ExpiresAt),
+		Price:            ticket.Price,
+		Currency:         ticket.Currency,
+		PaymentStatus:    string(ticket.PaymentStatus),
+		Status:           string(ticket.Status),
+		BookingReference: ticket.BookingReference,
+		Passenger:        convertPassengerToProtoPassenger(&ticket.Passenger),
+	}
+
+	for _, entry := range ticket.History {
+		protoTicket.History = append(protoTicket.History, &gen.TicketHistory{
+			FromStatus: string(entry.FromStatus),
+			ToStatus:   string(entry.ToStatus),
+			Reason:     entry.Reason,
+			CreatedAt:  timestamppb.New(entry.CreatedAt),
+		})
 	}
 
 	if ticket.CanceledAt != nil {
@@ -114,3 +407,321 @@ func convertTicketToProtoTicket(ticket *domain.Ticket) *gen.Ticket {
 
 	return protoTicket
 }
+
+func convertProtoSeatToSeat(protoSeat *gen.Seat) *domain.Seat {
+	return &domain.Seat{
+		ID:         uint(protoSeat.ID),
+		TrainID:    uint(protoSeat.TrainId),
+		UserID:     uint(protoSeat.UserId),
+		SeatNumber: uint(protoSeat.SeatNumber),
+		Class:      protoSeat.Class,
+		Booked:     protoSeat.Booked,
+		Held:       protoSeat.Held,
+	}
+}
+
+func convertProtoPassengerToPassenger(protoPassenger *gen.Passenger) domain.Passenger {
+	passenger := domain.Passenger{
+		FirstName:      protoPassenger.FirstName,
+		LastName:       protoPassenger.LastName,
+		DocumentType:   domain.DocumentType(protoPassenger.DocumentType),
+		DocumentNumber: protoPassenger.DocumentNumber,
+		Category:       domain.PassengerCategory(protoPassenger.Category),
+	}
+
+	if protoPassenger.DateOfBirth != nil {
+		dateOfBirth := protoPassenger.DateOfBirth.AsTime()
+		passenger.DateOfBirth = &dateOfBirth
+	}
+
+	return passenger
+}
+
+func convertPassengerToProtoPassenger(passenger *domain.Passenger) *gen.Passenger {
+	protoPassenger := &gen.Passenger{
+		FirstName:      passenger.FirstName,
+		LastName:       passenger.LastName,
+		DocumentType:   string(passenger.DocumentType),
+		DocumentNumber: passenger.DocumentNumber,
+		Category:       string(passenger.Category),
+	}
+
+	if passenger.DateOfBirth != nil {
+		protoPassenger.DateOfBirth = timestamppb.New(*passenger.DateOfBirth)
+	}
+
+	return protoPassenger
+}
+
+func UnmarshalUser(data []byte) (*domain.User, error) {
+	protoUser := &gen.User{}
+
+	err := proto.Unmarshal(data, protoUser)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.User{
+		ID:        uint(protoUser.ID),
+		FirstName: protoUser.FirstName,
+		LastName:  protoUser.LastName,
+		Email:     protoUser.Email,
+	}, nil
+}
+
+func UnmarshalTransferTicketRequest(data []byte) (*domain.TransferRequest, error) {
+	protoTransferTicketRequest := &gen.TransferTicketRequest{}
+
+	err := proto.Unmarshal(data, protoTransferTicketRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.TransferRequest{
+		TicketID:   uint(protoTransferTicketRequest.TicketId),
+		FromUserID: uint(protoTransferTicketRequest.FromUserId),
+		ToUserID:   uint(protoTransferTicketRequest.ToUserId),
+		ToEmail:    protoTransferTicketRequest.ToEmail,
+	}, nil
+}
+
+func UnmarshalAcceptTicketTransferRequest(data []byte) (uint, uint, error) {
+	protoAcceptTicketTransferRequest := &gen.AcceptTicketTransferRequest{}
+
+	err := proto.Unmarshal(data, protoAcceptTicketTransferRequest)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return uint(protoAcceptTicketTransferRequest.TransferId), uint(protoAcceptTicketTransferRequest.UserId), nil
+}
+
+func UnmarshalExchangeTicketRequest(data []byte) (*domain.ExchangeRequest, error) {
+	protoExchangeTicketRequest := &gen.ExchangeTicketRequest{}
+
+	err := proto.Unmarshal(data, protoExchangeTicketRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.ExchangeRequest{
+		TicketID:   uint(protoExchangeTicketRequest.TicketId),
+		TrainID:    uint(protoExchangeTicketRequest.TrainId),
+		SeatID:     uint(protoExchangeTicketRequest.SeatId),
+		SeatNumber: uint(protoExchangeTicketRequest.SeatNumber),
+	}, nil
+}
+
+func MarshalExchangeTicketReply(exchange *domain.Exchange) ([]byte, error) {
+	return proto.Marshal(&gen.ExchangeTicketReply{
+		OldTicket:      convertTicketToProtoTicket(exchange.OldTicket),
+		NewTicket:      convertTicketToProtoTicket(exchange.NewTicket),
+		FareDifference: exchange.FareDifference,
+		Currency:       exchange.Currency,
+	})
+}
+
+func MarshalTicketTransfer(transfer *domain.TicketTransfer) ([]byte, error) {
+	return proto.Marshal(convertTicketTransferToProtoTicketTransfer(transfer))
+}
+
+func MarshalTicketTransferNotice(notice *domain.TicketTransferNotice) ([]byte, error) {
+	protoTicketTransferNotice := &gen.TicketTransferNotice{
+		Transfer:      convertTicketTransferToProtoTicketTransfer(notice.Transfer),
+		TrainId:       uint32(notice.Ticket.TrainID),
+		SeatNumber:    uint32(notice.Ticket.SeatNumber),
+		Origin:        notice.Ticket.Origin,
+		Destination:   notice.Ticket.Destination,
+		DepartureTime: timestamppb.New(notice.Ticket.DepartureTime),
+		FromName:      notice.From.FullName(),
+		FromEmail:     notice.From.Email,
+		ToName:        notice.To.FullName(),
+		ToEmail:       notice.To.Email,
+	}
+
+	return proto.Marshal(protoTicketTransferNotice)
+}
+
+func MarshalBookingConfirmedNotice(confirmation *domain.BookingConfirmation) ([]byte, error) {
+	protoBookingConfirmedNotice := &gen.BookingConfirmedNotice{
+		Reference:     confirmation.Reference,
+		UserId:        uint32(confirmation.User.ID),
+		Name:          confirmation.User.FullName(),
+		Email:         confirmation.User.Email,
+		TrainId:       uint32(confirmation.Train.ID),
+		TrainName:     confirmation.Train.Name,
+		Origin:        confirmation.Train.Origin,
+		Destination:   confirmation.Train.Destination,
+		DepartureTime: timestamppb.New(confirmation.Train.DepartureTime),
+		ArrivalTime:   timestamppb.New(confirmation.Train.ArrivalTime),
+		Document:      confirmation.Document,
+	}
+
+	for i := range confirmation.Tickets {
+		protoBookingConfirmedNotice.Tickets = append(protoBookingConfirmedNotice.Tickets, convertTicketToProtoTicket(&confirmation.Tickets[i]))
+	}
+
+	return proto.Marshal(protoBookingConfirmedNotice)
+}
+
+func convertTicketTransferToProtoTicketTransfer(transfer *domain.TicketTransfer) *gen.TicketTransfer {
+	protoTicketTransfer := &gen.TicketTransfer{
+		ID:         uint32(transfer.ID),
+		TicketId:   uint32(transfer.TicketID),
+		FromUserId: uint32(transfer.FromUserID),
+		ToUserId:   uint32(transfer.ToUserID),
+		Status:     string(transfer.Status),
+		ExpiresAt:  timestamppb.New(transfer.ExpiresAt),
+		CreatedAt:  timestamppb.New(transfer.CreatedAt),
+	}
+
+	if transfer.AcceptedAt != nil {
+		protoTicketTransfer.AcceptedAt = timestamppb.New(*transfer.AcceptedAt)
+	}
+
+	return protoTicketTransfer
+}
+
+func UnmarshalPromoCode(data []byte) (*domain.PromoCode, error) {
+	protoPromoCode := &gen.PromoCode{}
+
+	err := proto.Unmarshal(data, protoPromoCode)
+	if err != nil {
+		return nil, err
+	}
+
+	promo := &domain.PromoCode{
+		Code:           protoPromoCode.Code,
+		DiscountType:   domain.DiscountType(protoPromoCode.DiscountType),
+		Value:          protoPromoCode.Value,
+		MaxUses:        uint(protoPromoCode.MaxUses),
+		MaxUsesPerUser: uint(protoPromoCode.MaxUsesPerUser),
+	}
+
+	if protoPromoCode.ValidFrom != nil {
+		promo.ValidFrom = protoPromoCode.ValidFrom.AsTime()
+	}
+
+	if protoPromoCode.ValidUntil != nil {
+		promo.ValidUntil = protoPromoCode.ValidUntil.AsTime()
+	}
+
+	for _, protoRestriction := range protoPromoCode.Restrictions {
+		promo.Restrictions = append(promo.Restrictions, domain.PromoRestriction{
+			TrainID:     uint(protoRestriction.TrainId),
+			Origin:      protoRestriction.Origin,
+			Destination: protoRestriction.Destination,
+		})
+	}
+
+	return promo, nil
+}
+
+func MarshalPromoCode(promo *domain.PromoCode) ([]byte, error) {
+	return proto.Marshal(convertPromoCodeToProtoPromoCode(promo))
+}
+
+func MarshalPromoCodes(promos []domain.PromoCode) ([]byte, error) {
+	protoListPromoCodes := &gen.ListPromoCodes{}
+
+	for i := range promos {
+		protoListPromoCodes.PromoCodes = append(protoListPromoCodes.PromoCodes, convertPromoCodeToProtoPromoCode(&promos[i]))
+	}
+
+	return proto.Marshal(protoListPromoCodes)
+}
+
+func convertPromoCodeToProtoPromoCode(promo *domain.PromoCode) *gen.PromoCode {
+	protoPromoCode := &gen.PromoCode{
+		ID:             uint32(promo.ID),
+		Code:           promo.Code,
+		DiscountType:   string(promo.DiscountType),
+		Value:          promo.Value,
+		MaxUses:        uint32(promo.MaxUses),
+		MaxUsesPerUser: uint32(promo.MaxUsesPerUser),
+		Uses:           uint32(promo.Uses),
+		ValidFrom:      timestamppb.New(promo.ValidFrom),
+		Active:         promo.Active,
+		CreatedAt:      timestamppb.New(promo.CreatedAt),
+	}
+
+	if !promo.ValidUntil.IsZero() {
+		protoPromoCode.ValidUntil = timestamppb.New(promo.ValidUntil)
+	}
+
+	for _, restriction := range promo.Restrictions {
+		protoPromoCode.Restrictions = append(protoPromoCode.Restrictions, &gen.PromoRestriction{
+			TrainId:     uint32(restriction.TrainID),
+			Origin:      restriction.Origin,
+			Destination: restriction.Destination,
+		})
+	}
+
+	return protoPromoCode
+}
+
+func UnmarshalAddCartLegRequest(data []byte) (uint, *domain.CartLeg, error) {
+	protoAddCartLegRequest := &gen.AddCartLegRequest{}
+
+	err := proto.Unmarshal(data, protoAddCartLegRequest)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	leg := &domain.CartLeg{
+		TrainID:      uint(protoAddCartLegRequest.TrainId),
+		TicketNumber: uint(protoAddCartLegRequest.TicketNumber),
+	}
+
+	return uint(protoAddCartLegRequest.CartId), leg, nil
+}
+
+func UnmarshalRemoveCartLegRequest(data []byte) (cartID, legID uint, err error) {
+	protoRemoveCartLegRequest := &gen.RemoveCartLegRequest{}
+
+	err = proto.Unmarshal(data, protoRemoveCartLegRequest)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return uint(protoRemoveCartLegRequest.CartId), uint(protoRemoveCartLegRequest.LegId), nil
+}
+
+func UnmarshalCheckoutCartRequest(data []byte) (*domain.CheckoutRequest, error) {
+	protoCheckoutCartRequest := &gen.CheckoutCartRequest{}
+
+	err := proto.Unmarshal(data, protoCheckoutCartRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &domain.CheckoutRequest{
+		CartID: uint(protoCheckoutCartRequest.CartId),
+	}
+
+	for _, protoPassenger := range protoCheckoutCartRequest.Passengers {
+		request.Passengers = append(request.Passengers, convertProtoPassengerToPassenger(protoPassenger))
+	}
+
+	return request, nil
+}
+
+func MarshalCart(cart *domain.Cart) ([]byte, error) {
+	protoCart := &gen.Cart{
+		ID:               uint32(cart.ID),
+		UserId:           uint32(cart.UserID),
+		Status:           string(cart.Status),
+		BookingReference: cart.BookingReference,
+		CreatedAt:        timestamppb.New(cart.CreatedAt),
+	}
+
+	for _, leg := range cart.Legs {
+		protoCart.Legs = append(protoCart.Legs, &gen.CartLeg{
+			ID:           uint32(leg.ID),
+			TrainId:      uint32(leg.TrainID),
+			TicketNumber: uint32(leg.TicketNumber),
+		})
+	}
+
+	return proto.Marshal(protoCart)
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"strconv"
 
 	"github.com/joho/godotenv"
 )
@@ -22,4 +23,15 @@ func GetNatsURL() string {
 
 func GetServerPort() string {
 	return os.Getenv("SERVER_PORT")
-}
\ No newline at end of file
+}
+
+// GetTimetableHorizonDays returns how many days ahead runs of timetables are
+// generated, TIMETABLE_HORIZON_DAYS defaults to 30.
+func GetTimetableHorizonDays() int {
+	days, err := strconv.Atoi(os.Getenv("TIMETABLE_HORIZON_DAYS"))
+	if err != nil || days < 0 {
+		return 30
+	}
+
+	return days
+}
@@ -14,12 +14,48 @@ func NewDB(url string) (*gorm.DB, error) {
 		return nil, fmt.Errorf("db connection error: %v", openErr)
 	}
 
+	if err := dropTimetableRunIndex(db); err != nil {
+		return nil, err
+	}
 	if err := migrate(db, &domain.Train{}); err != nil {
 		return nil, err
 	}
 	if err := migrate(db, &domain.Seat{}); err != nil {
 		return nil, err
 	}
+	if err := migrate(db, &domain.Coach{}); err != nil {
+		return nil, err
+	}
+	if err := migrate(db, &domain.LayoutTemplate{}); err != nil {
+		return nil, err
+	}
+	if err := migrate(db, &domain.CoachTemplate{}); err != nil {
+		return nil, err
+	}
+	if err := migrate(db, &domain.SeatTemplate{}); err != nil {
+		return nil, err
+	}
+	if err := migrate(db, &domain.Stop{}); err != nil {
+		return nil, err
+	}
+	if err := migrate(db, &domain.SeatSegment{}); err != nil {
+		return nil, err
+	}
+	if err := migrate(db, &domain.SeatHold{}); err != nil {
+		return nil, err
+	}
+	if err := migrate(db, &domain.Timetable{}); err != nil {
+		return nil, err
+	}
+	if err := migrate(db, &domain.TimetableStop{}); err != nil {
+		return nil, err
+	}
+	if err := migrate(db, &domain.TimetableException{}); err != nil {
+		return nil, err
+	}
+	if err := backfillStops(db); err != nil {
+		return nil, err
+	}
 
 	return db, nil
 }
@@ -31,3 +67,33 @@ func migrate(db *gorm.DB, model any) error {
 
 	return nil
 }
+
+// dropTimetableRunIndex drops the unique index on the runs of a timetable
+// from before retired runs were kept, which also covered them.
+func dropTimetableRunIndex(db *gorm.DB) error {
+	if !db.Migrator().HasIndex(&domain.Train{}, "idx_train_timetable_run") {
+		return nil
+	}
+
+	if err := db.Migrator().DropIndex(&domain.Train{}, "idx_train_timetable_run"); err != nil {
+		return fmt.Errorf("db migration error: %v", err)
+	}
+
+	return nil
+}
+
+// backfillStops gives trains from before multi-stop routes a route of their
+// origin and destination, which is what searches match stations against.
+func backfillStops(db *gorm.DB) error {
+	err := db.Exec(`INSERT INTO stops (train_id, sequence, station, arrival_time, departure_time)
+		SELECT id, 0, origin, departure_time, departure_time FROM trains
+		WHERE origin <> '' AND NOT EXISTS (SELECT 1 FROM stops WHERE stops.train_id = trains.id)
+		UNION ALL
+		SELECT id, 1, destination, arrival_time, arrival_time FROM trains
+		WHERE origin <> '' AND NOT EXISTS (SELECT 1 FROM stops WHERE stops.train_id = trains.id)`).Error
+	if err != nil {
+		return fmt.Errorf("db migration error: backfill stops: %v", err)
+	}
+
+	return nil
+}
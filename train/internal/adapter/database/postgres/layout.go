@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"train/internal/application/core/domain"
+
+	"gorm.io/gorm"
+)
+
+func orderCoaches(db *gorm.DB) *gorm.DB {
+	return db.Order("number")
+}
+
+func orderSeatTemplates(db *gorm.DB) *gorm.DB {
+	return db.Order("grid_row, grid_column")
+}
+
+// CreateLayoutTemplate creates the template with its coaches and their
+// seats.
+func (r *PostgresDBAdapter) CreateLayoutTemplate(ctx context.Context, layout *domain.LayoutTemplate) error {
+	err := r.db.WithContext(ctx).Create(layout).Error
+	if err != nil {
+		return fmt.Errorf("failed to create layout template: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresDBAdapter) GetLayoutTemplateByID(ctx context.Context, ID uint) (*domain.LayoutTemplate, error) {
+	var layout domain.LayoutTemplate
+
+	err := r.db.WithContext(ctx).
+		Preload("Coaches", orderCoaches).
+		Preload("Coaches.Seats", orderSeatTemplates).
+		First(&layout, ID).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get layout template by ID: %w", err)
+	}
+
+	return &layout, nil
+}
+
+func (r *PostgresDBAdapter) ListLayoutTemplates(ctx context.Context) ([]domain.LayoutTemplate, error) {
+	var layouts []domain.LayoutTemplate
+
+	err := r.db.WithContext(ctx).
+		Preload("Coaches", orderCoaches).
+		Preload("Coaches.Seats", orderSeatTemplates).
+		Order("id").
+		Find(&layouts).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list layout templates: %w", err)
+	}
+
+	return layouts, nil
+}
@@ -3,12 +3,17 @@ package postgres
 import (
 	"context"
 	"fmt"
-	"strings"
+	"time"
 	"train/internal/application/core/domain"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// Seats are free on the whole route while neither booked nor held on any
+// part of it.
+const freeSeatsCondition = "booked = false AND NOT EXISTS (SELECT 1 FROM seat_holds WHERE seat_holds.seat_id = seats.id AND seat_holds.held_until >= ?)"
+
 type PostgresDBAdapter struct {
 	db *gorm.DB
 }
@@ -19,19 +24,49 @@ func NewPostgresDBAdapter(db *gorm.DB) *PostgresDBAdapter {
 	}
 }
 
+// CreateTrain creates the train with its seats and stops, and its coaches
+// with the seats laid out in them.
 func (r *PostgresDBAdapter) CreateTrain(ctx context.Context, train *domain.Train) error {
 	train.AvailableSeats = train.Capacity
 
-	if err := r.db.WithContext(ctx).Create(train).Error; err != nil {
-		return fmt.Errorf("failed to create train: %w", err)
-	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Coaches").Create(train).Error; err != nil {
+			return fmt.Errorf("failed to create train: %w", err)
+		}
 
-	return nil
+		for i := range train.Coaches {
+			coach := &train.Coaches[i]
+			coach.TrainID = train.ID
+
+			if err := tx.Create(coach).Error; err != nil {
+				return fmt.Errorf("failed to create coach: train ID:%d %w", train.ID, err)
+			}
+
+			if len(coach.Seats) == 0 {
+				continue
+			}
+
+			for j := range coach.Seats {
+				coach.Seats[j].TrainID = train.ID
+				coach.Seats[j].CoachID = coach.ID
+			}
+
+			if err := tx.Create(&coach.Seats).Error; err != nil {
+				return fmt.Errorf("failed to create coach seats: train ID:%d %w", train.ID, err)
+			}
+		}
+
+		return nil
+	})
 }
 
 func (r *PostgresDBAdapter) GetTrainByID(ctx context.Context, ID uint) (*domain.Train, error) {
 	var train domain.Train
-	err := r.db.WithContext(ctx).Preload("Seats", "booked = false").First(&train, ID).Error
+	err := r.db.WithContext(ctx).
+		Preload("Seats", freeSeatsCondition+" AND "+unsegmentedSeatsCondition, time.Now()).
+		Preload("Stops", orderStops).
+		Preload("Coaches", orderCoaches).
+		First(&train, ID).Error
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get train by ID: %w", err)
@@ -49,61 +84,157 @@ func (r *PostgresDBAdapter) ListTrains(ctx context.Context) ([]domain.Train, err
 	return trains, nil
 }
 
-func (r *PostgresDBAdapter) ListTrainsFiltered(ctx context.Context, filters *domain.TrainFilters) ([]domain.Train, error) {
-	var trains []domain.Train
+// trainSortColumn is the expression the sort of a train search orders by,
+// in the unit of domain.TrainSort.Key, given the departure and arrival
+// columns of the searched segment.
+func trainSortColumn(sort domain.TrainSort, departure, arrival string) string {
+	switch sort.Field() {
+	case domain.SortByDuration:
+		return fmt.Sprintf("(EXTRACT(EPOCH FROM %s - %s) * 1000000)", arrival, departure)
+	default:
+		return departure
+	}
+}
 
-	query := r.db.WithContext(ctx).Model(&domain.Train{})
+// ListTrainsFiltered lists a page of the trains in service matching every
+// filter, fetching one train more than the page holds to tell whether
+// another page follows. Pages continue after the cursor in the order of the
+// sort.
+//
+// Origin and destination match any stops of the route in travel order,
+// the time windows, sort and seats then apply to the segment between them
+// and the trains are listed with the travel details of that segment.
+func (r *PostgresDBAdapter) ListTrainsFiltered(ctx context.Context, filters *domain.TrainFilters) (*domain.TrainPage, error) {
+	var trains []domain.Train
 
-	var conditions []string
-	var args []interface{}
+	query := r.db.WithContext(ctx).Model(&domain.Train{}).Select("trains.*").Where("trains.retired_at IS NULL")
+	departure, arrival := "trains.departure_time", "trains.arrival_time"
 
 	if filters.Name != "" {
-		conditions = append(conditions, "name LIKE ?")
-		args = append(args, "%"+filters.Name+"%")
+		query = query.Where("trains.name ILIKE ?", "%"+filters.Name+"%")
 	}
 
 	if filters.Origin != "" {
-		conditions = append(conditions, "destination = ?")
-		args = append(args, filters.Destination)
+		query = query.Joins("JOIN stops AS origin_stop ON origin_stop.train_id = trains.id AND LOWER(origin_stop.station) = LOWER(?)", filters.Origin)
+		departure = "origin_stop.departure_time"
 	}
 
 	if filters.Destination != "" {
-		conditions = append(conditions, "destination = ?")
-		args = append(args, filters.Destination)
+		query = query.Joins("JOIN stops AS destination_stop ON destination_stop.train_id = trains.id AND LOWER(destination_stop.station) = LOWER(?)", filters.Destination)
+		arrival = "destination_stop.arrival_time"
+	}
+
+	segmented := filters.Origin != "" && filters.Destination != ""
+	if segmented {
+		query = query.Where("destination_stop.sequence > origin_stop.sequence")
+	}
+
+	// Trains need free seats of the class with the attributes asked for,
+	// at least one when no number is given
+	filtersSeats := filters.Class != "" || filters.SeatAttributes != 0
+
+	if filters.MinAvailableSeats != 0 && !segmented && !filtersSeats {
+		query = query.Where("trains.available_seats >= ?", filters.MinAvailableSeats)
+	} else if filters.MinAvailableSeats != 0 || filtersSeats {
+		freeSeats, args := "SELECT COUNT(*) FROM seats WHERE seats.train_id = trains.id AND seats.booked = false", []any{}
+
+		if filters.Class != "" {
+			freeSeats += " AND seats.class = ?"
+			args = append(args, filters.Class)
+		}
+
+		if filters.SeatAttributes != 0 {
+			freeSeats += " AND seats.attributes & ? = ?"
+			args = append(args, filters.SeatAttributes, filters.SeatAttributes)
+		}
+
+		if segmented {
+			freeSeats += " AND NOT EXISTS (SELECT 1 FROM seat_segments WHERE seat_segments.seat_id = seats.id " +
+				"AND seat_segments.from_stop < destination_stop.sequence AND seat_segments.to_stop > origin_stop.sequence)"
+		} else {
+			freeSeats += " AND " + unsegmentedSeatsCondition
+		}
+
+		query = query.Where("("+freeSeats+") >= ?", append(args, max(filters.MinAvailableSeats, 1))...)
+	}
+
+	if !filters.DepartureFrom.IsZero() {
+		query = query.Where(departure+" >= ?", filters.DepartureFrom)
+	}
+
+	if !filters.DepartureTo.IsZero() {
+		query = query.Where(departure+" < ?", filters.DepartureTo)
 	}
 
-	if filters.AvailableSeats != 0 {
-		conditions = append(conditions, "available_seats >= ?")
-		args = append(args, filters.AvailableSeats)
+	if !filters.ArrivalFrom.IsZero() {
+		query = query.Where(arrival+" >= ?", filters.ArrivalFrom)
 	}
 
-	if !filters.DepartureTime.IsZero() {
-		conditions = append(conditions, "departure_time = ?")
-		args = append(args, filters.DepartureTime)
+	if !filters.ArrivalTo.IsZero() {
+		query = query.Where(arrival+" < ?", filters.ArrivalTo)
 	}
 
-	if !filters.ArrivalTime.IsZero() {
-		conditions = append(conditions, "arrival_time = ?")
-		args = append(args, filters.ArrivalTime)
+	column, direction, comparison := trainSortColumn(filters.Sort, departure, arrival), "ASC", ">"
+	if filters.Sort.Descending() {
+		direction, comparison = "DESC", "<"
 	}
 
-	if len(conditions) > 0 {
-		query = query.Where(strings.Join(conditions, " OR "), args...)
+	if filters.Cursor != "" {
+		cursor, err := domain.DecodeTrainCursor(filters.Cursor, filters.Sort)
+		if err != nil {
+			return nil, err
+		}
+
+		var key any = cursor.Key
+		if filters.Sort.Field() == domain.SortByDeparture {
+			key = time.Unix(0, cursor.Key)
+		}
+
+		query = query.Where(fmt.Sprintf("(%s, trains.id) %s (?, ?)", column, comparison), key, cursor.ID)
 	}
 
-	query = query.Preload("Seats", "booked = false")
+	searchesSegment := filters.Origin != "" || filters.Destination != ""
+	if !searchesSegment {
+		query = query.Preload("Seats", freeSeatsCondition+" AND "+unsegmentedSeatsCondition, time.Now())
+	}
 
-	err := query.Find(&trains).Error
+	err := query.Preload("Stops", orderStops).
+		Order(fmt.Sprintf("%s %s, trains.id %s", column, direction, direction)).
+		Limit(filters.Limit + 1).
+		Find(&trains).Error
 	if err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("no train available")
+		return nil, fmt.Errorf("failed to search trains: %w", err)
+	}
 
+	for i := range trains {
+		train := &trains[i]
+
+		if !searchesSegment {
+			train.Segment = train.FullRoute()
+			continue
 		}
-		return nil, fmt.Errorf("search trains: args:  %v\n: %w", args, err)
 
+		segment, err := train.FindSegment(filters.Origin, filters.Destination)
+		if err != nil {
+			return nil, err
+		}
+
+		train.ApplySegment(segment)
+
+		train.Seats, err = r.ListFreeSeatsBySegment(ctx, train.ID, segment)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	return trains, nil
+	page := &domain.TrainPage{Trains: trains}
+
+	if len(trains) > filters.Limit {
+		page.Trains = trains[:filters.Limit]
+		page.NextCursor = domain.NewTrainCursor(filters.Sort, &page.Trains[filters.Limit-1]).Encode()
+	}
+
+	return page, nil
 }
 
 func (r *PostgresDBAdapter) IsTrainAvailable(ctx context.Context, ID uint) (bool, error) {
@@ -126,23 +257,23 @@ func (r *PostgresDBAdapter) UpdateTrain(ctx context.Context, ID uint, name strin
 	return nil
 }
 
-func (r *PostgresDBAdapter) UpdateTrainTravelDetails(ctx context.Context, ID uint, travelDetails *domain.TrainTravelDetails) error {
-	train, err := r.GetTrainByID(ctx, ID)
-	if err != nil {
-		return err
-	}
-
-	train.Destination = travelDetails.Destination
-	train.Origin = travelDetails.Origin
-	train.DepartureTime = travelDetails.DepartureTime
-	train.ArrivalTime = travelDetails.ArrivalTime
-
-	err = r.db.WithContext(ctx).Save(&train).Error
-	if err != nil {
-		return fmt.Errorf("failed to update train travel details: %w", err)
-	}
+// UpdateTrainTravelDetails updates the travel details of the train together
+// with its route, whose ends are the origin and destination.
+func (r *PostgresDBAdapter) UpdateTrainTravelDetails(ctx context.Context, ID uint, travelDetails *domain.TrainTravelDetails, stops []domain.Stop) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&domain.Train{}).Where("id = ?", ID).Updates(map[string]any{
+			"origin":         travelDetails.Origin,
+			"destination":    travelDetails.Destination,
+			"departure_time": travelDetails.DepartureTime,
+			"arrival_time":   travelDetails.ArrivalTime,
+			"price":          travelDetails.Price,
+		}).Error
+		if err != nil {
+			return fmt.Errorf("failed to update train travel details: %w", err)
+		}
 
-	return nil
+		return replaceStops(tx, ID, stops)
+	})
 }
 
 func (r *PostgresDBAdapter) MinusTrainAvailableSeats(ctx context.Context, ID uint) error {
@@ -232,6 +363,104 @@ func (r *PostgresDBAdapter) UpdateSeatUser(ctx context.Context, seatID,userID ui
 	return nil
 }
 
+// HoldSeats holds the seats for the user on the segment until heldUntil.
+// Every seat has to be free on the segment, neither booked nor held by
+// someone else on an overlapping part of the route. Holds the user already
+// has there are replaced.
+func (r *PostgresDBAdapter) HoldSeats(ctx context.Context, trainID, userID uint, seatIDs []uint, segment domain.Segment, heldUntil time.Time) ([]domain.Seat, error) {
+	var seats []domain.Seat
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Locking the seats keeps concurrent holds from both finding them free
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ? AND train_id = ?", seatIDs, trainID).
+			Order("id").
+			Find(&seats).Error
+		if err != nil {
+			return fmt.Errorf("failed to lock seats: train ID:%d %w", trainID, err)
+		}
+
+		var free int64
+
+		err = tx.Model(&domain.Seat{}).
+			Where("id IN ? AND train_id = ? AND booked = false", seatIDs, trainID).
+			Where(segmentFreeSeatsCondition, segment.ToStop, segment.FromStop).
+			Where("NOT EXISTS (SELECT 1 FROM seat_holds WHERE seat_holds.seat_id = seats.id AND seat_holds.user_id <> ? AND seat_holds.held_until >= ? AND seat_holds.from_stop < ? AND seat_holds.to_stop > ?)",
+				userID, time.Now(), segment.ToStop, segment.FromStop).
+			Count(&free).Error
+		if err != nil {
+			return fmt.Errorf("failed to count free seats: train ID:%d %w", trainID, err)
+		}
+
+		if free != int64(len(seatIDs)) {
+			return fmt.Errorf("seat conflict: %d of %d seats are no longer free", len(seatIDs)-int(free), len(seatIDs))
+		}
+
+		err = tx.Where("seat_id IN ? AND user_id = ? AND from_stop < ? AND to_stop > ?", seatIDs, userID, segment.ToStop, segment.FromStop).
+			Delete(&domain.SeatHold{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to replace seat holds: train ID:%d %w", trainID, err)
+		}
+
+		holds := make([]domain.SeatHold, 0, len(seats))
+		for i := range seats {
+			holds = append(holds, domain.SeatHold{
+				SeatID:    seats[i].ID,
+				TrainID:   trainID,
+				UserID:    userID,
+				Segment:   segment,
+				HeldUntil: heldUntil,
+			})
+
+			seats[i].HeldBy = userID
+			seats[i].HeldUntil = &heldUntil
+		}
+
+		err = tx.Create(&holds).Error
+		if err != nil {
+			return fmt.Errorf("failed to hold seats: train ID:%d %w", trainID, err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return seats, nil
+}
+
+// ReleaseSeatHold releases the holds of the user on the seat that overlap
+// the segment.
+func (r *PostgresDBAdapter) ReleaseSeatHold(ctx context.Context, seatID, userID uint, segment domain.Segment) error {
+	err := r.db.WithContext(ctx).
+		Where("seat_id = ? AND user_id = ? AND from_stop < ? AND to_stop > ?", seatID, userID, segment.ToStop, segment.FromStop).
+		Delete(&domain.SeatHold{}).Error
+
+	if err != nil {
+		return fmt.Errorf("failed to release seat hold: seat ID:%d %w", seatID, err)
+	}
+
+	return nil
+}
+
+// IsSeatSegmentHeld reports whether another user holds the seat on part of
+// the segment.
+func (r *PostgresDBAdapter) IsSeatSegmentHeld(ctx context.Context, seatID, userID uint, segment domain.Segment) (bool, error) {
+	var holds int64
+
+	err := r.db.WithContext(ctx).Model(&domain.SeatHold{}).
+		Where("seat_id = ? AND user_id <> ? AND held_until >= ?", seatID, userID, time.Now()).
+		Where("from_stop < ? AND to_stop > ?", segment.ToStop, segment.FromStop).
+		Count(&holds).Error
+	if err != nil {
+		return false, fmt.Errorf("count seat holds: seat ID:%d %w", seatID, err)
+	}
+
+	return holds != 0, nil
+}
+
 func (r *PostgresDBAdapter) GetSeatByID(ctx context.Context, id uint) (*domain.Seat, error) {
 	seat := &domain.Seat{}
 
@@ -251,9 +480,43 @@ func (r *PostgresDBAdapter) ListSeatsByTrainID(ctx context.Context, trainID uint
 		return nil, fmt.Errorf("list seats by train ID: %w", err)
 	}
 
+	// A seat held on any part of the route is held on the whole of it
+	err = markHeldSeats(r.db.WithContext(ctx).Where("train_id = ?", trainID), seats)
+	if err != nil {
+		return nil, err
+	}
+
 	return seats, nil
 }
 
+// markHeldSeats sets the holder and expiry of the seats with one of the
+// active holds the query finds.
+func markHeldSeats(query *gorm.DB, seats []domain.Seat) error {
+	var holds []domain.SeatHold
+
+	err := query.Where("held_until >= ?", time.Now()).Find(&holds).Error
+	if err != nil {
+		return fmt.Errorf("list seat holds: %w", err)
+	}
+
+	held := make(map[uint]domain.SeatHold, len(holds))
+	for _, hold := range holds {
+		held[hold.SeatID] = hold
+	}
+
+	for i := range seats {
+		hold, ok := held[seats[i].ID]
+		if !ok {
+			continue
+		}
+
+		seats[i].HeldBy = hold.UserID
+		seats[i].HeldUntil = &hold.HeldUntil
+	}
+
+	return nil
+}
+
 func (r *PostgresDBAdapter) UpdateSeatBookingStatus(ctx context.Context, seatID uint, booked bool) error {
 	err := r.db.WithContext(ctx).Model(&domain.Seat{}).Where("id = ?", seatID).Update("booked", booked).Error
 
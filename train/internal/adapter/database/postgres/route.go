@@ -0,0 +1,208 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"train/internal/application/core/domain"
+
+	"gorm.io/gorm"
+)
+
+// Seats booked on part of the route are not free on the whole route, and
+// not free on a segment when the booked part overlaps it. Holds on a
+// segment keep the seat from overlapping segments the same way.
+const (
+	unsegmentedSeatsCondition   = "NOT EXISTS (SELECT 1 FROM seat_segments WHERE seat_segments.seat_id = seats.id)"
+	segmentFreeSeatsCondition   = "NOT EXISTS (SELECT 1 FROM seat_segments WHERE seat_segments.seat_id = seats.id AND seat_segments.from_stop < ? AND seat_segments.to_stop > ?)"
+	segmentUnheldSeatsCondition = "NOT EXISTS (SELECT 1 FROM seat_holds WHERE seat_holds.seat_id = seats.id AND seat_holds.held_until >= ? AND seat_holds.from_stop < ? AND seat_holds.to_stop > ?)"
+)
+
+func orderStops(db *gorm.DB) *gorm.DB {
+	return db.Order("sequence")
+}
+
+// UpdateTrainStops replaces the route of the train and moves its origin and
+// destination to the ends of the route. A route can only change while none
+// of its seats are booked.
+func (r *PostgresDBAdapter) UpdateTrainStops(ctx context.Context, trainID uint, stops []domain.Stop) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var bookings int64
+
+		err := tx.Model(&domain.Seat{}).
+			Where("train_id = ? AND (booked = true OR EXISTS (SELECT 1 FROM seat_segments WHERE seat_segments.seat_id = seats.id))", trainID).
+			Count(&bookings).Error
+		if err != nil {
+			return fmt.Errorf("failed to count seat bookings: train ID:%d %w", trainID, err)
+		}
+
+		if bookings != 0 {
+			return domain.ErrRouteHasBookings
+		}
+
+		travelDetails := domain.TravelDetailsOfRoute(stops)
+
+		err = tx.Model(&domain.Train{}).Where("id = ?", trainID).Updates(map[string]any{
+			"origin":         travelDetails.Origin,
+			"destination":    travelDetails.Destination,
+			"departure_time": travelDetails.DepartureTime,
+			"arrival_time":   travelDetails.ArrivalTime,
+		}).Error
+		if err != nil {
+			return fmt.Errorf("failed to update train travel details: train ID:%d %w", trainID, err)
+		}
+
+		return replaceStops(tx, trainID, stops)
+	})
+}
+
+func replaceStops(tx *gorm.DB, trainID uint, stops []domain.Stop) error {
+	err := tx.Where("train_id = ?", trainID).Delete(&domain.Stop{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete stops: train ID:%d %w", trainID, err)
+	}
+
+	for i := range stops {
+		stops[i].ID = 0
+		stops[i].TrainID = trainID
+	}
+
+	err = tx.Create(&stops).Error
+	if err != nil {
+		return fmt.Errorf("failed to create stops: train ID:%d %w", trainID, err)
+	}
+
+	return nil
+}
+
+// ListFreeSeatsBySegment lists the seats that are neither booked nor held
+// on any part of the segment.
+func (r *PostgresDBAdapter) ListFreeSeatsBySegment(ctx context.Context, trainID uint, segment domain.Segment) ([]domain.Seat, error) {
+	var seats []domain.Seat
+
+	err := r.db.WithContext(ctx).
+		Where("train_id = ? AND booked = false", trainID).
+		Where(segmentUnheldSeatsCondition, time.Now(), segment.ToStop, segment.FromStop).
+		Where(segmentFreeSeatsCondition, segment.ToStop, segment.FromStop).
+		Order("seat_number").
+		Find(&seats).Error
+	if err != nil {
+		return nil, fmt.Errorf("list free seats by segment: train ID:%d %w", trainID, err)
+	}
+
+	return seats, nil
+}
+
+// ListSeatsBySegment lists every seat of the train, marking the ones booked
+// on any part of the segment as booked and the ones held there as held.
+func (r *PostgresDBAdapter) ListSeatsBySegment(ctx context.Context, trainID uint, segment domain.Segment) ([]domain.Seat, error) {
+	var seats []domain.Seat
+
+	err := r.db.WithContext(ctx).Where("train_id = ?", trainID).Find(&seats).Error
+	if err != nil {
+		return nil, fmt.Errorf("list seats by train ID: %w", err)
+	}
+
+	err = markHeldSeats(r.db.WithContext(ctx).Where("train_id = ? AND from_stop < ? AND to_stop > ?", trainID, segment.ToStop, segment.FromStop), seats)
+	if err != nil {
+		return nil, err
+	}
+
+	var bookedSeatIDs []uint
+
+	err = r.db.WithContext(ctx).Model(&domain.SeatSegment{}).
+		Where("train_id = ? AND from_stop < ? AND to_stop > ?", trainID, segment.ToStop, segment.FromStop).
+		Pluck("seat_id", &bookedSeatIDs).Error
+	if err != nil {
+		return nil, fmt.Errorf("list seat segments: train ID:%d %w", trainID, err)
+	}
+
+	booked := make(map[uint]bool, len(bookedSeatIDs))
+	for _, seatID := range bookedSeatIDs {
+		booked[seatID] = true
+	}
+
+	for i := range seats {
+		seats[i].Booked = seats[i].Booked || booked[seats[i].ID]
+	}
+
+	return seats, nil
+}
+
+// IsSeatSegmentBooked reports whether the seat is booked on part of the
+// segment.
+func (r *PostgresDBAdapter) IsSeatSegmentBooked(ctx context.Context, seatID uint, segment domain.Segment) (bool, error) {
+	var bookings int64
+
+	err := r.db.WithContext(ctx).Model(&domain.SeatSegment{}).
+		Where("seat_id = ? AND from_stop < ? AND to_stop > ?", seatID, segment.ToStop, segment.FromStop).
+		Count(&bookings).Error
+	if err != nil {
+		return false, fmt.Errorf("count seat segments: seat ID:%d %w", seatID, err)
+	}
+
+	return bookings != 0, nil
+}
+
+func (r *PostgresDBAdapter) CreateSeatSegment(ctx context.Context, seatSegment *domain.SeatSegment) error {
+	err := r.db.WithContext(ctx).Create(seatSegment).Error
+	if err != nil {
+		return fmt.Errorf("failed to book seat segment: seat ID:%d %w", seatSegment.SeatID, err)
+	}
+
+	return nil
+}
+
+// DeleteSeatSegment cancels the booking of the seat on the segment, only
+// when it is booked by the user unless userID is zero.
+func (r *PostgresDBAdapter) DeleteSeatSegment(ctx context.Context, seatID uint, segment domain.Segment, userID uint) error {
+	query := r.db.WithContext(ctx).Where("seat_id = ? AND from_stop = ? AND to_stop = ?", seatID, segment.FromStop, segment.ToStop)
+	if userID != 0 {
+		query = query.Where("user_id = ?", userID)
+	}
+
+	err := query.Delete(&domain.SeatSegment{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to cancel seat segment: seat ID:%d %w", seatID, err)
+	}
+
+	return nil
+}
+
+func (r *PostgresDBAdapter) UpdateSeatSegmentUser(ctx context.Context, seatID uint, segment domain.Segment, userID uint) error {
+	result := r.db.WithContext(ctx).Model(&domain.SeatSegment{}).
+		Where("seat_id = ? AND from_stop = ? AND to_stop = ?", seatID, segment.FromStop, segment.ToStop).
+		Update("user_id", userID)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update seat segment user: seat ID:%d %w", seatID, result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("seat with ID %d is not booked from stop %d to %d", seatID, segment.FromStop, segment.ToStop)
+	}
+
+	return nil
+}
+
+// RecountTrainAvailableSeats counts the seats of the train that are free on
+// the whole route again, after a seat was booked or released on a segment.
+func (r *PostgresDBAdapter) RecountTrainAvailableSeats(ctx context.Context, trainID uint) error {
+	var available int64
+
+	err := r.db.WithContext(ctx).Model(&domain.Seat{}).
+		Where("train_id = ? AND booked = false AND "+unsegmentedSeatsCondition, trainID).
+		Count(&available).Error
+	if err != nil {
+		return fmt.Errorf("failed to count available seats: train ID:%d %w", trainID, err)
+	}
+
+	err = r.db.WithContext(ctx).Model(&domain.Train{}).Where("id = ?", trainID).Updates(map[string]any{
+		"available_seats": available,
+		"is_full":         available == 0,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to update train available seats: train ID:%d %w", trainID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"train/internal/application/core/domain"
+
+	"gorm.io/gorm"
+)
+
+// A run has bookings while any of its seats is booked on the whole route,
+// or booked or held on any part of it.
+const unbookedRunCondition = "NOT EXISTS (SELECT 1 FROM seats WHERE seats.train_id = trains.id AND seats.booked = true) " +
+	"AND NOT EXISTS (SELECT 1 FROM seat_holds WHERE seat_holds.train_id = trains.id AND seat_holds.held_until >= ?) " +
+	"AND NOT EXISTS (SELECT 1 FROM seat_segments WHERE seat_segments.train_id = trains.id)"
+
+func orderExceptions(db *gorm.DB) *gorm.DB {
+	return db.Order("date")
+}
+
+func (r *PostgresDBAdapter) CreateTimetable(ctx context.Context, timetable *domain.Timetable) error {
+	err := r.db.WithContext(ctx).Create(timetable).Error
+	if err != nil {
+		return fmt.Errorf("failed to create timetable: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresDBAdapter) GetTimetableByID(ctx context.Context, ID uint) (*domain.Timetable, error) {
+	var timetable domain.Timetable
+
+	err := r.db.WithContext(ctx).
+		Preload("Stops", orderStops).
+		Preload("Exceptions", orderExceptions).
+		First(&timetable, ID).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get timetable by ID: %w", err)
+	}
+
+	return &timetable, nil
+}
+
+func (r *PostgresDBAdapter) ListTimetables(ctx context.Context) ([]domain.Timetable, error) {
+	var timetables []domain.Timetable
+
+	err := r.db.WithContext(ctx).
+		Preload("Stops", orderStops).
+		Preload("Exceptions", orderExceptions).
+		Order("id").
+		Find(&timetables).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list timetables: %w", err)
+	}
+
+	return timetables, nil
+}
+
+// UpdateTimetable replaces the service pattern of the timetable together
+// with its stops and exceptions. Runs already generated are left as they
+// are.
+func (r *PostgresDBAdapter) UpdateTimetable(ctx context.Context, timetable *domain.Timetable) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&domain.Timetable{}).Where("id = ?", timetable.ID).Updates(map[string]any{
+			"name":               timetable.Name,
+			"capacity":           timetable.Capacity,
+			"layout_template_id": timetable.LayoutTemplateID,
+			"price":              timetable.Price,
+			"time_zone":          timetable.TimeZone,
+			"weekdays":           timetable.Weekdays,
+			"valid_from":         timetable.ValidFrom,
+			"valid_until":        timetable.ValidUntil,
+		})
+		if result.Error != nil {
+			return fmt.Errorf("failed to update timetable: timetable ID:%d %w", timetable.ID, result.Error)
+		}
+
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("timetable not found with ID: %d", timetable.ID)
+		}
+
+		err := tx.Where("timetable_id = ?", timetable.ID).Delete(&domain.TimetableStop{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete timetable stops: timetable ID:%d %w", timetable.ID, err)
+		}
+
+		err = tx.Where("timetable_id = ?", timetable.ID).Delete(&domain.TimetableException{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete timetable exceptions: timetable ID:%d %w", timetable.ID, err)
+		}
+
+		for i := range timetable.Stops {
+			timetable.Stops[i].ID = 0
+			timetable.Stops[i].TimetableID = timetable.ID
+		}
+
+		err = tx.Create(&timetable.Stops).Error
+		if err != nil {
+			return fmt.Errorf("failed to create timetable stops: timetable ID:%d %w", timetable.ID, err)
+		}
+
+		if len(timetable.Exceptions) == 0 {
+			return nil
+		}
+
+		for i := range timetable.Exceptions {
+			timetable.Exceptions[i].ID = 0
+			timetable.Exceptions[i].TimetableID = timetable.ID
+		}
+
+		err = tx.Create(&timetable.Exceptions).Error
+		if err != nil {
+			return fmt.Errorf("failed to create timetable exceptions: timetable ID:%d %w", timetable.ID, err)
+		}
+
+		return nil
+	})
+}
+
+// ListTimetableRunDates lists the service dates from the given one on that
+// the timetable already has a run in service for.
+func (r *PostgresDBAdapter) ListTimetableRunDates(ctx context.Context, timetableID uint, from time.Time) ([]time.Time, error) {
+	var dates []time.Time
+
+	err := r.db.WithContext(ctx).Model(&domain.Train{}).
+		Where("timetable_id = ? AND service_date >= ? AND retired_at IS NULL", timetableID, from).
+		Pluck("service_date", &dates).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list timetable runs: timetable ID:%d %w", timetableID, err)
+	}
+
+	return dates, nil
+}
+
+// RetireUnbookedTimetableRuns retires the runs of the timetable departing
+// after the given time that nobody booked or holds a seat on yet. Seat
+// bookings reach this service after the ticket is sold, so a run is
+// retired rather than deleted and a booking still on its way keeps the
+// run it was made on.
+func (r *PostgresDBAdapter) RetireUnbookedTimetableRuns(ctx context.Context, timetableID uint, after time.Time) error {
+	now := time.Now()
+
+	err := r.db.WithContext(ctx).Model(&domain.Train{}).
+		Where("timetable_id = ? AND departure_time > ? AND retired_at IS NULL", timetableID, after).
+		Where(unbookedRunCondition, now).
+		Update("retired_at", now).Error
+	if err != nil {
+		return fmt.Errorf("failed to retire timetable runs: timetable ID:%d %w", timetableID, err)
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"train/internal/application/core/domain"
 	"train/utils"
 
 	"github.com/nats-io/nats.go"
@@ -16,14 +17,18 @@ type NatsEventConsumer struct {
 }
 
 type SeatHandlers interface {
-	SeatBooked(ctx context.Context, seatID, trainID,userID uint) error
-	CancelSeatBooking(ctx context.Context, seatID, trainID uint) error
+	SeatBooked(ctx context.Context, seatID, trainID,userID uint, segment domain.Segment) error
+	CancelSeatBooking(ctx context.Context, seatID, trainID, userID uint, segment domain.Segment) error
+	TransferSeatBooking(ctx context.Context, seatID, trainID, userID uint, segment domain.Segment) error
+	ReleaseSeatHold(ctx context.Context, seatID, trainID, userID uint, segment domain.Segment) error
 }
 
 const (
 	SeatStream                  = "SEAT"
 	SeatBookedSubjectName       = "seat.book.booked"
 	SeatBookCanceledSubjectName = "seat.book.canceled"
+	SeatTransferredSubjectName  = "seat.book.transferred"
+	SeatHoldReleasedSubjectName = "seat.hold.released"
 )
 
 var (
@@ -72,14 +77,14 @@ func (c *NatsEventConsumer) ConsumerSeatEvents(ctx context.Context) <-chan error
 			for msg := range msgs.Messages() {
 				switch msg.Subject() {
 				case SeatBookedSubjectName:
-					seat, err := utils.UnmarshalSeat(msg.Data())
+					seat, segment, err := utils.UnmarshalSeat(msg.Data())
 					if err != nil {
 						errorStream <- err
 						ack(msg, errorStream)
 						continue
 					}
 
-					err = c.SeatHandlers.SeatBooked(ctx, seat.ID, seat.TrainID,seat.UserID)
+					err = c.SeatHandlers.SeatBooked(ctx, seat.ID, seat.TrainID, seat.UserID, segment)
 					if err != nil {
 						errorStream <- err
 						ack(msg, errorStream)
@@ -89,14 +94,48 @@ func (c *NatsEventConsumer) ConsumerSeatEvents(ctx context.Context) <-chan error
 					ack(msg, errorStream)
 
 				case SeatBookCanceledSubjectName:
-					seat, err := utils.UnmarshalSeat(msg.Data())
+					seat, segment, err := utils.UnmarshalSeat(msg.Data())
 					if err != nil {
 						errorStream <- err
 						ack(msg, errorStream)
 						continue
 					}
 
-					err = c.CancelSeatBooking(ctx, seat.ID, seat.TrainID)
+					err = c.CancelSeatBooking(ctx, seat.ID, seat.TrainID, seat.UserID, segment)
+					if err != nil {
+						errorStream <- err
+						ack(msg, errorStream)
+						continue
+					}
+
+					ack(msg, errorStream)
+
+				case SeatTransferredSubjectName:
+					seat, segment, err := utils.UnmarshalSeat(msg.Data())
+					if err != nil {
+						errorStream <- err
+						ack(msg, errorStream)
+						continue
+					}
+
+					err = c.TransferSeatBooking(ctx, seat.ID, seat.TrainID, seat.UserID, segment)
+					if err != nil {
+						errorStream <- err
+						ack(msg, errorStream)
+						continue
+					}
+
+					ack(msg, errorStream)
+
+				case SeatHoldReleasedSubjectName:
+					seat, segment, err := utils.UnmarshalSeat(msg.Data())
+					if err != nil {
+						errorStream <- err
+						ack(msg, errorStream)
+						continue
+					}
+
+					err = c.ReleaseSeatHold(ctx, seat.ID, seat.TrainID, seat.UserID, segment)
 					if err != nil {
 						errorStream <- err
 						ack(msg, errorStream)
@@ -14,16 +14,30 @@ const (
 	SubjectRequestListTrains              = "request.list.trains"
 	SubjectRequestListTrainsFiltered      = "request.list.trains.filtered"
 	SubjectRequestGetTrainByID            = "request.get.train.byID"
+	SubjectRequestGetTrainSegment         = "request.get.train.segment"
 	SubjectRequestCreateTrain             = "request.create.train"
 	SubjectRequestUpdateTrain             = "request.update.train"
 	SubjectRequestUpdateTrainTravelDetail = "request.update.train.travel.detail"
+	SubjectRequestUpdateTrainStops        = "request.update.train.stops"
 	SubjectRequestDeleteTrainByID         = "request.delete.train.byID"
 
 	SubjectRequestGetSeatByID        = "request.get.seat.byID"
 	SubjectRequestListSeatsByTrainID = "request.list.seats.byTrainID"
+	SubjectRequestListSeatsBySegment = "request.list.seats.bySegment"
+	SubjectRequestGetSeatMap         = "request.get.train.seatmap"
 	SubjectRequestCreateSeat         = "request.create.seat"
 	SubjectRequestUpdateSeatNumber   = "request.update.seat.number"
 	SubjectRequestDeleteSeatByID     = "request.delete.seat.byID"
+	SubjectRequestHoldSeats          = "request.hold.seats"
+
+	SubjectRequestCreateLayoutTemplate  = "request.create.layoutTemplate"
+	SubjectRequestGetLayoutTemplateByID = "request.get.layoutTemplate.byID"
+	SubjectRequestListLayoutTemplates   = "request.list.layoutTemplates"
+
+	SubjectRequestCreateTimetable  = "request.create.timetable"
+	SubjectRequestGetTimetableByID = "request.get.timetable.byID"
+	SubjectRequestListTimetables   = "request.list.timetables"
+	SubjectRequestUpdateTimetable  = "request.update.timetable"
 )
 
 type TrainEventResponderAdapter struct {
@@ -78,17 +92,16 @@ func (r *TrainEventResponderAdapter) ReplyToListTrainsFiltered(ctx context.Conte
 			return
 		}
 
-		trains, err := r.APIAdapter.ListTrainsFiltered(ctx, filters)
+		page, err := r.APIAdapter.ListTrainsFiltered(ctx, filters)
 		if err != nil {
 			errString := fmt.Errorf("error list trains: %w", err).Error()
 			msg.Respond([]byte(errString))
 			return
 		}
 
-		serializedTrainsData, err := utils.MarshalListTrainsReplay(trains)
+		serializedTrainsData, err := utils.MarshalTrainPage(page)
 		if err != nil {
-			fmt.Println()
-			errString := fmt.Errorf("error serialize train: %v,%w", trains, err).Error()
+			errString := fmt.Errorf("error serialize train: %v,%w", page.Trains, err).Error()
 			msg.Respond([]byte(errString))
 			return
 		}
@@ -150,16 +163,54 @@ func (r *TrainEventResponderAdapter) ReplyToGetTrainByID(ctx context.Context) er
 	return nil
 }
 
+func (r *TrainEventResponderAdapter) ReplyToGetTrainSegment(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestGetTrainSegment, func(msg *nats.Msg) {
+		trainID, origin, destination, err := utils.UnmarshalTrainSegmentRequest(msg.Data)
+		if err != nil {
+			errString := fmt.Errorf("error unmarshal request(GetTrainSegment): %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		train, err := r.APIAdapter.GetTrainSegment(ctx, trainID, origin, destination)
+		if err != nil {
+			errString := fmt.Errorf("error get train segment: %d,%w", trainID, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		serializedTrainData, err := utils.MarshalTrain(train)
+		if err != nil {
+			errString := fmt.Errorf("error serialize train: %v,%w", train, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		msg.Respond(serializedTrainData)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
 func (r *TrainEventResponderAdapter) ReplyToCreateTrain(ctx context.Context) error {
 	subscription, err := r.natsConn.Subscribe(SubjectRequestCreateTrain, func(msg *nats.Msg) {
-		trainName, capacity, err := utils.UnmarshalCreateTrainRequest(msg.Data)
+		trainName, capacity, layoutTemplateID, err := utils.UnmarshalCreateTrainRequest(msg.Data)
 		if err != nil {
 			errString := fmt.Errorf("error unmarshal request(CreateTrain): %w", err).Error()
 			msg.Respond([]byte(errString))
 			return
 		}
 
-		if err := r.APIAdapter.CreateTrain(ctx, trainName, capacity); err != nil {
+		if err := r.APIAdapter.CreateTrain(ctx, trainName, capacity, layoutTemplateID); err != nil {
 			errString := fmt.Errorf("error create train: %w", err).Error()
 			msg.Respond([]byte(errString))
 			return
@@ -241,6 +292,37 @@ func (r *TrainEventResponderAdapter) ReplyToUpdateTrainTravelDetails(ctx context
 	return nil
 }
 
+func (r *TrainEventResponderAdapter) ReplyToUpdateTrainStops(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestUpdateTrainStops, func(msg *nats.Msg) {
+		trainID, stops, err := utils.UnmarshalUpdateTrainStopsRequest(msg.Data)
+		if err != nil {
+			errString := fmt.Errorf("error unmarshal request(UpdateTrainStops): %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		err = r.APIAdapter.UpdateTrainStops(ctx, trainID, stops)
+		if err != nil {
+			errString := fmt.Errorf("error update train stops: %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		msg.Respond([]byte("train stops updated successfully"))
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
 func (r *TrainEventResponderAdapter) ReplyToDeleteTrainByID(ctx context.Context) error {
 	subscription, err := r.natsConn.Subscribe(SubjectRequestDeleteTrainByID, func(msg *nats.Msg) {
 		trainIDstr := string(msg.Data)
@@ -314,6 +396,84 @@ func (r *TrainEventResponderAdapter) ReplyToListSeatsByTrainID(ctx context.Conte
 	return nil
 }
 
+func (r *TrainEventResponderAdapter) ReplyToListSeatsBySegment(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestListSeatsBySegment, func(msg *nats.Msg) {
+		trainID, segment, err := utils.UnmarshalListSegmentSeatsRequest(msg.Data)
+		if err != nil {
+			errString := fmt.Errorf("error unmarshal request(ListSeatsBySegment): %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		seats, err := r.APIAdapter.ListSeatsBySegment(ctx, trainID, segment)
+		if err != nil {
+			errString := fmt.Errorf("error list seats by segment: %d,%w", trainID, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		serializedSeatsData, err := utils.MarshalSeats(seats)
+		if err != nil {
+			errString := fmt.Errorf("error serialize seats: %v,%w", seats, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		msg.Respond(serializedSeatsData)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+// ReplyToGetSeatMap answers with every seat of the train in its coaches and
+// the state of each seat on the requested part of the route.
+func (r *TrainEventResponderAdapter) ReplyToGetSeatMap(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestGetSeatMap, func(msg *nats.Msg) {
+		trainID, origin, destination, err := utils.UnmarshalTrainSegmentRequest(msg.Data)
+		if err != nil {
+			errString := fmt.Errorf("error unmarshal request(GetSeatMap): %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		seatMap, err := r.APIAdapter.GetSeatMap(ctx, trainID, origin, destination)
+		if err != nil {
+			errString := fmt.Errorf("error get seat map: %d,%w", trainID, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		serializedSeatMapData, err := utils.MarshalSeatMap(seatMap)
+		if err != nil {
+			errString := fmt.Errorf("error serialize seat map: %d,%w", trainID, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		msg.Respond(serializedSeatMapData)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
 func (r *TrainEventResponderAdapter) ReplyToGetSeatByID(ctx context.Context) error {
 	subscription, err := r.natsConn.Subscribe(SubjectRequestGetSeatByID, func(msg *nats.Msg) {
 		seatIDstr := string(msg.Data)
@@ -357,14 +517,14 @@ func (r *TrainEventResponderAdapter) ReplyToGetSeatByID(ctx context.Context) err
 
 func (r *TrainEventResponderAdapter) ReplyToCreateSeat(ctx context.Context) error {
 	subscription, err := r.natsConn.Subscribe(SubjectRequestCreateSeat, func(msg *nats.Msg) {
-		trainID, seatNumber, err := utils.UnmarshalCreateSeatRequest(msg.Data)
+		trainID, seatNumber, class, err := utils.UnmarshalCreateSeatRequest(msg.Data)
 		if err != nil {
 			errString := fmt.Errorf("error unmarshal request(CreateSeat): %w", err).Error()
 			msg.Respond([]byte(errString))
 			return
 		}
 
-		if err := r.APIAdapter.CreateSeat(ctx, uint(trainID), uint(seatNumber)); err != nil {
+		if err := r.APIAdapter.CreateSeat(ctx, uint(trainID), uint(seatNumber), class); err != nil {
 			errString := fmt.Errorf("error create seat: %w", err).Error()
 			msg.Respond([]byte(errString))
 			return
@@ -446,3 +606,293 @@ func (r *TrainEventResponderAdapter) ReplyToDeleteSeatBySeatID(ctx context.Conte
 
 	return nil
 }
+
+func (r *TrainEventResponderAdapter) ReplyToHoldSeats(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestHoldSeats, func(msg *nats.Msg) {
+		trainID, userID, seatIDs, segment, heldUntil, err := utils.UnmarshalHoldSeatsRequest(msg.Data)
+		if err != nil {
+			errString := fmt.Errorf("error unmarshal request(HoldSeats): %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		seats, err := r.APIAdapter.HoldSeats(ctx, trainID, userID, seatIDs, segment, heldUntil)
+		if err != nil {
+			errString := fmt.Errorf("error hold seats: %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		serializedSeatsData, err := utils.MarshalSeats(seats)
+		if err != nil {
+			errString := fmt.Errorf("error serialize seats: %v,%w", seats, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		msg.Respond(serializedSeatsData)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TrainEventResponderAdapter) ReplyToCreateTimetable(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestCreateTimetable, func(msg *nats.Msg) {
+		timetable, err := utils.UnmarshalTimetable(msg.Data)
+		if err != nil {
+			errString := fmt.Errorf("error unmarshal request(CreateTimetable): %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		timetable, err = r.APIAdapter.CreateTimetable(ctx, timetable)
+		if err != nil {
+			errString := fmt.Errorf("error create timetable: %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		serializedTimetableData, err := utils.MarshalTimetable(timetable)
+		if err != nil {
+			errString := fmt.Errorf("error serialize timetable: %v,%w", timetable, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		msg.Respond(serializedTimetableData)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TrainEventResponderAdapter) ReplyToGetTimetableByID(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestGetTimetableByID, func(msg *nats.Msg) {
+		timetableID, err := strconv.Atoi(string(msg.Data))
+		if err != nil {
+			errString := fmt.Errorf("error invalid timetableID: %s", msg.Data).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		timetable, err := r.APIAdapter.GetTimetableByID(ctx, uint(timetableID))
+		if err != nil {
+			errString := fmt.Errorf("error get timetable by ID: %d,%w", timetableID, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		serializedTimetableData, err := utils.MarshalTimetable(timetable)
+		if err != nil {
+			errString := fmt.Errorf("error serialize timetable: %v,%w", timetable, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		msg.Respond(serializedTimetableData)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TrainEventResponderAdapter) ReplyToListTimetables(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestListTimetables, func(msg *nats.Msg) {
+		timetables, err := r.APIAdapter.ListTimetables(ctx)
+		if err != nil {
+			errString := fmt.Errorf("error list timetables: %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		serializedTimetablesData, err := utils.MarshalTimetables(timetables)
+		if err != nil {
+			errString := fmt.Errorf("error serialize timetables: %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		msg.Respond(serializedTimetablesData)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TrainEventResponderAdapter) ReplyToUpdateTimetable(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestUpdateTimetable, func(msg *nats.Msg) {
+		timetable, err := utils.UnmarshalTimetable(msg.Data)
+		if err != nil {
+			errString := fmt.Errorf("error unmarshal request(UpdateTimetable): %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		timetable, err = r.APIAdapter.UpdateTimetable(ctx, timetable)
+		if err != nil {
+			errString := fmt.Errorf("error update timetable: %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		serializedTimetableData, err := utils.MarshalTimetable(timetable)
+		if err != nil {
+			errString := fmt.Errorf("error serialize timetable: %v,%w", timetable, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		msg.Respond(serializedTimetableData)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TrainEventResponderAdapter) ReplyToCreateLayoutTemplate(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestCreateLayoutTemplate, func(msg *nats.Msg) {
+		layout, err := utils.UnmarshalLayoutTemplate(msg.Data)
+		if err != nil {
+			errString := fmt.Errorf("error unmarshal request(CreateLayoutTemplate): %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		layout, err = r.APIAdapter.CreateLayoutTemplate(ctx, layout)
+		if err != nil {
+			errString := fmt.Errorf("error create layout template: %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		serializedLayoutData, err := utils.MarshalLayoutTemplate(layout)
+		if err != nil {
+			errString := fmt.Errorf("error serialize layout template: %v,%w", layout, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		msg.Respond(serializedLayoutData)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TrainEventResponderAdapter) ReplyToGetLayoutTemplateByID(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestGetLayoutTemplateByID, func(msg *nats.Msg) {
+		layoutID, err := strconv.Atoi(string(msg.Data))
+		if err != nil {
+			errString := fmt.Errorf("error invalid layoutTemplateID: %s", msg.Data).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		layout, err := r.APIAdapter.GetLayoutTemplateByID(ctx, uint(layoutID))
+		if err != nil {
+			errString := fmt.Errorf("error get layout template by ID: %d,%w", layoutID, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		serializedLayoutData, err := utils.MarshalLayoutTemplate(layout)
+		if err != nil {
+			errString := fmt.Errorf("error serialize layout template: %v,%w", layout, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		msg.Respond(serializedLayoutData)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
+func (r *TrainEventResponderAdapter) ReplyToListLayoutTemplates(ctx context.Context) error {
+	subscription, err := r.natsConn.Subscribe(SubjectRequestListLayoutTemplates, func(msg *nats.Msg) {
+		layouts, err := r.APIAdapter.ListLayoutTemplates(ctx)
+		if err != nil {
+			errString := fmt.Errorf("error list layout templates: %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		serializedLayoutsData, err := utils.MarshalLayoutTemplates(layouts)
+		if err != nil {
+			errString := fmt.Errorf("error serialize layout templates: %w", err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		msg.Respond(serializedLayoutsData)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
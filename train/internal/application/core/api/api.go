@@ -3,21 +3,40 @@ package api
 import (
 	"context"
 	"fmt"
+	"time"
 	"train/internal/application/core/domain"
 	"train/internal/ports"
 )
 
 type APIAdapter struct {
 	DatabasePort ports.DatabasePort
+	options      Options
 }
 
-func NewAPIAdapter(DBport ports.DatabasePort) *APIAdapter {
+// Options holds the scheduling settings of the train service.
+type Options struct {
+	TimetableHorizonDays int // Days ahead timetable runs are generated for
+}
+
+func NewAPIAdapter(DBport ports.DatabasePort, options Options) *APIAdapter {
 	return &APIAdapter{
 		DatabasePort: DBport,
+		options:      options,
 	}
 }
 
-func (a *APIAdapter) CreateTrain(ctx context.Context, name string, capacity uint) error {
+// CreateTrain creates the train with the coaches and seats of the layout
+// template, or with capacity numbered seats without a template.
+func (a *APIAdapter) CreateTrain(ctx context.Context, name string, capacity uint, layoutTemplateID uint) error {
+	if layoutTemplateID != 0 {
+		layout, err := a.DatabasePort.GetLayoutTemplateByID(ctx, layoutTemplateID)
+		if err != nil {
+			return err
+		}
+
+		return a.DatabasePort.CreateTrain(ctx, domain.NewTrainFromLayout(name, layout))
+	}
+
 	train := domain.NewTrain(name, uint32(capacity))
 
 	err := a.DatabasePort.CreateTrain(ctx, train)
@@ -37,15 +56,34 @@ func (a *APIAdapter) CreateTrain(ctx context.Context, name string, capacity uint
 	return nil
 }
 
+// GetTrainByID gets the train with the seats that are free on its whole
+// route.
 func (a *APIAdapter) GetTrainByID(ctx context.Context, ID uint) (*domain.Train, error) {
-	return a.DatabasePort.GetTrainByID(ctx, ID)
+	train, err := a.DatabasePort.GetTrainByID(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A retired run has no seats left to book
+	if train.IsRetired() {
+		train.Seats = nil
+	}
+
+	train.Segment = train.FullRoute()
+
+	return train, nil
 }
 
 func (a *APIAdapter) ListTrains(ctx context.Context) ([]domain.Train, error) {
 	return a.DatabasePort.ListTrains(ctx)
 }
 
-func (a *APIAdapter) ListTrainsFiltered(ctx context.Context, trainFilters *domain.TrainFilters) ([]domain.Train, error) {
+func (a *APIAdapter) ListTrainsFiltered(ctx context.Context, trainFilters *domain.TrainFilters) (*domain.TrainPage, error) {
+	err := trainFilters.Normalize()
+	if err != nil {
+		return nil, err
+	}
+
 	return a.DatabasePort.ListTrainsFiltered(ctx, trainFilters)
 }
 
@@ -53,17 +91,42 @@ func (a *APIAdapter) UpdateTrain(ctx context.Context, ID uint, name string) erro
 	return a.DatabasePort.UpdateTrain(ctx, ID, name)
 }
 
+// UpdateTrainTravelDetails moves the first and last stop of the route to the
+// origin and destination, keeping the stops in between.
 func (a *APIAdapter) UpdateTrainTravelDetails(ctx context.Context, TrainID uint, travelDetails *domain.TrainTravelDetails) error {
-	return a.DatabasePort.UpdateTrainTravelDetails(ctx, TrainID, travelDetails)
+	train, err := a.DatabasePort.GetTrainByID(ctx, TrainID)
+	if err != nil {
+		return err
+	}
+
+	stops := train.RouteWithTravelDetails(travelDetails)
+
+	err = domain.ValidateRoute(stops)
+	if err != nil {
+		return err
+	}
+
+	return a.DatabasePort.UpdateTrainTravelDetails(ctx, TrainID, travelDetails, stops)
 }
 
 func (a *APIAdapter) DeleteTrain(ctx context.Context, ID uint) error {
 	return a.DatabasePort.DeleteTrain(ctx, ID)
 }
 
-func (a *APIAdapter) CreateSeat(ctx context.Context, trainID, seatNumber uint) error {
+// CreateSeat adds a seat of the given class to the train, a standard seat
+// when no class is given.
+func (a *APIAdapter) CreateSeat(ctx context.Context, trainID, seatNumber uint, class string) error {
 	seat := domain.NewSeat(trainID, seatNumber)
 
+	if class != "" {
+		class, err := domain.ParseSeatClass(class)
+		if err != nil {
+			return err
+		}
+
+		seat.Class = class
+	}
+
 	return a.DatabasePort.CreateSeat(ctx, seat)
 }
 
@@ -80,31 +143,113 @@ func (a *APIAdapter) UpdateSeatNumber(ctx context.Context, seatID uint, seatNumb
 	return nil
 }
 
-func (a *APIAdapter) SeatBooked(ctx context.Context, seatID, trainID, userID uint) error {
+// SeatBooked books the seat on the segment, the whole route when the
+// segment is left out.
+func (a *APIAdapter) SeatBooked(ctx context.Context, seatID, trainID, userID uint, segment domain.Segment) error {
 	IsTrainAvailable, err := a.DatabasePort.IsTrainAvailable(ctx, trainID)
 	if err != nil {
 		return err
 	}
 
-	if IsTrainAvailable {
+	train, err := a.DatabasePort.GetTrainByID(ctx, trainID)
+	if err != nil {
+		return err
+	}
+
+	if train.IsFullRoute(segment) {
+		segment = train.FullRoute()
+	} else if err := train.CheckSegment(segment); err != nil {
+		return err
+	}
+
+	// A full train can still have seats free on parts of its route
+	if IsTrainAvailable && segment == train.FullRoute() {
 		return fmt.Errorf("train with ID %d is not available", trainID)
 	}
 
-	err = a.DatabasePort.UpdateSeatBookingStatus(ctx, seatID, true)
+	seat, err := a.DatabasePort.GetSeatByID(ctx, seatID)
+	if err != nil {
+		return err
+	}
+
+	if seat.TrainID != trainID {
+		return fmt.Errorf("seat with ID %d does not belong to train %d", seatID, trainID)
+	}
+
+	if seat.Booked {
+		return fmt.Errorf("seat with ID %d is already booked", seatID)
+	}
+
+	segmentHeld, err := a.DatabasePort.IsSeatSegmentHeld(ctx, seatID, userID, segment)
+	if err != nil {
+		return err
+	}
+
+	if segmentHeld {
+		return fmt.Errorf("seat with ID %d is held by another user", seatID)
+	}
+
+	segmentBooked, err := a.DatabasePort.IsSeatSegmentBooked(ctx, seatID, segment)
+	if err != nil {
+		return err
+	}
+
+	if segmentBooked {
+		return fmt.Errorf("%w: seat ID %d", domain.ErrSeatSegmentBooked, seatID)
+	}
+
+	if segment != train.FullRoute() {
+		err = a.bookSeatSegment(ctx, seat, userID, segment)
+	} else {
+		err = a.bookSeat(ctx, seat, userID)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// The booking replaces the hold the user had on the segment
+	return a.DatabasePort.ReleaseSeatHold(ctx, seatID, userID, segment)
+}
+
+func (a *APIAdapter) bookSeat(ctx context.Context, seat *domain.Seat, userID uint) error {
+	err := a.DatabasePort.UpdateSeatBookingStatus(ctx, seat.ID, true)
 	if err != nil {
 		return err
 	}
 
-	err = a.DatabasePort.UpdateSeatUser(ctx, seatID, userID)
+	err = a.DatabasePort.UpdateSeatUser(ctx, seat.ID, userID)
 	if err != nil {
 		return err
 	}
 
-	return a.DatabasePort.MinusTrainAvailableSeats(ctx, trainID)
+	return a.DatabasePort.MinusTrainAvailableSeats(ctx, seat.TrainID)
 }
 
-func (a *APIAdapter) CancelSeatBooking(ctx context.Context, seatID uint, trainID uint) error {
-	err := a.DatabasePort.UpdateSeatBookingStatus(ctx, seatID, false)
+func (a *APIAdapter) CancelSeatBooking(ctx context.Context, seatID, trainID, userID uint, segment domain.Segment) error {
+	if !segment.IsZero() {
+		train, err := a.DatabasePort.GetTrainByID(ctx, trainID)
+		if err != nil {
+			return err
+		}
+
+		if !train.IsFullRoute(segment) {
+			return a.cancelSeatSegment(ctx, seatID, trainID, userID, segment)
+		}
+	}
+
+	seat, err := a.DatabasePort.GetSeatByID(ctx, seatID)
+	if err != nil {
+		return err
+	}
+
+	// Releases may be replayed by a compensating booking saga, so a seat that
+	// is not booked (or is booked by someone else) is left untouched
+	if !seat.Booked || (userID != 0 && seat.UserID != userID) {
+		return nil
+	}
+
+	err = a.DatabasePort.UpdateSeatBookingStatus(ctx, seatID, false)
 	if err != nil {
 		return err
 	}
@@ -112,6 +257,89 @@ func (a *APIAdapter) CancelSeatBooking(ctx context.Context, seatID uint, trainID
 	return a.DatabasePort.PlusTrainAvailableSeats(ctx, trainID)
 }
 
+// TransferSeatBooking moves a booked seat to the user a ticket was
+// transferred to.
+func (a *APIAdapter) TransferSeatBooking(ctx context.Context, seatID, trainID, userID uint, segment domain.Segment) error {
+	seat, err := a.DatabasePort.GetSeatByID(ctx, seatID)
+	if err != nil {
+		return err
+	}
+
+	if seat.TrainID != trainID {
+		return fmt.Errorf("seat with ID %d does not belong to train %d", seatID, trainID)
+	}
+
+	if !segment.IsZero() {
+		train, err := a.DatabasePort.GetTrainByID(ctx, trainID)
+		if err != nil {
+			return err
+		}
+
+		if !train.IsFullRoute(segment) {
+			return a.DatabasePort.UpdateSeatSegmentUser(ctx, seatID, segment, userID)
+		}
+	}
+
+	if !seat.Booked {
+		return fmt.Errorf("seat with ID %d is not booked", seatID)
+	}
+
+	return a.DatabasePort.UpdateSeatUser(ctx, seatID, userID)
+}
+
+// HoldSeats holds the seats for the user on the segment, the whole route
+// when the segment is left out.
+func (a *APIAdapter) HoldSeats(ctx context.Context, trainID, userID uint, seatIDs []uint, segment domain.Segment, heldUntil time.Time) ([]domain.Seat, error) {
+	if len(seatIDs) == 0 {
+		return nil, fmt.Errorf("no seats to hold")
+	}
+
+	train, err := a.DatabasePort.GetTrainByID(ctx, trainID)
+	if err != nil {
+		return nil, err
+	}
+
+	if train.IsRetired() {
+		return nil, fmt.Errorf("%w: train ID %d", domain.ErrTrainRetired, trainID)
+	}
+
+	segment, err = resolveSegment(train, segment)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.DatabasePort.HoldSeats(ctx, trainID, userID, seatIDs, segment, heldUntil)
+}
+
+func (a *APIAdapter) ReleaseSeatHold(ctx context.Context, seatID, trainID, userID uint, segment domain.Segment) error {
+	train, err := a.DatabasePort.GetTrainByID(ctx, trainID)
+	if err != nil {
+		return err
+	}
+
+	segment, err = resolveSegment(train, segment)
+	if err != nil {
+		return err
+	}
+
+	return a.DatabasePort.ReleaseSeatHold(ctx, seatID, userID, segment)
+}
+
+// resolveSegment checks the segment is on the route of the train, taking
+// the zero segment for the whole route.
+func resolveSegment(train *domain.Train, segment domain.Segment) (domain.Segment, error) {
+	if train.IsFullRoute(segment) {
+		return train.FullRoute(), nil
+	}
+
+	err := train.CheckSegment(segment)
+	if err != nil {
+		return domain.Segment{}, err
+	}
+
+	return segment, nil
+}
+
 func (a *APIAdapter) ListSeatsByTrainID(ctx context.Context, trainID uint) ([]domain.Seat, error) {
 	return a.DatabasePort.ListSeatsByTrainID(ctx, trainID)
 }
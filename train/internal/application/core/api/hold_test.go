@@ -0,0 +1,216 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+	"train/internal/application/core/domain"
+	"train/internal/ports"
+)
+
+// holdDatabase keeps the seat holds and segment bookings of a single train
+// in memory. Only the methods used by holding and booking seats are
+// implemented.
+type holdDatabase struct {
+	ports.DatabasePort
+
+	train    *domain.Train
+	holds    []domain.SeatHold
+	segments []domain.SeatSegment
+}
+
+func newHoldDatabase() *holdDatabase {
+	departure := time.Date(2026, time.March, 10, 8, 0, 0, 0, time.UTC)
+
+	train := &domain.Train{ID: 1}
+	for i, station := range []string{"Lyon", "Dijon", "Paris", "Lille"} {
+		at := departure.Add(time.Duration(i) * time.Hour)
+		train.Stops = append(train.Stops, domain.Stop{TrainID: 1, Sequence: uint(i), Station: station, ArrivalTime: at, DepartureTime: at})
+	}
+
+	return &holdDatabase{train: train}
+}
+
+func (d *holdDatabase) GetTrainByID(ctx context.Context, ID uint) (*domain.Train, error) {
+	train := *d.train
+	return &train, nil
+}
+
+func (d *holdDatabase) IsTrainAvailable(ctx context.Context, trainID uint) (bool, error) {
+	return false, nil
+}
+
+func (d *holdDatabase) GetSeatByID(ctx context.Context, ID uint) (*domain.Seat, error) {
+	return &domain.Seat{ID: ID, TrainID: d.train.ID}, nil
+}
+
+func (d *holdDatabase) HoldSeats(ctx context.Context, trainID, userID uint, seatIDs []uint, segment domain.Segment, heldUntil time.Time) ([]domain.Seat, error) {
+	for _, seatID := range seatIDs {
+		held, _ := d.IsSeatSegmentHeld(ctx, seatID, userID, segment)
+		if held {
+			return nil, fmt.Errorf("seat conflict: seat %d is no longer free", seatID)
+		}
+	}
+
+	seats := make([]domain.Seat, 0, len(seatIDs))
+	for _, seatID := range seatIDs {
+		d.holds = append(d.holds, domain.SeatHold{SeatID: seatID, TrainID: trainID, UserID: userID, Segment: segment, HeldUntil: heldUntil})
+		seats = append(seats, domain.Seat{ID: seatID, TrainID: trainID, HeldBy: userID, HeldUntil: &heldUntil})
+	}
+
+	return seats, nil
+}
+
+func (d *holdDatabase) ReleaseSeatHold(ctx context.Context, seatID, userID uint, segment domain.Segment) error {
+	holds := d.holds[:0]
+	for _, hold := range d.holds {
+		if hold.SeatID != seatID || hold.UserID != userID || !hold.Overlaps(segment) {
+			holds = append(holds, hold)
+		}
+	}
+
+	d.holds = holds
+
+	return nil
+}
+
+func (d *holdDatabase) IsSeatSegmentHeld(ctx context.Context, seatID, userID uint, segment domain.Segment) (bool, error) {
+	for _, hold := range d.holds {
+		if hold.SeatID == seatID && hold.UserID != userID && hold.HeldUntil.After(time.Now()) && hold.Overlaps(segment) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (d *holdDatabase) IsSeatSegmentBooked(ctx context.Context, seatID uint, segment domain.Segment) (bool, error) {
+	for _, seatSegment := range d.segments {
+		if seatSegment.SeatID == seatID && seatSegment.Overlaps(segment) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (d *holdDatabase) CreateSeatSegment(ctx context.Context, seatSegment *domain.SeatSegment) error {
+	d.segments = append(d.segments, *seatSegment)
+	return nil
+}
+
+func (d *holdDatabase) RecountTrainAvailableSeats(ctx context.Context, trainID uint) error {
+	return nil
+}
+
+func TestHoldSeatsOnSegment(t *testing.T) {
+	heldUntil := time.Now().Add(10 * time.Minute)
+
+	tests := []struct {
+		name    string
+		segment domain.Segment
+		wantErr bool
+	}{
+		{name: "segment after the hold", segment: domain.Segment{FromStop: 2, ToStop: 3}},
+		{name: "segment overlapping the hold", segment: domain.Segment{FromStop: 1, ToStop: 3}, wantErr: true},
+		{name: "whole route", segment: domain.Segment{}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newHoldDatabase()
+			a := &APIAdapter{DatabasePort: db}
+
+			_, err := a.HoldSeats(context.Background(), 1, 1, []uint{7}, domain.Segment{FromStop: 0, ToStop: 2}, heldUntil)
+			if err != nil {
+				t.Fatalf("HoldSeats() error = %v", err)
+			}
+
+			_, err = a.HoldSeats(context.Background(), 1, 2, []uint{7}, tt.segment, heldUntil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HoldSeats() of another user error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHoldSeatsResolvesSegment(t *testing.T) {
+	heldUntil := time.Now().Add(10 * time.Minute)
+
+	db := newHoldDatabase()
+	a := &APIAdapter{DatabasePort: db}
+
+	_, err := a.HoldSeats(context.Background(), 1, 1, []uint{7}, domain.Segment{}, heldUntil)
+	if err != nil {
+		t.Fatalf("HoldSeats() error = %v", err)
+	}
+
+	if want := (domain.Segment{FromStop: 0, ToStop: 3}); db.holds[0].Segment != want {
+		t.Errorf("HoldSeats() held segment = %v, want the whole route %v", db.holds[0].Segment, want)
+	}
+
+	_, err = a.HoldSeats(context.Background(), 1, 1, []uint{7}, domain.Segment{FromStop: 2, ToStop: 5}, heldUntil)
+	if !errors.Is(err, domain.ErrInvalidSegment) {
+		t.Errorf("HoldSeats() off the route error = %v, want %v", err, domain.ErrInvalidSegment)
+	}
+}
+
+func TestSeatBookedChecksHoldOnSegment(t *testing.T) {
+	held := domain.Segment{FromStop: 0, ToStop: 2}
+
+	tests := []struct {
+		name      string
+		userID    uint
+		segment   domain.Segment
+		wantErr   bool
+		wantHolds int
+	}{
+		{name: "holder books the held segment", userID: 1, segment: held, wantHolds: 0},
+		{name: "other user books after the hold", userID: 2, segment: domain.Segment{FromStop: 2, ToStop: 3}, wantHolds: 1},
+		{name: "other user books inside the hold", userID: 2, segment: domain.Segment{FromStop: 1, ToStop: 2}, wantErr: true, wantHolds: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newHoldDatabase()
+			db.holds = []domain.SeatHold{{SeatID: 7, TrainID: 1, UserID: 1, Segment: held, HeldUntil: time.Now().Add(10 * time.Minute)}}
+
+			a := &APIAdapter{DatabasePort: db}
+
+			err := a.SeatBooked(context.Background(), 7, 1, tt.userID, tt.segment)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SeatBooked() error = %v, want error %v", err, tt.wantErr)
+			}
+
+			if len(db.holds) != tt.wantHolds {
+				t.Errorf("SeatBooked() left holds %v, want %d", db.holds, tt.wantHolds)
+			}
+		})
+	}
+}
+
+func TestRetiredRunTakesNoBookings(t *testing.T) {
+	db := newHoldDatabase()
+	retiredAt := time.Now()
+	db.train.RetiredAt = &retiredAt
+
+	a := &APIAdapter{DatabasePort: db}
+
+	_, err := a.HoldSeats(context.Background(), 1, 1, []uint{7}, domain.Segment{}, time.Now().Add(10*time.Minute))
+	if !errors.Is(err, domain.ErrTrainRetired) {
+		t.Errorf("HoldSeats() error = %v, want %v", err, domain.ErrTrainRetired)
+	}
+
+	_, err = a.GetTrainSegment(context.Background(), 1, "Dijon", "Paris")
+	if !errors.Is(err, domain.ErrTrainRetired) {
+		t.Errorf("GetTrainSegment() error = %v, want %v", err, domain.ErrTrainRetired)
+	}
+
+	// A booking sold before the run was retired still lands on it
+	err = a.SeatBooked(context.Background(), 7, 1, 1, domain.Segment{FromStop: 1, ToStop: 2})
+	if err != nil {
+		t.Errorf("SeatBooked() error = %v, want the booking kept", err)
+	}
+}
@@ -0,0 +1,28 @@
+package api
+
+import (
+	"context"
+	"train/internal/application/core/domain"
+)
+
+func (a *APIAdapter) CreateLayoutTemplate(ctx context.Context, layout *domain.LayoutTemplate) (*domain.LayoutTemplate, error) {
+	err := layout.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.DatabasePort.CreateLayoutTemplate(ctx, layout)
+	if err != nil {
+		return nil, err
+	}
+
+	return layout, nil
+}
+
+func (a *APIAdapter) GetLayoutTemplateByID(ctx context.Context, ID uint) (*domain.LayoutTemplate, error) {
+	return a.DatabasePort.GetLayoutTemplateByID(ctx, ID)
+}
+
+func (a *APIAdapter) ListLayoutTemplates(ctx context.Context) ([]domain.LayoutTemplate, error) {
+	return a.DatabasePort.ListLayoutTemplates(ctx)
+}
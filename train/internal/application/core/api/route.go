@@ -0,0 +1,127 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"train/internal/application/core/domain"
+)
+
+// UpdateTrainStops replaces the route of the train, which is only allowed
+// while none of its seats are booked.
+func (a *APIAdapter) UpdateTrainStops(ctx context.Context, trainID uint, stops []domain.Stop) error {
+	err := domain.ValidateRoute(stops)
+	if err != nil {
+		return err
+	}
+
+	return a.DatabasePort.UpdateTrainStops(ctx, trainID, stops)
+}
+
+// GetTrainSegment gets the train between two of its stations, with the
+// travel details of that segment and the seats free on all of it.
+func (a *APIAdapter) GetTrainSegment(ctx context.Context, ID uint, origin, destination string) (*domain.Train, error) {
+	train, err := a.DatabasePort.GetTrainByID(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if train.IsRetired() {
+		return nil, fmt.Errorf("%w: train ID %d", domain.ErrTrainRetired, ID)
+	}
+
+	segment, err := train.FindSegment(origin, destination)
+	if err != nil {
+		return nil, err
+	}
+
+	train.Seats, err = a.DatabasePort.ListFreeSeatsBySegment(ctx, ID, segment)
+	if err != nil {
+		return nil, err
+	}
+
+	train.ApplySegment(segment)
+
+	return train, nil
+}
+
+// ListSeatsBySegment lists every seat of the train, the ones booked on any
+// part of the segment marked as booked.
+func (a *APIAdapter) ListSeatsBySegment(ctx context.Context, trainID uint, segment domain.Segment) ([]domain.Seat, error) {
+	train, err := a.DatabasePort.GetTrainByID(ctx, trainID)
+	if err != nil {
+		return nil, err
+	}
+
+	if train.IsFullRoute(segment) {
+		segment = train.FullRoute()
+	}
+
+	err = train.CheckSegment(segment)
+	if err != nil {
+		return nil, err
+	}
+
+	seats, err := a.DatabasePort.ListSeatsBySegment(ctx, trainID, segment)
+	if err != nil {
+		return nil, err
+	}
+
+	// Seats of a retired run can no longer be chosen
+	if train.IsRetired() {
+		for i := range seats {
+			seats[i].Booked = true
+		}
+	}
+
+	return seats, nil
+}
+
+// GetSeatMap lays out every seat of the train in its coaches, with the
+// state each seat has between the origin and destination. Empty stations
+// are the ends of the route.
+func (a *APIAdapter) GetSeatMap(ctx context.Context, trainID uint, origin, destination string) (*domain.SeatMap, error) {
+	train, err := a.DatabasePort.GetTrainByID(ctx, trainID)
+	if err != nil {
+		return nil, err
+	}
+
+	segment, err := train.FindSegment(origin, destination)
+	if err != nil {
+		return nil, err
+	}
+
+	seats, err := a.DatabasePort.ListSeatsBySegment(ctx, trainID, segment)
+	if err != nil {
+		return nil, err
+	}
+
+	train.ApplySegment(segment)
+	train.Seats = nil
+
+	return domain.NewSeatMap(train, seats), nil
+}
+
+func (a *APIAdapter) bookSeatSegment(ctx context.Context, seat *domain.Seat, userID uint, segment domain.Segment) error {
+	err := a.DatabasePort.CreateSeatSegment(ctx, &domain.SeatSegment{
+		SeatID:  seat.ID,
+		TrainID: seat.TrainID,
+		UserID:  userID,
+		Segment: segment,
+	})
+	if err != nil {
+		return err
+	}
+
+	return a.DatabasePort.RecountTrainAvailableSeats(ctx, seat.TrainID)
+}
+
+// cancelSeatSegment cancels a booking on part of the route. Like whole route
+// releases it may be replayed, a booking that is gone is left alone.
+func (a *APIAdapter) cancelSeatSegment(ctx context.Context, seatID, trainID, userID uint, segment domain.Segment) error {
+	err := a.DatabasePort.DeleteSeatSegment(ctx, seatID, segment, userID)
+	if err != nil {
+		return err
+	}
+
+	return a.DatabasePort.RecountTrainAvailableSeats(ctx, trainID)
+}
@@ -0,0 +1,175 @@
+package api
+
+import (
+	"context"
+	"log"
+	"time"
+	"train/internal/application/core/domain"
+)
+
+const timetableRunInterval = time.Hour
+
+// CreateTimetable saves the timetable and generates its runs within the
+// horizon. Runs that fail to generate are left for the next generation.
+func (a *APIAdapter) CreateTimetable(ctx context.Context, timetable *domain.Timetable) (*domain.Timetable, error) {
+	err := a.validateTimetable(ctx, timetable)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.DatabasePort.CreateTimetable(ctx, timetable)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.generateRuns(ctx, timetable, time.Now())
+	if err != nil {
+		log.Printf("generate runs of timetable %d error:%v\n", timetable.ID, err)
+	}
+
+	return timetable, nil
+}
+
+func (a *APIAdapter) GetTimetableByID(ctx context.Context, ID uint) (*domain.Timetable, error) {
+	return a.DatabasePort.GetTimetableByID(ctx, ID)
+}
+
+func (a *APIAdapter) ListTimetables(ctx context.Context) ([]domain.Timetable, error) {
+	return a.DatabasePort.ListTimetables(ctx)
+}
+
+// UpdateTimetable replaces the service pattern of the timetable and
+// generates its upcoming runs again. The runs it replaces are retired, runs
+// somebody booked or holds a seat on keep the schedule they were generated
+// with.
+func (a *APIAdapter) UpdateTimetable(ctx context.Context, timetable *domain.Timetable) (*domain.Timetable, error) {
+	err := a.validateTimetable(ctx, timetable)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.DatabasePort.UpdateTimetable(ctx, timetable)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	err = a.DatabasePort.RetireUnbookedTimetableRuns(ctx, timetable.ID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.generateRuns(ctx, timetable, now)
+	if err != nil {
+		log.Printf("generate runs of timetable %d error:%v\n", timetable.ID, err)
+	}
+
+	return a.DatabasePort.GetTimetableByID(ctx, timetable.ID)
+}
+
+// validateTimetable checks the timetable and that its layout template
+// exists.
+func (a *APIAdapter) validateTimetable(ctx context.Context, timetable *domain.Timetable) error {
+	err := timetable.Validate()
+	if err != nil {
+		return err
+	}
+
+	if timetable.LayoutTemplateID == 0 {
+		return nil
+	}
+
+	_, err = a.DatabasePort.GetLayoutTemplateByID(ctx, timetable.LayoutTemplateID)
+
+	return err
+}
+
+// GenerateTimetableRuns keeps the runs of every timetable generated for
+// the horizon ahead, once at start and then periodically.
+func (a *APIAdapter) GenerateTimetableRuns(ctx context.Context) {
+	ticker := time.NewTicker(timetableRunInterval)
+	defer ticker.Stop()
+
+	for {
+		err := a.generateTimetableRuns(ctx, time.Now())
+		if err != nil {
+			log.Printf("generate timetable runs error:%v\n", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// generateTimetableRuns works through every timetable. A timetable whose
+// runs fail to generate is left for the next run.
+func (a *APIAdapter) generateTimetableRuns(ctx context.Context, now time.Time) error {
+	timetables, err := a.DatabasePort.ListTimetables(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range timetables {
+		err := a.generateRuns(ctx, &timetables[i], now)
+		if err != nil {
+			log.Printf("generate runs of timetable %d error:%v\n", timetables[i].ID, err)
+		}
+	}
+
+	return nil
+}
+
+// generateRuns creates the runs of the timetable from today until the
+// horizon that are missing and have not departed yet.
+func (a *APIAdapter) generateRuns(ctx context.Context, timetable *domain.Timetable, now time.Time) error {
+	location, err := timetable.Location()
+	if err != nil {
+		return err
+	}
+
+	today := domain.ServiceDay(now.In(location))
+
+	runDates, err := a.DatabasePort.ListTimetableRunDates(ctx, timetable.ID, today)
+	if err != nil {
+		return err
+	}
+
+	var layout *domain.LayoutTemplate
+	if timetable.LayoutTemplateID != 0 {
+		layout, err = a.DatabasePort.GetLayoutTemplateByID(ctx, timetable.LayoutTemplateID)
+		if err != nil {
+			return err
+		}
+	}
+
+	generated := make(map[time.Time]bool, len(runDates))
+	for _, date := range runDates {
+		generated[domain.ServiceDay(date)] = true
+	}
+
+	for _, date := range timetable.ServiceDates(today, today.AddDate(0, 0, a.options.TimetableHorizonDays)) {
+		if generated[date] {
+			continue
+		}
+
+		run, err := timetable.Run(date, layout)
+		if err != nil {
+			return err
+		}
+
+		if !run.DepartureTime.After(now) {
+			continue
+		}
+
+		err = a.DatabasePort.CreateTrain(ctx, run)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// SeatAttributes holds the features of a seat, searches can ask for any
+// combination of them.
+type SeatAttributes uint32
+
+const (
+	SeatWindow SeatAttributes = 1 << iota
+	SeatAisle
+	SeatTable
+	SeatPowerSocket
+	SeatAccessible
+)
+
+var seatAttributeNames = []struct {
+	attribute SeatAttributes
+	name      string
+}{
+	{SeatWindow, "window"},
+	{SeatAisle, "aisle"},
+	{SeatTable, "table"},
+	{SeatPowerSocket, "power_socket"},
+	{SeatAccessible, "accessible"},
+}
+
+var (
+	ErrInvalidSeatAttribute = errors.New("seat attribute not allowed")
+	ErrInvalidLayout        = errors.New("layout not allowed")
+)
+
+// Coach is a carriage of a train. Its seats sit on a grid of Rows by
+// Columns cells, the cells without a seat being the aisle, doors or
+// luggage space.
+type Coach struct {
+	ID      uint `gorm:"primaryKey"`
+	TrainID uint `gorm:"uniqueIndex:idx_coach_number"`
+	Number  uint `gorm:"uniqueIndex:idx_coach_number"` // Position in the train, from 1
+	Class   string
+	Rows    uint
+	Columns uint
+	Seats   []Seat `gorm:"-"` // Seats to create with the coach
+}
+
+// LayoutTemplate is a reusable line-up of coaches trains are created from.
+type LayoutTemplate struct {
+	ID        uint            `gorm:"primaryKey"`
+	Name      string          `gorm:"uniqueIndex"`
+	Coaches   []CoachTemplate `gorm:"foreignKey:LayoutTemplateID;constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type CoachTemplate struct {
+	ID               uint `gorm:"primaryKey"`
+	LayoutTemplateID uint `gorm:"uniqueIndex:idx_coach_template_number"`
+	Number           uint `gorm:"uniqueIndex:idx_coach_template_number"`
+	Class            string
+	Rows             uint
+	Columns          uint
+	Seats            []SeatTemplate `gorm:"foreignKey:CoachTemplateID;constraint:OnDelete:CASCADE"`
+}
+
+// SeatTemplate places a seat on a cell of the coach grid.
+type SeatTemplate struct {
+	ID              uint           `gorm:"primaryKey"`
+	CoachTemplateID uint           `gorm:"index"`
+	Row             uint           `gorm:"column:grid_row"`
+	Column          uint           `gorm:"column:grid_column"`
+	Attributes      SeatAttributes `gorm:"default:0"`
+}
+
+// ParseSeatAttributes reads attribute names like "window" or
+// "power_socket".
+func ParseSeatAttributes(names []string) (SeatAttributes, error) {
+	var attributes SeatAttributes
+
+	for _, name := range names {
+		attribute, ok := seatAttribute(name)
+		if !ok {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidSeatAttribute, name)
+		}
+
+		attributes |= attribute
+	}
+
+	return attributes, nil
+}
+
+func seatAttribute(name string) (SeatAttributes, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	for _, attribute := range seatAttributeNames {
+		if attribute.name == name {
+			return attribute.attribute, true
+		}
+	}
+
+	return 0, false
+}
+
+// Names lists the names of the attributes.
+func (a SeatAttributes) Names() []string {
+	names := []string{}
+
+	for _, attribute := range seatAttributeNames {
+		if a&attribute.attribute != 0 {
+			names = append(names, attribute.name)
+		}
+	}
+
+	return names
+}
+
+// Validate checks the template, numbers its coaches in order and sorts the
+// seats of each coach by row and column.
+func (l *LayoutTemplate) Validate() error {
+	l.Name = strings.TrimSpace(l.Name)
+	if l.Name == "" {
+		return fmt.Errorf("%w: layout has no name", ErrInvalidLayout)
+	}
+
+	if len(l.Coaches) == 0 {
+		return fmt.Errorf("%w: layout has no coaches", ErrInvalidLayout)
+	}
+
+	for i := range l.Coaches {
+		coach := &l.Coaches[i]
+		coach.Number = uint(i + 1)
+
+		class, err := ParseSeatClass(coach.Class)
+		if err != nil {
+			return fmt.Errorf("%w: coach %d: %w", ErrInvalidLayout, coach.Number, err)
+		}
+		coach.Class = class
+
+		if coach.Rows == 0 || coach.Columns == 0 {
+			return fmt.Errorf("%w: coach %d has an empty grid", ErrInvalidLayout, coach.Number)
+		}
+
+		if len(coach.Seats) == 0 {
+			return fmt.Errorf("%w: coach %d has no seats", ErrInvalidLayout, coach.Number)
+		}
+
+		slices.SortFunc(coach.Seats, func(a, b SeatTemplate) int {
+			if a.Row != b.Row {
+				return int(a.Row) - int(b.Row)
+			}
+
+			return int(a.Column) - int(b.Column)
+		})
+
+		for j, seat := range coach.Seats {
+			if seat.Row == 0 || seat.Row > coach.Rows || seat.Column == 0 || seat.Column > coach.Columns {
+				return fmt.Errorf("%w: coach %d has a seat outside its grid at row %d column %d", ErrInvalidLayout, coach.Number, seat.Row, seat.Column)
+			}
+
+			if j > 0 && seat.Row == coach.Seats[j-1].Row && seat.Column == coach.Seats[j-1].Column {
+				return fmt.Errorf("%w: coach %d has two seats at row %d column %d", ErrInvalidLayout, coach.Number, seat.Row, seat.Column)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Capacity is the number of seats of the layout.
+func (l *LayoutTemplate) Capacity() uint32 {
+	var capacity uint32
+	for _, coach := range l.Coaches {
+		capacity += uint32(len(coach.Seats))
+	}
+
+	return capacity
+}
+
+// BuildCoaches lays out the coaches of a new train. Seats are numbered
+// through the train from 1, coach by coach, and take the class of their
+// coach.
+func (l *LayoutTemplate) BuildCoaches() []Coach {
+	coaches := make([]Coach, 0, len(l.Coaches))
+	number := uint(1)
+
+	for _, coachTemplate := range l.Coaches {
+		coach := Coach{
+			Number:  coachTemplate.Number,
+			Class:   coachTemplate.Class,
+			Rows:    coachTemplate.Rows,
+			Columns: coachTemplate.Columns,
+			Seats:   make([]Seat, 0, len(coachTemplate.Seats)),
+		}
+
+		for _, seatTemplate := range coachTemplate.Seats {
+			seat := NewSeat(0, number)
+			seat.Class = coachTemplate.Class
+			seat.Row = seatTemplate.Row
+			seat.Column = seatTemplate.Column
+			seat.Attributes = seatTemplate.Attributes
+
+			coach.Seats = append(coach.Seats, *seat)
+			number++
+		}
+
+		coaches = append(coaches, coach)
+	}
+
+	return coaches
+}
+
+// NewTrainFromLayout is a train with the coaches and seats of the layout.
+func NewTrainFromLayout(name string, layout *LayoutTemplate) *Train {
+	train := NewTrain(name, layout.Capacity())
+	train.Coaches = layout.BuildCoaches()
+
+	return train
+}
@@ -0,0 +1,212 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidRoute      = errors.New("route not allowed")
+	ErrInvalidSegment    = errors.New("segment not allowed")
+	ErrRouteHasBookings  = errors.New("route conflict: seats of the train are booked")
+	ErrSeatSegmentBooked = errors.New("seat conflict: seat is booked on part of the segment")
+)
+
+// Stop is a station on the route of a train. Stops are numbered from 0 in
+// the order the train calls at them.
+type Stop struct {
+	ID            uint `gorm:"primaryKey"`
+	TrainID       uint `gorm:"uniqueIndex:idx_stop_sequence"`
+	Sequence      uint `gorm:"uniqueIndex:idx_stop_sequence"`
+	Station       string
+	ArrivalTime   time.Time
+	DepartureTime time.Time
+}
+
+// Segment is the part of a route between two of its stops, by sequence.
+// The zero segment stands for the whole route.
+type Segment struct {
+	FromStop uint
+	ToStop   uint
+}
+
+// SeatSegment books a seat on part of the route. A seat booked on the whole
+// route is marked Booked instead.
+type SeatSegment struct {
+	ID      uint `gorm:"primaryKey"`
+	SeatID  uint `gorm:"index"`
+	TrainID uint `gorm:"index"`
+	UserID  uint
+	Segment
+	CreatedAt time.Time
+}
+
+// SeatHold keeps a seat for a user on part of the route until the hold
+// expires. Only holds on overlapping segments keep others from the seat.
+type SeatHold struct {
+	ID      uint `gorm:"primaryKey"`
+	SeatID  uint `gorm:"index"`
+	TrainID uint `gorm:"index"`
+	UserID  uint
+	Segment
+	HeldUntil time.Time `gorm:"index"`
+	CreatedAt time.Time
+}
+
+// IsZero reports whether the segment was left out.
+func (s Segment) IsZero() bool {
+	return s.FromStop == 0 && s.ToStop == 0
+}
+
+// Overlaps reports whether both segments share a stretch of track. Segments
+// that only meet at a stop do not overlap.
+func (s Segment) Overlaps(other Segment) bool {
+	return s.FromStop < other.ToStop && other.FromStop < s.ToStop
+}
+
+// ValidateRoute checks that the stops are distinct stations, at least two,
+// called at in time order, and numbers them.
+func ValidateRoute(stops []Stop) error {
+	if len(stops) < 2 {
+		return fmt.Errorf("%w: a route needs at least two stops", ErrInvalidRoute)
+	}
+
+	stations := make(map[string]bool, len(stops))
+
+	for i := range stops {
+		stop := &stops[i]
+		stop.Sequence = uint(i)
+
+		station := strings.ToLower(strings.TrimSpace(stop.Station))
+		if station == "" {
+			return fmt.Errorf("%w: stop %d has no station", ErrInvalidRoute, i)
+		}
+
+		if stations[station] {
+			return fmt.Errorf("%w: station %q is on the route twice", ErrInvalidRoute, stop.Station)
+		}
+		stations[station] = true
+
+		if stop.DepartureTime.Before(stop.ArrivalTime) {
+			return fmt.Errorf("%w: stop %q departs before it arrives", ErrInvalidRoute, stop.Station)
+		}
+
+		if i > 0 && !stop.ArrivalTime.After(stops[i-1].DepartureTime) {
+			return fmt.Errorf("%w: stop %q is reached before %q is left", ErrInvalidRoute, stop.Station, stops[i-1].Station)
+		}
+	}
+
+	return nil
+}
+
+// Route is the stops of the train. A train without stops runs straight
+// from its origin to its destination.
+func (t *Train) Route() []Stop {
+	if len(t.Stops) >= 2 {
+		return t.Stops
+	}
+
+	return []Stop{
+		{TrainID: t.ID, Sequence: 0, Station: t.Origin, ArrivalTime: t.DepartureTime, DepartureTime: t.DepartureTime},
+		{TrainID: t.ID, Sequence: 1, Station: t.Destination, ArrivalTime: t.ArrivalTime, DepartureTime: t.ArrivalTime},
+	}
+}
+
+// FullRoute is the segment from the first to the last stop.
+func (t *Train) FullRoute() Segment {
+	return Segment{FromStop: 0, ToStop: uint(len(t.Route()) - 1)}
+}
+
+// IsFullRoute reports whether the segment runs the whole route.
+func (t *Train) IsFullRoute(segment Segment) bool {
+	return segment.IsZero() || segment == t.FullRoute()
+}
+
+// FindSegment is the segment between the origin and destination stations.
+// An empty origin is the first stop and an empty destination the last.
+func (t *Train) FindSegment(origin, destination string) (Segment, error) {
+	segment := t.FullRoute()
+
+	for _, stop := range t.Route() {
+		if origin != "" && strings.EqualFold(stop.Station, origin) {
+			segment.FromStop = stop.Sequence
+			origin = ""
+		}
+
+		if destination != "" && strings.EqualFold(stop.Station, destination) {
+			segment.ToStop = stop.Sequence
+			destination = ""
+		}
+	}
+
+	if origin != "" || destination != "" {
+		return Segment{}, fmt.Errorf("%w: the train does not stop at %s", ErrInvalidSegment, strings.TrimSpace(origin+" "+destination))
+	}
+
+	err := t.CheckSegment(segment)
+	if err != nil {
+		return Segment{}, err
+	}
+
+	return segment, nil
+}
+
+// CheckSegment checks that the segment runs forward between stops of the
+// route.
+func (t *Train) CheckSegment(segment Segment) error {
+	if segment.FromStop >= segment.ToStop || segment.ToStop >= uint(len(t.Route())) {
+		return fmt.Errorf("%w: stops %d to %d are not on the route of train %d", ErrInvalidSegment, segment.FromStop, segment.ToStop, t.ID)
+	}
+
+	return nil
+}
+
+// ApplySegment narrows the travel details of the train to the segment.
+func (t *Train) ApplySegment(segment Segment) {
+	route := t.Route()
+	from, to := route[segment.FromStop], route[segment.ToStop]
+
+	t.Segment = segment
+	t.Origin = from.Station
+	t.DepartureTime = from.DepartureTime
+	t.Destination = to.Station
+	t.ArrivalTime = to.ArrivalTime
+}
+
+// RouteWithTravelDetails is the route with its first and last stop moved to
+// the origin and destination of the travel details.
+func (t *Train) RouteWithTravelDetails(travelDetails *TrainTravelDetails) []Stop {
+	route := make([]Stop, len(t.Route()))
+	copy(route, t.Route())
+
+	first, last := &route[0], &route[len(route)-1]
+
+	first.Station = travelDetails.Origin
+	first.ArrivalTime = travelDetails.DepartureTime
+	first.DepartureTime = travelDetails.DepartureTime
+
+	last.Station = travelDetails.Destination
+	last.ArrivalTime = travelDetails.ArrivalTime
+	last.DepartureTime = travelDetails.ArrivalTime
+
+	for i := range route {
+		route[i].ID = 0
+		route[i].TrainID = t.ID
+	}
+
+	return route
+}
+
+// TravelDetailsOfRoute is the origin and destination of the stops.
+func TravelDetailsOfRoute(stops []Stop) TrainTravelDetails {
+	first, last := stops[0], stops[len(stops)-1]
+
+	return TrainTravelDetails{
+		Origin:        first.Station,
+		DepartureTime: first.DepartureTime,
+		Destination:   last.Station,
+		ArrivalTime:   last.ArrivalTime,
+	}
+}
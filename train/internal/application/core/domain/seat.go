@@ -1,13 +1,41 @@
 package domain
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Seat classes. Fares are priced per class, standard seats are second
+// class.
+const (
+	SeatClassStandard = "standard"
+	SeatClassFirst    = "first"
+	SeatClassSleeper  = "sleeper"
+)
+
+var ErrInvalidSeatClass = errors.New("seat class not allowed")
+
+var seatClasses = map[string]bool{
+	SeatClassStandard: true,
+	SeatClassFirst:    true,
+	SeatClassSleeper:  true,
+}
 
 type Seat struct {
 	ID         uint `gorm:"primaryKey"`
 	UserID     uint // Foreign key to User
 	TrainID    uint // Foreign key to Train
+	CoachID    uint `gorm:"default:0;index"` // Coach the seat is in, 0 for trains without coaches
 	SeatNumber uint
-	Booked     bool // Indicates if the seat is booked
+	Row        uint           `gorm:"column:grid_row;default:0"`    // Row of the coach layout grid, from 1
+	Column     uint           `gorm:"column:grid_column;default:0"` // Column of the coach layout grid, from 1
+	Class      string         `gorm:"default:standard"`
+	Attributes SeatAttributes `gorm:"default:0"`
+	Booked     bool           // Indicates if the seat is booked
+	HeldBy     uint           `gorm:"-"` // User holding the seat on the listed segment, from its SeatHold
+	HeldUntil  *time.Time     `gorm:"-"` // Hold expiry, the seat is free again afterwards
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
 }
@@ -16,5 +44,30 @@ func NewSeat(trainID uint, seatNumber uint) *Seat {
 	return &Seat{
 		TrainID:    trainID,
 		SeatNumber: seatNumber,
+		Class:      SeatClassStandard,
+	}
+}
+
+// IsSeatClass reports whether class is one of the known seat classes.
+func IsSeatClass(class string) bool {
+	return seatClasses[class]
+}
+
+// ParseSeatClass reads a seat class, taking "second" for standard.
+func ParseSeatClass(class string) (string, error) {
+	class = strings.ToLower(strings.TrimSpace(class))
+	if class == "second" {
+		class = SeatClassStandard
+	}
+
+	if !IsSeatClass(class) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidSeatClass, class)
 	}
+
+	return class, nil
+}
+
+// IsHeld reports whether someone holds the seat at the given time.
+func (s *Seat) IsHeld(now time.Time) bool {
+	return s.HeldUntil != nil && s.HeldUntil.After(now)
 }
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// SeatState is whether a seat can be picked on a segment.
+type SeatState string
+
+const (
+	SeatFree   SeatState = "free"
+	SeatHeld   SeatState = "held"
+	SeatBooked SeatState = "booked"
+)
+
+// SeatMap lays out every seat of a train in its coaches with its state on
+// the segment of the train. Seats of trains created without a layout are
+// in no coach.
+type SeatMap struct {
+	Train   *Train
+	Coaches []Coach
+	Seats   []Seat // Seats in no coach
+}
+
+// State is the state of the seat at the given time. A booking wins over a
+// hold.
+func (s *Seat) State(now time.Time) SeatState {
+	if s.Booked {
+		return SeatBooked
+	}
+
+	if s.IsHeld(now) {
+		return SeatHeld
+	}
+
+	return SeatFree
+}
+
+// NewSeatMap places the seats in the coaches of the train, each coach
+// listing its seats by seat number.
+func NewSeatMap(train *Train, seats []Seat) *SeatMap {
+	slices.SortFunc(seats, func(a, b Seat) int {
+		return int(a.SeatNumber) - int(b.SeatNumber)
+	})
+
+	seatMap := &SeatMap{
+		Train:   train,
+		Coaches: make([]Coach, len(train.Coaches)),
+		Seats:   []Seat{},
+	}
+
+	coachIndexes := make(map[uint]int, len(train.Coaches))
+	for i, coach := range train.Coaches {
+		coach.Seats = []Seat{}
+		seatMap.Coaches[i] = coach
+		coachIndexes[coach.ID] = i
+	}
+
+	for _, seat := range seats {
+		i, ok := coachIndexes[seat.CoachID]
+		if !ok {
+			seatMap.Seats = append(seatMap.Seats, seat)
+			continue
+		}
+
+		seatMap.Coaches[i].Seats = append(seatMap.Coaches[i].Seats, seat)
+	}
+
+	return seatMap
+}
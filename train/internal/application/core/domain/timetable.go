@@ -0,0 +1,245 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ServiceException changes whether a timetable runs on a single date.
+type ServiceException string
+
+const (
+	ServiceRemoved ServiceException = "no_service"
+	ServiceAdded   ServiceException = "extra_service"
+)
+
+var (
+	ErrInvalidTimetable = errors.New("timetable not allowed")
+	ErrTrainRetired     = errors.New("train conflict: the run was replaced by a timetable update")
+)
+
+// WeekdayMask holds the days of the week a timetable runs on, bit n for
+// time.Weekday n.
+type WeekdayMask uint8
+
+const EveryDay WeekdayMask = 1<<7 - 1
+
+// Timetable is a service pattern that runs the same route on the weekdays
+// of its mask between ValidFrom and ValidUntil, dates included. Exceptions
+// win over the weekdays and validity period. Times of its stops are
+// counted from midnight of the service day in TimeZone.
+type Timetable struct {
+	ID       uint `gorm:"primaryKey"`
+	Name     string
+	Capacity uint32 // Number of seats of every run without a layout
+	// Layout template the coaches and seats of the runs are laid out by
+	LayoutTemplateID uint  `gorm:"default:0"`
+	Price            int64 // Starting fare of every run in minor currency units
+	TimeZone         string
+	Weekdays         WeekdayMask
+	ValidFrom        time.Time            `gorm:"type:date"`
+	ValidUntil       time.Time            `gorm:"type:date"`
+	Stops            []TimetableStop      `gorm:"foreignKey:TimetableID;constraint:OnDelete:CASCADE"`
+	Exceptions       []TimetableException `gorm:"foreignKey:TimetableID;constraint:OnDelete:CASCADE"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// TimetableStop is a station on the route of a timetable. Minutes past 24
+// hours fall on the days after the service day.
+type TimetableStop struct {
+	ID              uint `gorm:"primaryKey"`
+	TimetableID     uint `gorm:"uniqueIndex:idx_timetable_stop_sequence"`
+	Sequence        uint `gorm:"uniqueIndex:idx_timetable_stop_sequence"`
+	Station         string
+	ArrivalMinute   uint32
+	DepartureMinute uint32
+}
+
+type TimetableException struct {
+	ID          uint      `gorm:"primaryKey"`
+	TimetableID uint      `gorm:"uniqueIndex:idx_timetable_exception_date"`
+	Date        time.Time `gorm:"type:date;uniqueIndex:idx_timetable_exception_date"`
+	Kind        ServiceException
+}
+
+func NewWeekdayMask(days ...time.Weekday) WeekdayMask {
+	var mask WeekdayMask
+	for _, day := range days {
+		mask |= 1 << day
+	}
+
+	return mask
+}
+
+func (m WeekdayMask) Has(day time.Weekday) bool {
+	return m&(1<<day) != 0
+}
+
+// ServiceDay is the calendar date of t, as midnight UTC.
+func ServiceDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Location is the time zone the stop times of the timetable are in.
+func (t *Timetable) Location() (*time.Location, error) {
+	location, err := time.LoadLocation(t.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unknown time zone %q", ErrInvalidTimetable, t.TimeZone)
+	}
+
+	return location, nil
+}
+
+// Validate checks the timetable and numbers its stops. The route is checked
+// the way the first run would be.
+func (t *Timetable) Validate() error {
+	t.Name = strings.TrimSpace(t.Name)
+	if t.Name == "" {
+		return fmt.Errorf("%w: timetable has no name", ErrInvalidTimetable)
+	}
+
+	if t.Capacity == 0 && t.LayoutTemplateID == 0 {
+		return fmt.Errorf("%w: runs need at least one seat or a layout", ErrInvalidTimetable)
+	}
+
+	if t.TimeZone == "" {
+		t.TimeZone = "UTC"
+	}
+
+	_, err := t.Location()
+	if err != nil {
+		return err
+	}
+
+	if t.Weekdays&^EveryDay != 0 {
+		return fmt.Errorf("%w: weekday mask %b has unknown days", ErrInvalidTimetable, t.Weekdays)
+	}
+
+	t.ValidFrom, t.ValidUntil = ServiceDay(t.ValidFrom), ServiceDay(t.ValidUntil)
+	if t.ValidUntil.Before(t.ValidFrom) {
+		return fmt.Errorf("%w: timetable ends before it starts", ErrInvalidTimetable)
+	}
+
+	dates := make(map[time.Time]bool, len(t.Exceptions))
+
+	for i := range t.Exceptions {
+		exception := &t.Exceptions[i]
+		exception.Date = ServiceDay(exception.Date)
+
+		if exception.Kind != ServiceRemoved && exception.Kind != ServiceAdded {
+			return fmt.Errorf("%w: unknown exception kind %q", ErrInvalidTimetable, exception.Kind)
+		}
+
+		if dates[exception.Date] {
+			return fmt.Errorf("%w: date %s has two exceptions", ErrInvalidTimetable, exception.Date.Format(time.DateOnly))
+		}
+		dates[exception.Date] = true
+	}
+
+	for i := range t.Stops {
+		t.Stops[i].Sequence = uint(i)
+	}
+
+	run, err := t.Run(t.ValidFrom, nil)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateRoute(run.Stops)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidTimetable, err)
+	}
+
+	return nil
+}
+
+// RunsOn reports whether the timetable has a run on the date.
+func (t *Timetable) RunsOn(date time.Time) bool {
+	date = ServiceDay(date)
+
+	for _, exception := range t.Exceptions {
+		if ServiceDay(exception.Date).Equal(date) {
+			return exception.Kind == ServiceAdded
+		}
+	}
+
+	if date.Before(ServiceDay(t.ValidFrom)) || date.After(ServiceDay(t.ValidUntil)) {
+		return false
+	}
+
+	return t.Weekdays.Has(date.Weekday())
+}
+
+// ServiceDates lists the dates from one to the other, both included, the
+// timetable has a run on.
+func (t *Timetable) ServiceDates(from, to time.Time) []time.Time {
+	var dates []time.Time
+
+	for date := ServiceDay(from); !date.After(ServiceDay(to)); date = date.AddDate(0, 0, 1) {
+		if t.RunsOn(date) {
+			dates = append(dates, date)
+		}
+	}
+
+	return dates
+}
+
+// Run is the train of the timetable on the date, with its seats and stops.
+// The seats are laid out in the coaches of the layout when it is given.
+// Stop times keep the wall clock of the timetable across daylight saving
+// changes.
+func (t *Timetable) Run(date time.Time, layout *LayoutTemplate) (*Train, error) {
+	location, err := t.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(t.Stops) == 0 {
+		return nil, fmt.Errorf("%w: a route needs at least two stops", ErrInvalidRoute)
+	}
+
+	date = ServiceDay(date)
+	at := func(minute uint32) time.Time {
+		return time.Date(date.Year(), date.Month(), date.Day(), 0, int(minute), 0, 0, location)
+	}
+
+	stops := make([]Stop, 0, len(t.Stops))
+	for _, stop := range t.Stops {
+		stops = append(stops, Stop{
+			Sequence:      stop.Sequence,
+			Station:       stop.Station,
+			ArrivalTime:   at(stop.ArrivalMinute),
+			DepartureTime: at(stop.DepartureMinute),
+		})
+	}
+
+	name := fmt.Sprintf("%s %s", t.Name, date.Format(time.DateOnly))
+
+	var run *Train
+	if layout != nil {
+		run = NewTrainFromLayout(name, layout)
+	} else {
+		run = NewTrain(name, t.Capacity)
+
+		for i := 1; i <= int(t.Capacity); i++ {
+			run.Seats = append(run.Seats, *NewSeat(0, uint(i)))
+		}
+	}
+
+	run.TrainTravelDetails = TravelDetailsOfRoute(stops)
+	run.Price = t.Price
+	run.Stops = stops
+	run.TimetableID = t.ID
+	run.ServiceDate = &date
+
+	return run, nil
+}
+
+// IsRetired reports whether a timetable update replaced the run. A retired
+// run keeps the bookings made on it but takes no new ones.
+func (t *Train) IsRetired() bool {
+	return t.RetiredAt != nil
+}
@@ -6,18 +6,20 @@ type Train struct {
 	ID   uint `gorm:"primaryKey"`
 	Name string
 	TrainTravelDetails
-	Capacity       uint32 // Total number of seats in the train
-	AvailableSeats uint32 // Number of seats available for booking
-	IsFull         bool   // Indicates if the train is fully booked
-	Seats          []Seat `gorm:"foreignKey:TrainID;constraint:OnDelete:CASCADE"`
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
-}
-
-type TrainFilters struct {
-	Name           string
-	AvailableSeats uint
-	TrainTravelDetails
+	Capacity       uint32  // Total number of seats in the train
+	AvailableSeats uint32  // Number of seats available for booking
+	IsFull         bool    // Indicates if the train is fully booked
+	Seats          []Seat  `gorm:"foreignKey:TrainID;constraint:OnDelete:CASCADE"`
+	Stops          []Stop  `gorm:"foreignKey:TrainID;constraint:OnDelete:CASCADE"`
+	Coaches        []Coach `gorm:"foreignKey:TrainID;constraint:OnDelete:CASCADE"`
+	Segment        Segment `gorm:"-"` // Part of the route the train is looked at for
+	// Timetable and date the train is a run of, zero for trains created by
+	// hand. Only one run in service is kept per date.
+	TimetableID uint       `gorm:"default:0;uniqueIndex:idx_train_timetable_active_run,where:timetable_id <> 0 AND retired_at IS NULL"`
+	ServiceDate *time.Time `gorm:"type:date;uniqueIndex:idx_train_timetable_active_run,where:timetable_id <> 0 AND retired_at IS NULL"`
+	RetiredAt   *time.Time `gorm:"index"` // Set once a timetable update replaced the run
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 }
 
 type TrainTravelDetails struct {
@@ -25,6 +27,7 @@ type TrainTravelDetails struct {
 	Origin        string
 	DepartureTime time.Time
 	ArrivalTime   time.Time
+	Price         int64 // Advertised starting fare in minor currency units, tickets are priced by the ticket service
 }
 
 func NewTrain(name string, capacity uint32) *Train {
@@ -33,15 +36,3 @@ func NewTrain(name string, capacity uint32) *Train {
 		Capacity: capacity,
 	}
 }
-
-func NewFilterTrains(name, origin, destination string, departureTime, arrivalTime time.Time) *TrainFilters {
-	return &TrainFilters{
-		Name:          name,
-		TrainTravelDetails: TrainTravelDetails{
-			Origin:        origin,
-			Destination:   destination,
-			DepartureTime: departureTime,
-			ArrivalTime:   arrivalTime,
-		},
-	}
-}
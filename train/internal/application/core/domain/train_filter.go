@@ -0,0 +1,176 @@
+package domain
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TrainSort orders a train search by departure time or travel duration, a
+// leading "-" reversing the order. Ties are broken by train ID. Searches do
+// not sort by price, fares are set by the fare table of the ticket service
+// and depend on the seat, segment and occupancy.
+type TrainSort string
+
+const (
+	SortByDeparture     TrainSort = "departure"
+	SortByDepartureDesc TrainSort = "-departure"
+	SortByDuration      TrainSort = "duration"
+	SortByDurationDesc  TrainSort = "-duration"
+)
+
+const (
+	DefaultTrainPageSize = 20
+	MaxTrainPageSize     = 100
+)
+
+var (
+	ErrInvalidTrainFilter = errors.New("train filter not allowed")
+	ErrInvalidTrainCursor = errors.New("train cursor not allowed: cursor is malformed or was issued for another sort")
+)
+
+// TrainFilters selects a page of trains. Every criterion that is set must
+// match, zero values leave a criterion out. The From bounds of the time
+// windows are inclusive and the To bounds exclusive. The cursor is the
+// NextCursor of the previous page.
+type TrainFilters struct {
+	Name              string
+	Origin            string
+	Destination       string
+	MinAvailableSeats uint
+	Class             string         // Class of the free seats
+	SeatAttributes    SeatAttributes // Attributes every free seat counted has
+	DepartureFrom     time.Time
+	DepartureTo       time.Time
+	ArrivalFrom       time.Time
+	ArrivalTo         time.Time
+	Sort              TrainSort
+	Cursor            string
+	Limit             int
+}
+
+// TrainPage is a page of a train search. NextCursor is empty on the last
+// page.
+type TrainPage struct {
+	Trains     []Train
+	NextCursor string
+}
+
+// TrainCursor is the position after the last train of a page.
+type TrainCursor struct {
+	Sort TrainSort
+	Key  int64
+	ID   uint
+}
+
+// Normalize validates the filters and fills in the default sort, soonest
+// departure first, and page size.
+func (f *TrainFilters) Normalize() error {
+	if !f.DepartureFrom.IsZero() && !f.DepartureTo.IsZero() && !f.DepartureFrom.Before(f.DepartureTo) {
+		return fmt.Errorf("%w: departure window is empty", ErrInvalidTrainFilter)
+	}
+
+	if !f.ArrivalFrom.IsZero() && !f.ArrivalTo.IsZero() && !f.ArrivalFrom.Before(f.ArrivalTo) {
+		return fmt.Errorf("%w: arrival window is empty", ErrInvalidTrainFilter)
+	}
+
+	if f.Class != "" {
+		class, err := ParseSeatClass(f.Class)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidTrainFilter, err)
+		}
+
+		f.Class = class
+	}
+
+	if f.Sort == "" {
+		f.Sort = SortByDeparture
+	}
+
+	switch f.Sort {
+	case SortByDeparture, SortByDepartureDesc, SortByDuration, SortByDurationDesc:
+	default:
+		return fmt.Errorf("%w: unknown sort %q, use departure or duration with an optional leading -", ErrInvalidTrainFilter, f.Sort)
+	}
+
+	if f.Limit < 0 || f.Limit > MaxTrainPageSize {
+		return fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidTrainFilter, MaxTrainPageSize)
+	}
+
+	if f.Limit == 0 {
+		f.Limit = DefaultTrainPageSize
+	}
+
+	return nil
+}
+
+// Field is the sort without its direction.
+func (s TrainSort) Field() TrainSort {
+	return TrainSort(strings.TrimPrefix(string(s), "-"))
+}
+
+// Descending reports whether the sort lists the latest or longest trains
+// first.
+func (s TrainSort) Descending() bool {
+	return strings.HasPrefix(string(s), "-")
+}
+
+// Key is the value of the train the sort orders by: the departure in Unix
+// nanoseconds or the duration in microseconds.
+func (s TrainSort) Key(train *Train) int64 {
+	switch s.Field() {
+	case SortByDuration:
+		return train.ArrivalTime.Sub(train.DepartureTime).Microseconds()
+	default:
+		return train.DepartureTime.UnixNano()
+	}
+}
+
+// NewTrainCursor points after the train in the given sort.
+func NewTrainCursor(sort TrainSort, train *Train) *TrainCursor {
+	return &TrainCursor{
+		Sort: sort,
+		Key:  sort.Key(train),
+		ID:   train.ID,
+	}
+}
+
+// Encode makes the cursor opaque to clients.
+func (c *TrainCursor) Encode() string {
+	raw := fmt.Sprintf("%s:%d:%d", c.Sort, c.Key, c.ID)
+
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeTrainCursor reads a cursor, which must have been issued for the
+// given sort.
+func DecodeTrainCursor(cursor string, sort TrainSort) (*TrainCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidTrainCursor
+	}
+
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 3 || TrainSort(parts[0]) != sort {
+		return nil, ErrInvalidTrainCursor
+	}
+
+	key, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidTrainCursor
+	}
+
+	ID, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidTrainCursor
+	}
+
+	return &TrainCursor{
+		Sort: sort,
+		Key:  key,
+		ID:   uint(ID),
+	}, nil
+}
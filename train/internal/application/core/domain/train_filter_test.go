@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDecodeTrainCursor(t *testing.T) {
+	train := &Train{
+		ID: 42,
+		TrainTravelDetails: TrainTravelDetails{
+			DepartureTime: time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC),
+			ArrivalTime:   time.Date(2026, time.March, 10, 15, 30, 0, 0, time.UTC),
+		},
+	}
+
+	tests := []struct {
+		name      string
+		cursor    string
+		sort      TrainSort
+		wantKey   int64
+		wantError error
+	}{
+		{
+			name:    "same sort",
+			cursor:  NewTrainCursor(SortByDeparture, train).Encode(),
+			sort:    SortByDeparture,
+			wantKey: train.DepartureTime.UnixNano(),
+		},
+		{
+			name:    "same descending sort",
+			cursor:  NewTrainCursor(SortByDurationDesc, train).Encode(),
+			sort:    SortByDurationDesc,
+			wantKey: (3*time.Hour + 30*time.Minute).Microseconds(),
+		},
+		{
+			name:      "issued for another sort",
+			cursor:    NewTrainCursor(SortByDuration, train).Encode(),
+			sort:      SortByDeparture,
+			wantError: ErrInvalidTrainCursor,
+		},
+		{
+			name:      "issued for the reversed sort",
+			cursor:    NewTrainCursor(SortByDeparture, train).Encode(),
+			sort:      SortByDepartureDesc,
+			wantError: ErrInvalidTrainCursor,
+		},
+		{
+			name:      "not base64",
+			cursor:    "not a cursor!",
+			sort:      SortByDeparture,
+			wantError: ErrInvalidTrainCursor,
+		},
+		{
+			name:      "missing the train ID",
+			cursor:    base64.RawURLEncoding.EncodeToString([]byte("duration:1500")),
+			sort:      SortByDuration,
+			wantError: ErrInvalidTrainCursor,
+		},
+		{
+			name:      "key not a number",
+			cursor:    base64.RawURLEncoding.EncodeToString([]byte("duration:long:42")),
+			sort:      SortByDuration,
+			wantError: ErrInvalidTrainCursor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := DecodeTrainCursor(tt.cursor, tt.sort)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("DecodeTrainCursor() error = %v, want %v", err, tt.wantError)
+			}
+
+			if tt.wantError != nil {
+				return
+			}
+
+			if cursor.Sort != tt.sort || cursor.Key != tt.wantKey || cursor.ID != train.ID {
+				t.Errorf("DecodeTrainCursor() = %+v, want sort %s, key %d, ID %d", cursor, tt.sort, tt.wantKey, train.ID)
+			}
+		})
+	}
+}
+
+func TestTrainFiltersNormalizeSort(t *testing.T) {
+	tests := []struct {
+		sort      TrainSort
+		wantSort  TrainSort
+		wantError error
+	}{
+		{sort: "", wantSort: SortByDeparture},
+		{sort: SortByDurationDesc, wantSort: SortByDurationDesc},
+		{sort: "price", wantError: ErrInvalidTrainFilter},
+		{sort: "-price", wantError: ErrInvalidTrainFilter},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.sort), func(t *testing.T) {
+			filters := &TrainFilters{Sort: tt.sort}
+
+			err := filters.Normalize()
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("Normalize() error = %v, want %v", err, tt.wantError)
+			}
+
+			if tt.wantError == nil && filters.Sort != tt.wantSort {
+				t.Errorf("Normalize() sort = %s, want %s", filters.Sort, tt.wantSort)
+			}
+		})
+	}
+}
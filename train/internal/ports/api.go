@@ -2,22 +2,38 @@ package ports
 
 import (
 	"context"
+	"time"
 	"train/internal/application/core/domain"
 )
 
 type APIPort interface {
-	CreateTrain(ctx context.Context, name string,capacity uint) error
+	CreateTrain(ctx context.Context, name string,capacity uint, layoutTemplateID uint) error
 	GetTrainByID(ctx context.Context, ID uint) (*domain.Train, error)
+	GetTrainSegment(ctx context.Context, ID uint, origin, destination string) (*domain.Train, error)
 	ListTrains(ctx context.Context) ([]domain.Train, error)
-	ListTrainsFiltered(ctx context.Context, trainFilters *domain.TrainFilters) ([]domain.Train, error)
+	ListTrainsFiltered(ctx context.Context, trainFilters *domain.TrainFilters) (*domain.TrainPage, error)
 	UpdateTrain(ctx context.Context, ID uint, name string) error
 	UpdateTrainTravelDetails(ctx context.Context, TrainID uint, travelDetails *domain.TrainTravelDetails) error
+	UpdateTrainStops(ctx context.Context, trainID uint, stops []domain.Stop) error
 	DeleteTrain(ctx context.Context, ID uint) error
-	CreateSeat(ctx context.Context, trainID uint, seatNumber uint) error
+	CreateSeat(ctx context.Context, trainID uint, seatNumber uint, class string) error
 	UpdateSeatNumber(ctx context.Context, ID uint, seatNumber uint) error
 	GetSeatByID(ctx context.Context, ID uint) (*domain.Seat, error)
-	SeatBooked(ctx context.Context, seatID, trainID,userID uint) error
-	CancelSeatBooking(ctx context.Context, seatID, trainID uint) error
+	SeatBooked(ctx context.Context, seatID, trainID,userID uint, segment domain.Segment) error
+	CancelSeatBooking(ctx context.Context, seatID, trainID, userID uint, segment domain.Segment) error
+	TransferSeatBooking(ctx context.Context, seatID, trainID, userID uint, segment domain.Segment) error
+	HoldSeats(ctx context.Context, trainID, userID uint, seatIDs []uint, segment domain.Segment, heldUntil time.Time) ([]domain.Seat, error)
+	ReleaseSeatHold(ctx context.Context, seatID, trainID, userID uint, segment domain.Segment) error
 	ListSeatsByTrainID(ctx context.Context, trainID uint) ([]domain.Seat, error)
+	ListSeatsBySegment(ctx context.Context, trainID uint, segment domain.Segment) ([]domain.Seat, error)
+	GetSeatMap(ctx context.Context, trainID uint, origin, destination string) (*domain.SeatMap, error)
 	DeleteSeat(ctx context.Context,ID uint)error
+	CreateLayoutTemplate(ctx context.Context, layout *domain.LayoutTemplate) (*domain.LayoutTemplate, error)
+	GetLayoutTemplateByID(ctx context.Context, ID uint) (*domain.LayoutTemplate, error)
+	ListLayoutTemplates(ctx context.Context) ([]domain.LayoutTemplate, error)
+	CreateTimetable(ctx context.Context, timetable *domain.Timetable) (*domain.Timetable, error)
+	GetTimetableByID(ctx context.Context, ID uint) (*domain.Timetable, error)
+	ListTimetables(ctx context.Context) ([]domain.Timetable, error)
+	UpdateTimetable(ctx context.Context, timetable *domain.Timetable) (*domain.Timetable, error)
+	GenerateTimetableRuns(ctx context.Context)
 }
@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 	"train/internal/application/core/domain"
 )
 
@@ -10,13 +11,15 @@ type DatabasePort interface {
 
 	GetTrainByID(ctx context.Context, ID uint) (*domain.Train, error)
 	ListTrains(ctx context.Context) ([]domain.Train, error)
-	ListTrainsFiltered(ctx context.Context, filter *domain.TrainFilters) ([]domain.Train, error)
+	ListTrainsFiltered(ctx context.Context, filter *domain.TrainFilters) (*domain.TrainPage, error)
 	IsTrainAvailable(ctx context.Context, trainID uint) (bool, error)
 
 	UpdateTrain(ctx context.Context, ID uint, name string) error
-	UpdateTrainTravelDetails(ctx context.Context, trainID uint, travelDetails *domain.TrainTravelDetails) error
+	UpdateTrainTravelDetails(ctx context.Context, trainID uint, travelDetails *domain.TrainTravelDetails, stops []domain.Stop) error
+	UpdateTrainStops(ctx context.Context, trainID uint, stops []domain.Stop) error
 	MinusTrainAvailableSeats(ctx context.Context, trainID uint) error
 	PlusTrainAvailableSeats(ctx context.Context, trainID uint) error
+	RecountTrainAvailableSeats(ctx context.Context, trainID uint) error
 
 	DeleteTrain(ctx context.Context, ID uint) error
 
@@ -24,7 +27,27 @@ type DatabasePort interface {
 	UpdateSeatNumber(ctx context.Context, ID uint, seatNumber uint) error
 	GetSeatByID(ctx context.Context, ID uint) (*domain.Seat, error)
 	ListSeatsByTrainID(ctx context.Context, trainID uint) ([]domain.Seat, error)
+	ListSeatsBySegment(ctx context.Context, trainID uint, segment domain.Segment) ([]domain.Seat, error)
+	ListFreeSeatsBySegment(ctx context.Context, trainID uint, segment domain.Segment) ([]domain.Seat, error)
+	IsSeatSegmentBooked(ctx context.Context, seatID uint, segment domain.Segment) (bool, error)
+	IsSeatSegmentHeld(ctx context.Context, seatID, userID uint, segment domain.Segment) (bool, error)
+	CreateSeatSegment(ctx context.Context, seatSegment *domain.SeatSegment) error
+	DeleteSeatSegment(ctx context.Context, seatID uint, segment domain.Segment, userID uint) error
+	UpdateSeatSegmentUser(ctx context.Context, seatID uint, segment domain.Segment, userID uint) error
 	UpdateSeatBookingStatus(ctx context.Context, seatID uint, booked bool) error
 	UpdateSeatUser(ctx context.Context, seatID,userID uint) error
+	HoldSeats(ctx context.Context, trainID, userID uint, seatIDs []uint, segment domain.Segment, heldUntil time.Time) ([]domain.Seat, error)
+	ReleaseSeatHold(ctx context.Context, seatID, userID uint, segment domain.Segment) error
 	DeleteSeat(ctx context.Context, ID uint) error
+
+	CreateLayoutTemplate(ctx context.Context, layout *domain.LayoutTemplate) error
+	GetLayoutTemplateByID(ctx context.Context, ID uint) (*domain.LayoutTemplate, error)
+	ListLayoutTemplates(ctx context.Context) ([]domain.LayoutTemplate, error)
+
+	CreateTimetable(ctx context.Context, timetable *domain.Timetable) error
+	GetTimetableByID(ctx context.Context, ID uint) (*domain.Timetable, error)
+	ListTimetables(ctx context.Context) ([]domain.Timetable, error)
+	UpdateTimetable(ctx context.Context, timetable *domain.Timetable) error
+	ListTimetableRunDates(ctx context.Context, timetableID uint, from time.Time) ([]time.Time, error)
+	RetireUnbookedTimetableRuns(ctx context.Context, timetableID uint, after time.Time) error
 }
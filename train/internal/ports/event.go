@@ -8,16 +8,30 @@ type TrainEventResponder interface {
 	ReplyToListTrains(ctx context.Context) error
 	ReplyToListTrainsFiltered(ctx context.Context) error
 	ReplyToGetTrainByID(ctx context.Context) error
+	ReplyToGetTrainSegment(ctx context.Context) error
 	ReplyToCreateTrain(ctx context.Context) error
 	ReplyToUpdateTrainName(ctx context.Context) error
 	ReplyToUpdateTrainTravelDetails(ctx context.Context) error
+	ReplyToUpdateTrainStops(ctx context.Context) error
 	ReplyToDeleteTrainByID(ctx context.Context) error
 	ReplyToListSeatsByTrainID(ctx context.Context) error
+	ReplyToListSeatsBySegment(ctx context.Context) error
+	ReplyToGetSeatMap(ctx context.Context) error
+	ReplyToHoldSeats(ctx context.Context) error
 
 	ReplyToGetSeatByID(ctx context.Context) error
 	ReplyToCreateSeat(ctx context.Context) error
 	ReplyToUpdateSeatNumberBySeatID(ctx context.Context) error
 	ReplyToDeleteSeatBySeatID(ctx context.Context) error
+
+	ReplyToCreateLayoutTemplate(ctx context.Context) error
+	ReplyToGetLayoutTemplateByID(ctx context.Context) error
+	ReplyToListLayoutTemplates(ctx context.Context) error
+
+	ReplyToCreateTimetable(ctx context.Context) error
+	ReplyToGetTimetableByID(ctx context.Context) error
+	ReplyToListTimetables(ctx context.Context) error
+	ReplyToUpdateTimetable(ctx context.Context) error
 }
 
 type SeatEventsConsumer interface {
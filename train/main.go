@@ -45,7 +45,9 @@ func main() {
 
 	databaseAdapter := postgres.NewPostgresDBAdapter(db)
 
-	api := api.NewAPIAdapter(databaseAdapter)
+	api := api.NewAPIAdapter(databaseAdapter, api.Options{
+		TimetableHorizonDays: config.GetTimetableHorizonDays(),
+	})
 
 	trainEventResponder := nats.NewTrainEventResponderAdapter(natsConn, api)
 
@@ -70,6 +72,13 @@ func main() {
 		}
 	}()
 
+	go func() {
+		err := trainEventResponder.ReplyToGetTrainSegment(ctx)
+		if err != nil {
+			log.Printf("Error replying to get train segment: %v", err)
+		}
+	}()
+
 	go func() {
 		err := trainEventResponder.ReplyToCreateTrain(ctx)
 		if err != nil {
@@ -91,6 +100,13 @@ func main() {
 		}
 	}()
 
+	go func() {
+		err := trainEventResponder.ReplyToUpdateTrainStops(ctx)
+		if err != nil {
+			log.Printf("Error replying to update train stops: %v", err)
+		}
+	}()
+
 	go func() {
 		err := trainEventResponder.ReplyToDeleteTrainByID(ctx)
 		if err != nil {
@@ -105,6 +121,27 @@ func main() {
 		}
 	}()
 
+	go func() {
+		err := trainEventResponder.ReplyToListSeatsBySegment(ctx)
+		if err != nil {
+			log.Printf("Error replying to list seats by segment: %v", err)
+		}
+	}()
+
+	go func() {
+		err := trainEventResponder.ReplyToGetSeatMap(ctx)
+		if err != nil {
+			log.Printf("Error replying to get seat map: %v", err)
+		}
+	}()
+
+	go func() {
+		err := trainEventResponder.ReplyToHoldSeats(ctx)
+		if err != nil {
+			log.Printf("Error replying to hold seats: %v", err)
+		}
+	}()
+
 	go func() {
 		err := trainEventResponder.ReplyToGetSeatByID(ctx)
 		if err != nil {
@@ -133,6 +170,55 @@ func main() {
 		}
 	}()
 
+	go func() {
+		err := trainEventResponder.ReplyToCreateLayoutTemplate(ctx)
+		if err != nil {
+			log.Printf("Error replying to create layout template: %v", err)
+		}
+	}()
+
+	go func() {
+		err := trainEventResponder.ReplyToGetLayoutTemplateByID(ctx)
+		if err != nil {
+			log.Printf("Error replying to get layout template by ID: %v", err)
+		}
+	}()
+
+	go func() {
+		err := trainEventResponder.ReplyToListLayoutTemplates(ctx)
+		if err != nil {
+			log.Printf("Error replying to list layout templates: %v", err)
+		}
+	}()
+
+	go func() {
+		err := trainEventResponder.ReplyToCreateTimetable(ctx)
+		if err != nil {
+			log.Printf("Error replying to create timetable: %v", err)
+		}
+	}()
+
+	go func() {
+		err := trainEventResponder.ReplyToGetTimetableByID(ctx)
+		if err != nil {
+			log.Printf("Error replying to get timetable by ID: %v", err)
+		}
+	}()
+
+	go func() {
+		err := trainEventResponder.ReplyToListTimetables(ctx)
+		if err != nil {
+			log.Printf("Error replying to list timetables: %v", err)
+		}
+	}()
+
+	go func() {
+		err := trainEventResponder.ReplyToUpdateTimetable(ctx)
+		if err != nil {
+			log.Printf("Error replying to update timetable: %v", err)
+		}
+	}()
+
 	trainEventConsumer, err := nats.NewNatsEventConsumer(natsConn, api)
 	if err != nil {
 		log.Fatalf("Error creating user event publisher: %v", err)
@@ -145,6 +231,8 @@ func main() {
 		}
 	}()
 
+	go api.GenerateTimetableRuns(ctx)
+
 	log.Println("Train service is starting....")
 
 	<-ctx.Done()
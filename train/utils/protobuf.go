@@ -1,20 +1,21 @@
 package utils
 
 import (
+	"time"
 	"train/gen"
 	"train/internal/application/core/domain"
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
-func UnmarshalCreateSeatRequest(data []byte) (uint, uint, error) {
+func UnmarshalCreateSeatRequest(data []byte) (uint, uint, string, error) {
 	protoSeat := &gen.CreateSeatRequest{}
 	err := proto.Unmarshal(data, protoSeat)
 	if err != nil {
-		return 0, 0, err
+		return 0, 0, "", err
 	}
 
-	return uint(protoSeat.TrainId), uint(protoSeat.Number), nil
+	return uint(protoSeat.TrainId), uint(protoSeat.Number), protoSeat.Class, nil
 }
 
 func UnmarshalUpdateSeatNumberRequest(data []byte) (uint, uint, error) {
@@ -27,14 +28,14 @@ func UnmarshalUpdateSeatNumberRequest(data []byte) (uint, uint, error) {
 	return uint(protoSeat.ID), uint(protoSeat.Number), nil
 }
 
-func UnmarshalCreateTrainRequest(data []byte) (string, uint, error) {
+func UnmarshalCreateTrainRequest(data []byte) (string, uint, uint, error) {
 	protoTrain := &gen.CreateTrainRequest{}
 	err := proto.Unmarshal(data, protoTrain)
 	if err != nil {
-		return "", 0, err
+		return "", 0, 0, err
 	}
 
-	return protoTrain.Name, uint(protoTrain.Capacity), nil
+	return protoTrain.Name, uint(protoTrain.Capacity), uint(protoTrain.LayoutTemplateId), nil
 }
 
 func UnmarshalUpdateTrainNameRequest(data []byte) (uint, string, error) {
@@ -59,11 +60,29 @@ func UnmarshalUpdateTrainTravelDetailsRequest(data []byte) (uint, *domain.TrainT
 		Destination:   protoTrain.Destination,
 		DepartureTime: protoTrain.DepartureTime.AsTime(),
 		ArrivalTime:   protoTrain.ArrivalTime.AsTime(),
+		Price:         protoTrain.Price,
 	}
 
 	return uint(protoTrain.ID), travelDetails, nil
 }
 
+func UnmarshalHoldSeatsRequest(data []byte) (uint, uint, []uint, domain.Segment, time.Time, error) {
+	protoRequest := &gen.HoldSeatsRequest{}
+	err := proto.Unmarshal(data, protoRequest)
+	if err != nil {
+		return 0, 0, nil, domain.Segment{}, time.Time{}, err
+	}
+
+	seatIDs := make([]uint, 0, len(protoRequest.SeatIds))
+	for _, seatID := range protoRequest.SeatIds {
+		seatIDs = append(seatIDs, uint(seatID))
+	}
+
+	segment := domain.Segment{FromStop: uint(protoRequest.FromStop), ToStop: uint(protoRequest.ToStop)}
+
+	return uint(protoRequest.TrainId), uint(protoRequest.UserId), seatIDs, segment, protoRequest.HeldUntil.AsTime(), nil
+}
+
 func MarshalListTrainsReplay(trains []domain.Train) ([]byte, error) {
 	protoListTrainsReplay := &gen.ListTrainsReplay{}
 
@@ -100,17 +119,53 @@ func UnmarshalFilters(data []byte) (*domain.TrainFilters, error) {
 		return nil, err
 	}
 
-	trainFilter := domain.NewFilterTrains(
-		protoTrainFilter.Name,
-		protoTrainFilter.Origin,
-		protoTrainFilter.Destination,
-		protoTrainFilter.DepartureTime.AsTime(),
-		protoTrainFilter.ArrivalTime.AsTime(),
-	)
+	trainFilter := &domain.TrainFilters{
+		Name:              protoTrainFilter.Name,
+		Origin:            protoTrainFilter.Origin,
+		Destination:       protoTrainFilter.Destination,
+		MinAvailableSeats: uint(protoTrainFilter.MinAvailableSeats),
+		Sort:              domain.TrainSort(protoTrainFilter.Sort),
+		Class:             protoTrainFilter.Class,
+		Cursor:            protoTrainFilter.Cursor,
+		Limit:             int(protoTrainFilter.Limit),
+	}
+
+	trainFilter.SeatAttributes, err = domain.ParseSeatAttributes(protoTrainFilter.SeatAttributes)
+	if err != nil {
+		return nil, err
+	}
+
+	if protoTrainFilter.DepartureFrom != nil {
+		trainFilter.DepartureFrom = protoTrainFilter.DepartureFrom.AsTime()
+	}
+
+	if protoTrainFilter.DepartureTo != nil {
+		trainFilter.DepartureTo = protoTrainFilter.DepartureTo.AsTime()
+	}
+
+	if protoTrainFilter.ArrivalFrom != nil {
+		trainFilter.ArrivalFrom = protoTrainFilter.ArrivalFrom.AsTime()
+	}
+
+	if protoTrainFilter.ArrivalTo != nil {
+		trainFilter.ArrivalTo = protoTrainFilter.ArrivalTo.AsTime()
+	}
 
 	return trainFilter, nil
 }
 
+func MarshalTrainPage(page *domain.TrainPage) ([]byte, error) {
+	protoListTrainsReplay := &gen.ListTrainsReplay{
+		NextCursor: page.NextCursor,
+	}
+
+	for i := range page.Trains {
+		protoListTrainsReplay.Trains = append(protoListTrainsReplay.Trains, convertTrainToProtoTrain(&page.Trains[i]))
+	}
+
+	return proto.Marshal(protoListTrainsReplay)
+}
+
 func MarshalSeats(seats []domain.Seat) ([]byte, error) {
 	protoSeats := &gen.ListSeatsReplay{}
 
@@ -138,12 +193,14 @@ func MarshalSeat(seat *domain.Seat) ([]byte, error) {
 	return data, nil
 }
 
-func UnmarshalSeat(data []byte) (*domain.Seat, error) {
+// UnmarshalSeat reads a seat event, with the segment of the route it is
+// about. The segment is zero for the whole route.
+func UnmarshalSeat(data []byte) (*domain.Seat, domain.Segment, error) {
 	var protoSeat = gen.Seat{}
 
 	err := proto.Unmarshal(data, &protoSeat)
 	if err != nil {
-		return nil, err
+		return nil, domain.Segment{}, err
 	}
 
 	seat := &domain.Seat{
@@ -153,7 +210,221 @@ func UnmarshalSeat(data []byte) (*domain.Seat, error) {
 		SeatNumber: uint(protoSeat.SeatNumber),
 	}
 
-	return seat, nil
+	segment := domain.Segment{
+		FromStop: uint(protoSeat.FromStop),
+		ToStop:   uint(protoSeat.ToStop),
+	}
+
+	return seat, segment, nil
+}
+
+func UnmarshalUpdateTrainStopsRequest(data []byte) (uint, []domain.Stop, error) {
+	protoRequest := &gen.UpdateTrainStopsRequest{}
+	err := proto.Unmarshal(data, protoRequest)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	stops := make([]domain.Stop, 0, len(protoRequest.Stops))
+	for _, protoStop := range protoRequest.Stops {
+		stops = append(stops, domain.Stop{
+			Station:       protoStop.Station,
+			ArrivalTime:   protoStop.ArrivalTime.AsTime(),
+			DepartureTime: protoStop.DepartureTime.AsTime(),
+		})
+	}
+
+	return uint(protoRequest.TrainId), stops, nil
+}
+
+func UnmarshalTrainSegmentRequest(data []byte) (uint, string, string, error) {
+	protoRequest := &gen.TrainSegmentRequest{}
+	err := proto.Unmarshal(data, protoRequest)
+	if err != nil {
+		return 0, "", "", err
+	}
+
+	return uint(protoRequest.TrainId), protoRequest.Origin, protoRequest.Destination, nil
+}
+
+func UnmarshalListSegmentSeatsRequest(data []byte) (uint, domain.Segment, error) {
+	protoRequest := &gen.ListSegmentSeatsRequest{}
+	err := proto.Unmarshal(data, protoRequest)
+	if err != nil {
+		return 0, domain.Segment{}, err
+	}
+
+	segment := domain.Segment{
+		FromStop: uint(protoRequest.FromStop),
+		ToStop:   uint(protoRequest.ToStop),
+	}
+
+	return uint(protoRequest.TrainId), segment, nil
+}
+
+func UnmarshalTimetable(data []byte) (*domain.Timetable, error) {
+	protoTimetable := &gen.Timetable{}
+	err := proto.Unmarshal(data, protoTimetable)
+	if err != nil {
+		return nil, err
+	}
+
+	timetable := &domain.Timetable{
+		ID:               uint(protoTimetable.ID),
+		Name:             protoTimetable.Name,
+		Capacity:         protoTimetable.Capacity,
+		LayoutTemplateID: uint(protoTimetable.LayoutTemplateId),
+		Price:            protoTimetable.Price,
+		TimeZone:         protoTimetable.TimeZone,
+		Weekdays:         domain.WeekdayMask(protoTimetable.Weekdays),
+		ValidFrom:        protoTimetable.ValidFrom.AsTime(),
+		ValidUntil:       protoTimetable.ValidUntil.AsTime(),
+	}
+
+	for _, protoStop := range protoTimetable.Stops {
+		timetable.Stops = append(timetable.Stops, domain.TimetableStop{
+			Station:         protoStop.Station,
+			ArrivalMinute:   protoStop.ArrivalMinute,
+			DepartureMinute: protoStop.DepartureMinute,
+		})
+	}
+
+	for _, protoException := range protoTimetable.Exceptions {
+		timetable.Exceptions = append(timetable.Exceptions, domain.TimetableException{
+			Date: protoException.Date.AsTime(),
+			Kind: domain.ServiceException(protoException.Kind),
+		})
+	}
+
+	return timetable, nil
+}
+
+func MarshalTimetable(timetable *domain.Timetable) ([]byte, error) {
+	return proto.Marshal(convertTimetableToProtoTimetable(timetable))
+}
+
+func MarshalTimetables(timetables []domain.Timetable) ([]byte, error) {
+	protoListTimetablesReplay := &gen.ListTimetablesReplay{}
+
+	for i := range timetables {
+		protoListTimetablesReplay.Timetables = append(protoListTimetablesReplay.Timetables, convertTimetableToProtoTimetable(&timetables[i]))
+	}
+
+	return proto.Marshal(protoListTimetablesReplay)
+}
+
+// UnmarshalLayoutTemplate reads a layout template, failing on unknown seat
+// attributes.
+func UnmarshalLayoutTemplate(data []byte) (*domain.LayoutTemplate, error) {
+	protoLayout := &gen.LayoutTemplate{}
+	err := proto.Unmarshal(data, protoLayout)
+	if err != nil {
+		return nil, err
+	}
+
+	layout := &domain.LayoutTemplate{
+		ID:   uint(protoLayout.ID),
+		Name: protoLayout.Name,
+	}
+
+	for _, protoCoach := range protoLayout.Coaches {
+		coach := domain.CoachTemplate{
+			Class:   protoCoach.Class,
+			Rows:    uint(protoCoach.Rows),
+			Columns: uint(protoCoach.Columns),
+		}
+
+		for _, protoSeat := range protoCoach.Seats {
+			attributes, err := domain.ParseSeatAttributes(protoSeat.Attributes)
+			if err != nil {
+				return nil, err
+			}
+
+			coach.Seats = append(coach.Seats, domain.SeatTemplate{
+				Row:        uint(protoSeat.Row),
+				Column:     uint(protoSeat.Column),
+				Attributes: attributes,
+			})
+		}
+
+		layout.Coaches = append(layout.Coaches, coach)
+	}
+
+	return layout, nil
+}
+
+func MarshalLayoutTemplate(layout *domain.LayoutTemplate) ([]byte, error) {
+	return proto.Marshal(convertLayoutTemplateToProtoLayoutTemplate(layout))
+}
+
+func MarshalLayoutTemplates(layouts []domain.LayoutTemplate) ([]byte, error) {
+	protoListLayoutTemplatesReplay := &gen.ListLayoutTemplatesReplay{}
+
+	for i := range layouts {
+		protoListLayoutTemplatesReplay.LayoutTemplates = append(protoListLayoutTemplatesReplay.LayoutTemplates, convertLayoutTemplateToProtoLayoutTemplate(&layouts[i]))
+	}
+
+	return proto.Marshal(protoListLayoutTemplatesReplay)
+}
+
+func convertLayoutTemplateToProtoLayoutTemplate(layout *domain.LayoutTemplate) *gen.LayoutTemplate {
+	protoLayout := &gen.LayoutTemplate{
+		ID:   uint32(layout.ID),
+		Name: layout.Name,
+	}
+
+	for _, coach := range layout.Coaches {
+		protoCoach := &gen.CoachTemplate{
+			Number:  uint32(coach.Number),
+			Class:   coach.Class,
+			Rows:    uint32(coach.Rows),
+			Columns: uint32(coach.Columns),
+		}
+
+		for _, seat := range coach.Seats {
+			protoCoach.Seats = append(protoCoach.Seats, &gen.SeatTemplate{
+				Row:        uint32(seat.Row),
+				Column:     uint32(seat.Column),
+				Attributes: seat.Attributes.Names(),
+			})
+		}
+
+		protoLayout.Coaches = append(protoLayout.Coaches, protoCoach)
+	}
+
+	return protoLayout
+}
+
+func convertTimetableToProtoTimetable(timetable *domain.Timetable) *gen.Timetable {
+	protoTimetable := &gen.Timetable{
+		ID:               uint32(timetable.ID),
+		Name:             timetable.Name,
+		Capacity:         timetable.Capacity,
+		LayoutTemplateId: uint32(timetable.LayoutTemplateID),
+		Price:            timetable.Price,
+		TimeZone:         timetable.TimeZone,
+		Weekdays:         uint32(timetable.Weekdays),
+		ValidFrom:        timestamppb.New(timetable.ValidFrom),
+		ValidUntil:       timestamppb.New(timetable.ValidUntil),
+	}
+
+	for _, stop := range timetable.Stops {
+		protoTimetable.Stops = append(protoTimetable.Stops, &gen.TimetableStop{
+			Sequence:        uint32(stop.Sequence),
+			Station:         stop.Station,
+			ArrivalMinute:   stop.ArrivalMinute,
+			DepartureMinute: stop.DepartureMinute,
+		})
+	}
+
+	for _, exception := range timetable.Exceptions {
+		protoTimetable.Exceptions = append(protoTimetable.Exceptions, &gen.TimetableException{
+			Date: timestamppb.New(exception.Date),
+			Kind: string(exception.Kind),
+		})
+	}
+
+	return protoTimetable
 }
 
 func convertTrainToProtoTrain(train *domain.Train) *gen.Train {
@@ -162,10 +433,32 @@ func convertTrainToProtoTrain(train *domain.Train) *gen.Train {
 		Name:          train.Name,
 		Origin:        train.Origin,
 		Destination:   train.Destination,
-		Capacity:      train.Capacity,
-		IsFull:        train.IsFull,
-		DepartureTime: timestamppb.New(train.DepartureTime),
-		ArrivalTime:   timestamppb.New(train.ArrivalTime),
+		Capacity:       train.Capacity,
+		AvailableSeats: train.AvailableSeats,
+		IsFull:         train.IsFull,
+		DepartureTime:  timestamppb.New(train.DepartureTime),
+		ArrivalTime:    timestamppb.New(train.ArrivalTime),
+		Price:          train.Price,
+		FromStop:       uint32(train.Segment.FromStop),
+		ToStop:         uint32(train.Segment.ToStop),
+		TimetableId:    uint32(train.TimetableID),
+	}
+
+	if train.ServiceDate != nil {
+		protoTrain.ServiceDate = timestamppb.New(*train.ServiceDate)
+	}
+
+	for _, stop := range train.Stops {
+		protoTrain.Stops = append(protoTrain.Stops, &gen.Stop{
+			Sequence:      uint32(stop.Sequence),
+			Station:       stop.Station,
+			ArrivalTime:   timestamppb.New(stop.ArrivalTime),
+			DepartureTime: timestamppb.New(stop.DepartureTime),
+		})
+	}
+
+	for _, coach := range train.Coaches {
+		protoTrain.Coaches = append(protoTrain.Coaches, convertCoachToProtoCoach(&coach))
 	}
 
 	for _, seat := range train.Seats {
@@ -176,11 +469,53 @@ func convertTrainToProtoTrain(train *domain.Train) *gen.Train {
 	return protoTrain
 }
 
+func convertCoachToProtoCoach(coach *domain.Coach) *gen.Coach {
+	return &gen.Coach{
+		ID:      uint32(coach.ID),
+		Number:  uint32(coach.Number),
+		Class:   coach.Class,
+		Rows:    uint32(coach.Rows),
+		Columns: uint32(coach.Columns),
+	}
+}
+
 func convertSeatToProtoSeat(seat *domain.Seat) *gen.Seat {
 	return &gen.Seat{
 		ID:         uint32(seat.ID),
 		TrainId:    uint32(seat.TrainID),
 		UserId:     uint32(seat.UserID),
 		SeatNumber: uint32(seat.SeatNumber),
+		Class:      seat.Class,
+		Booked:     seat.Booked,
+		Held:       seat.IsHeld(time.Now()),
+		CoachId:    uint32(seat.CoachID),
+		Row:        uint32(seat.Row),
+		Column:     uint32(seat.Column),
+		Attributes: seat.Attributes.Names(),
+		State:      string(seat.State(time.Now())),
+	}
+}
+
+func MarshalSeatMap(seatMap *domain.SeatMap) ([]byte, error) {
+	protoSeatMap := &gen.SeatMap{
+		Train: convertTrainToProtoTrain(seatMap.Train),
 	}
+
+	for _, coach := range seatMap.Coaches {
+		protoCoach := &gen.SeatMapCoach{
+			Coach: convertCoachToProtoCoach(&coach),
+		}
+
+		for _, seat := range coach.Seats {
+			protoCoach.Seats = append(protoCoach.Seats, convertSeatToProtoSeat(&seat))
+		}
+
+		protoSeatMap.Coaches = append(protoSeatMap.Coaches, protoCoach)
+	}
+
+	for _, seat := range seatMap.Seats {
+		protoSeatMap.Seats = append(protoSeatMap.Seats, convertSeatToProtoSeat(&seat))
+	}
+
+	return proto.Marshal(protoSeatMap)
 }
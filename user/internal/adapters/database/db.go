@@ -14,7 +14,7 @@ func NewDB(url string) (*gorm.DB, error) {
 		return nil, fmt.Errorf("db connection error: %v", openErr)
 	}
 
-	err := db.AutoMigrate(&domain.User{})
+	err := db.AutoMigrate(&domain.User{}, &domain.OutboxMessage{}, &domain.IdempotencyRecord{})
 	if err != nil {
 		return nil, fmt.Errorf("db migration error: %v", err)
 	}
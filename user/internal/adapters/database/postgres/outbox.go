@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"user/internal/application/core/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func createOutboxMessage(tx *gorm.DB, user *domain.User, newEvent domain.OutboxMessageFactory) error {
+	message, err := newEvent(user)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Create(message).Error
+	if err != nil {
+		return fmt.Errorf("create outbox message: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimOutboxMessages claims up to limit due messages, each the oldest
+// pending message of its user, and leases them for
+// domain.OutboxClaimLease. Other relays skip a leased message, so it can be
+// published after the claim commits without two instances sending it at
+// once.
+func (u *DatabasePostgresAdapter) ClaimOutboxMessages(ctx context.Context, limit int, now time.Time) ([]domain.OutboxMessage, error) {
+	var messages []domain.OutboxMessage
+
+	err := u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("sent_at IS NULL AND dead_at IS NULL").
+			Where("(next_attempt_at IS NULL OR next_attempt_at <= ?)", now).
+			Where(`NOT EXISTS (SELECT 1 FROM outbox_messages earlier
+				WHERE earlier.aggregate_key = outbox_messages.aggregate_key AND earlier.id < outbox_messages.id
+				AND earlier.sent_at IS NULL AND earlier.dead_at IS NULL)`).
+			Order("id").Limit(limit).Find(&messages).Error
+		if err != nil {
+			return fmt.Errorf("claim outbox messages: %w", err)
+		}
+
+		if len(messages) == 0 {
+			return nil
+		}
+
+		messageIDs := make([]uint, 0, len(messages))
+		for i := range messages {
+			messages[i].Claim(now)
+			messageIDs = append(messageIDs, messages[i].ID)
+		}
+
+		err = tx.Model(&domain.OutboxMessage{}).Where("id IN ?", messageIDs).Update("next_attempt_at", messages[0].NextAttemptAt).Error
+		if err != nil {
+			return fmt.Errorf("lease outbox messages: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+// SaveOutboxMessage saves the outcome of publishing a claimed message.
+func (u *DatabasePostgresAdapter) SaveOutboxMessage(ctx context.Context, message *domain.OutboxMessage) error {
+	err := u.db.WithContext(ctx).Model(&domain.OutboxMessage{}).Where("id = ?", message.ID).Updates(map[string]any{
+		"attempts":        message.Attempts,
+		"last_error":      message.LastError,
+		"next_attempt_at": message.NextAttemptAt,
+		"sent_at":         message.SentAt,
+		"dead_at":         message.DeadAt,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("save outbox message: message ID:%d \n%w", message.ID, err)
+	}
+
+	return nil
+}
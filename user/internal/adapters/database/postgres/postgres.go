@@ -25,17 +25,20 @@ func NewDatabasePostgresAdapter(db *gorm.DB) *DatabasePostgresAdapter {
 	}
 }
 
-func (u *DatabasePostgresAdapter) SaveUser(ctx context.Context, user *domain.User) error {
-	err := u.db.Create(user).Error
-	if err != nil {
-		if utils.CheckErrorForWord(err, "email") {
-			return ErrEmailAlreadyExists
+func (u *DatabasePostgresAdapter) SaveUser(ctx context.Context, user *domain.User, newEvent domain.OutboxMessageFactory) error {
+	err := u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(user).Error
+		if err != nil {
+			if utils.CheckErrorForWord(err, "email") {
+				return ErrEmailAlreadyExists
+			}
+			return fmt.Errorf("save user: %v \n%w", user, err)
 		}
-		return fmt.Errorf("save user: %v \n%w", user, err)
-	}
 
-	return nil
+		return createOutboxMessage(tx, user, newEvent)
+	})
 
+	return err
 }
 
 func (u *DatabasePostgresAdapter) GetUserByID(ctx context.Context, ID uint) (*domain.User, error) {
@@ -49,6 +52,17 @@ func (u *DatabasePostgresAdapter) GetUserByID(ctx context.Context, ID uint) (*do
 	return user, nil
 }
 
+func (u *DatabasePostgresAdapter) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
+	user := &domain.User{}
+
+	err := u.db.WithContext(ctx).Where("email = ?", email).First(user).Error
+	if err != nil {
+		return nil, fmt.Errorf("get user by email: %s: %w", email, err)
+	}
+
+	return user, nil
+}
+
 func (u *DatabasePostgresAdapter) ListUsers(ctx context.Context) ([]domain.User, error) {
 	var users []domain.User
 	err := u.db.WithContext(ctx).Find(&users).Error
@@ -59,7 +73,7 @@ func (u *DatabasePostgresAdapter) ListUsers(ctx context.Context) ([]domain.User,
 	return users, nil
 }
 
-func (u *DatabasePostgresAdapter) UpdateUser(ctx context.Context, ID uint, firstName, lastName string) (*domain.User,error) {
+func (u *DatabasePostgresAdapter) UpdateUser(ctx context.Context, ID uint, firstName, lastName string, newEvent domain.OutboxMessageFactory) (*domain.User,error) {
 	user, err := u.GetUserByID(ctx, ID)
 	if err != nil {
 		return nil,err
@@ -68,9 +82,17 @@ func (u *DatabasePostgresAdapter) UpdateUser(ctx context.Context, ID uint, first
 	user.FirstName = firstName
 	user.LastName = lastName
 
-	err = u.db.WithContext(ctx).Save(user).Error
+	err = u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Save(user).Error
+		if err != nil {
+			return fmt.Errorf("update user by id: user ID:%d ,first name:%s ,last name:%s \n%w", ID, firstName, lastName, err)
+		}
+
+		return createOutboxMessage(tx, user, newEvent)
+	})
+
 	if err != nil {
-		return  nil,fmt.Errorf("update user by id: user ID:%d ,first name:%s ,last name:%s \n%w", ID, firstName, lastName, err)
+		return nil, err
 	}
 
 	return user,nil
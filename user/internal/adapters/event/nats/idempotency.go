@@ -0,0 +1,64 @@
+package nats
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"user/internal/application/core/domain"
+
+	"github.com/nats-io/nats.go"
+)
+
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// respondIdempotent replies with the result of handle. A request carrying
+// an idempotency key is handled once, retries with the same key get the
+// stored reply of the first successful attempt. A key left pending by an
+// attempt that crashed is taken over once its lease has run out.
+func (u *UserEventResponderAdapter) respondIdempotent(ctx context.Context, msg *nats.Msg, handle func() ([]byte, error)) {
+	key := msg.Header.Get(IdempotencyKeyHeader)
+	if key == "" {
+		respond(msg, handle)
+		return
+	}
+
+	record, err := u.API.BeginIdempotentRequest(ctx, msg.Subject, key, msg.Data)
+	if err != nil {
+		errString := fmt.Errorf("error idempotency key %s: %w", key, err).Error()
+		msg.Respond([]byte(errString))
+		return
+	}
+
+	if record.Status == domain.IdempotencyCompleted {
+		msg.Respond(record.Response)
+		return
+	}
+
+	data, err := handle()
+	if err != nil {
+		abortErr := u.API.AbortIdempotentRequest(ctx, record)
+		if abortErr != nil {
+			log.Printf("abort idempotent request %s error:%v\n", key, abortErr)
+		}
+
+		msg.Respond([]byte(err.Error()))
+		return
+	}
+
+	err = u.API.CompleteIdempotentRequest(ctx, record, data)
+	if err != nil {
+		log.Printf("complete idempotent request %s error:%v\n", key, err)
+	}
+
+	msg.Respond(data)
+}
+
+func respond(msg *nats.Msg, handle func() ([]byte, error)) {
+	data, err := handle()
+	if err != nil {
+		msg.Respond([]byte(err.Error()))
+		return
+	}
+
+	msg.Respond(data)
+}
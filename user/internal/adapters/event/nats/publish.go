@@ -2,18 +2,16 @@ package nats
 
 import (
 	"context"
+	"fmt"
 	"user/internal/application/core/domain"
-	"user/utils"
 
 	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
 )
 
 const (
-	StreamName                = "USER"
-	UserSubject               = "user.*"
-	UserRegisteredSubjectName = "user.registered"
-	UserUpdatedSubjectName    = "user.updated"
+	StreamName  = "USER"
+	UserSubject = "user.*"
 )
 
 type UserEventPublisherAdapter struct {
@@ -33,9 +31,9 @@ func NewEventPublisherAdapter(nats *nats.Conn) (*UserEventPublisherAdapter, erro
 	return userEventPublisherAdapter, nil
 }
 
-func (u *UserEventPublisherAdapter) PublishUserRegistered(ctx context.Context, user *domain.User) error {
+func (u *UserEventPublisherAdapter) PublishOutboxMessage(ctx context.Context, message *domain.OutboxMessage) error {
 	_, err := u.jetStream.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
-		Name: StreamName,
+		Name:     StreamName,
 		Subjects: []string{UserSubject},
 		Storage:  jetstream.FileStorage,
 	})
@@ -44,32 +42,11 @@ func (u *UserEventPublisherAdapter) PublishUserRegistered(ctx context.Context, u
 		return err
 	}
 
-	data, err := utils.MarshalUser(user)
-	if err != nil {
-		return err
-	}
-
-	_, err = u.jetStream.Publish(ctx, UserRegisteredSubjectName, data)
-
-	return err
-}
-
-func (u *UserEventPublisherAdapter) PublishUserUpdated(ctx context.Context,user *domain.User) error {
-	_, err := u.jetStream.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
-		Name: StreamName,
-		Subjects: []string{UserSubject},
-		Storage:  jetstream.FileStorage,
-	})
+	// The message ID lets JetStream drop duplicates when the relay retries
+	// a message whose publish ack got lost.
+	msgID := fmt.Sprintf("user-outbox-%d", message.ID)
 
-	if err != nil {
-		return err
-	}
-
-	data, err := utils.MarshalUser(user)
-	if err != nil {
-		return err
-	}	
-	_, err = u.jetStream.Publish(ctx, UserUpdatedSubjectName, data)
+	_, err = u.jetStream.Publish(ctx, message.Subject, message.Payload, jetstream.WithMsgID(msgID))
 
 	return err
 }
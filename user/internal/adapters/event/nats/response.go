@@ -13,6 +13,7 @@ import (
 const (
 	SubjectRequestListUsers      = "request.list.users"
 	SubjectRequestGetUserByID    = "request.get.user.byID"
+	SubjectRequestGetUserByEmail = "request.get.user.byEmail"
 	SubjectRequestCreateUser     = "request.create.user"
 	SubjectRequestUpdateUser     = "request.update.user"
 	SubjectRequestDeleteUser     = "request.delete.user"
@@ -62,6 +63,39 @@ func (u *UserEventResponderAdapter) ReplyToListUsers(ctx context.Context) error
 	return nil
 }
 
+func (u *UserEventResponderAdapter) ReplyToGetUserByEmail(ctx context.Context) error {
+	subscription, err := u.natsConn.Subscribe(SubjectRequestGetUserByEmail, func(msg *nats.Msg) {
+		email := string(msg.Data)
+
+		user, err := u.API.GetUserByEmail(ctx, email)
+		if err != nil {
+			errString := fmt.Errorf("error get user by email  : %s,%w", email, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		serializedUserData, err := utils.MarshalUser(user)
+		if err != nil {
+			errString := fmt.Errorf("error serialize user: %v,%w", user, err).Error()
+			msg.Respond([]byte(errString))
+			return
+		}
+
+		msg.Respond(serializedUserData)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+	}()
+
+	return nil
+}
+
 func (u *UserEventResponderAdapter) ReplyToGetUserByID(ctx context.Context) error {
 	subscription, err := u.natsConn.Subscribe(SubjectRequestGetUserByID, func(msg *nats.Msg) {
 		userIDstr := string(msg.Data)
@@ -105,21 +139,19 @@ func (u *UserEventResponderAdapter) ReplyToGetUserByID(ctx context.Context) erro
 
 func (u *UserEventResponderAdapter) ReplayToCreateUser(ctx context.Context) error {
 	subscription, err := u.natsConn.Subscribe(SubjectRequestCreateUser, func(msg *nats.Msg) {
-		firstName, lastName, email, err := utils.UnmarshalCreateUserRequest(msg.Data)
-		if err != nil {
-			errString := fmt.Errorf("error unmarshal data  : %s,%w", string(msg.Data), err).Error()
-			msg.Respond([]byte(errString))
-			return
-		}
-
-		_, err = u.API.Register(ctx, firstName, lastName, email)
-		if err != nil {
-			errString := fmt.Errorf("error create user: %w", err).Error()
-			msg.Respond([]byte(errString))
-			return
-		}
-
-		msg.Respond([]byte("Ok"))
+		u.respondIdempotent(ctx, msg, func() ([]byte, error) {
+			firstName, lastName, email, err := utils.UnmarshalCreateUserRequest(msg.Data)
+			if err != nil {
+				return nil, fmt.Errorf("error unmarshal data  : %s,%w", string(msg.Data), err)
+			}
+
+			_, err = u.API.Register(ctx, firstName, lastName, email)
+			if err != nil {
+				return nil, fmt.Errorf("error create user: %w", err)
+			}
+
+			return []byte("Ok"), nil
+		})
 	})
 
 	if err != nil {
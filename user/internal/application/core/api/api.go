@@ -28,12 +28,7 @@ func (api *API) 	Register(ctx context.Context, firstName, lastName, email string
 		Email:     email,
 	}
 
-	if err := api.DatabasePort.SaveUser(ctx, user); err != nil {
-		return nil, err
-	}
-
-	err := api.UserEventPublisher.PublishUserRegistered(ctx, user)
-	if err != nil {
+	if err := api.DatabasePort.SaveUser(ctx, user, newUserEvent(domain.UserRegisteredEvent)); err != nil {
 		return nil, err
 	}
 
@@ -44,6 +39,10 @@ func (api *API) GetUserByID(ctx context.Context, id uint) (*domain.User, error)
 	return api.DatabasePort.GetUserByID(ctx, id)
 }
 
+func (api *API) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
+	return api.DatabasePort.GetUserByEmail(ctx, email)
+}
+
 func (api *API) ListUsers(ctx context.Context) ([]domain.User, error) {
 	users, err := api.DatabasePort.ListUsers(ctx)
 	if err != nil {
@@ -53,17 +52,9 @@ func (api *API) ListUsers(ctx context.Context) ([]domain.User, error) {
 }
 
 func (api *API) UpdateUser(ctx context.Context, id uint, firstName, lastName string) error {
-	user,err := api.DatabasePort.UpdateUser(ctx, id, firstName, lastName)
-	if err != nil {
-		return err
-	}
-
-	err = api.UserEventPublisher.PublishUserUpdated(ctx,user)
-	if err != nil {
-		return err
-	}
+	_, err := api.DatabasePort.UpdateUser(ctx, id, firstName, lastName, newUserEvent(domain.UserUpdatedEvent))
 
-	return nil
+	return err
 }
 
 func (api *API) DeleteUser(ctx context.Context, id uint) error {
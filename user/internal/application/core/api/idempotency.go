@@ -0,0 +1,67 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+	"user/internal/application/core/domain"
+)
+
+// BeginIdempotentRequest claims the key for the request. When the key was
+// already used for the same request the stored record is returned, its
+// status tells whether the original reply can be replayed. A pending
+// record whose lease ran out is taken over and handled again.
+func (api *API) BeginIdempotentRequest(ctx context.Context, scope, key string, request []byte) (*domain.IdempotencyRecord, error) {
+	record := domain.NewIdempotencyRecord(scope, key, request)
+
+	err := api.DatabasePort.CreateIdempotencyRecord(ctx, record)
+	if err == nil {
+		return record, nil
+	} else if !errors.Is(err, domain.ErrIdempotencyKeyExists) {
+		return nil, err
+	}
+
+	existing, err := api.DatabasePort.GetIdempotencyRecord(ctx, scope, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !existing.Matches(request) {
+		return nil, domain.ErrIdempotencyKeyReused
+	}
+
+	now := time.Now()
+
+	if existing.LeaseExpired(now) {
+		err = api.DatabasePort.TakeOverIdempotencyRecord(ctx, existing, now)
+		if err != nil {
+			return nil, err
+		}
+
+		return existing, nil
+	}
+
+	if existing.Status != domain.IdempotencyCompleted {
+		return nil, domain.ErrIdempotencyKeyInProgress
+	}
+
+	return existing, nil
+}
+
+func (api *API) CompleteIdempotentRequest(ctx context.Context, record *domain.IdempotencyRecord, response []byte) error {
+	err := api.DatabasePort.CompleteIdempotencyRecord(ctx, record, response)
+	if err != nil {
+		return err
+	}
+
+	record.Status = domain.IdempotencyCompleted
+	record.Response = response
+
+	return nil
+}
+
+// AbortIdempotentRequest frees the key of a failed request so that it can
+// be retried.
+func (api *API) AbortIdempotentRequest(ctx context.Context, record *domain.IdempotencyRecord) error {
+	return api.DatabasePort.DeleteIdempotencyRecord(ctx, record)
+}
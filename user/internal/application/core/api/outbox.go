@@ -0,0 +1,83 @@
+package api
+
+import (
+	"context"
+	"log"
+	"time"
+	"user/internal/application/core/domain"
+	"user/utils"
+)
+
+const (
+	outboxRelayInterval  = time.Second
+	outboxRelayBatchSize = 100
+	outboxPublishTimeout = 5 * time.Second
+)
+
+func (api *API) RelayOutbox(ctx context.Context) {
+	ticker := time.NewTicker(outboxRelayInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := api.relayOutboxMessages(ctx)
+			if err != nil {
+				log.Printf("outbox relay error: %v", err)
+			}
+		}
+	}
+}
+
+// relayOutboxMessages claims and publishes due messages batch by batch
+// until none are left. A message that fails holds back only the later
+// messages of its own user, is retried with a growing backoff and is
+// dead-lettered after domain.MaxOutboxAttempts.
+func (api *API) relayOutboxMessages(ctx context.Context) error {
+	for ctx.Err() == nil {
+		messages, err := api.DatabasePort.ClaimOutboxMessages(ctx, outboxRelayBatchSize, time.Now())
+		if err != nil || len(messages) == 0 {
+			return err
+		}
+
+		for i := range messages {
+			err := api.publishOutboxMessage(ctx, &messages[i])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// publishOutboxMessage publishes a claimed message and saves the outcome.
+// Publishing gives up after outboxPublishTimeout, well within the claim
+// lease, so a stuck publish is not retried by another relay meanwhile.
+func (api *API) publishOutboxMessage(ctx context.Context, message *domain.OutboxMessage) error {
+	publishCtx, cancel := context.WithTimeout(ctx, outboxPublishTimeout)
+	err := api.UserEventPublisher.PublishOutboxMessage(publishCtx, message)
+	cancel()
+
+	if err != nil {
+		log.Printf("publish outbox message %d attempt %d: %v", message.ID, message.Attempts+1, err)
+		message.Fail(err.Error(), time.Now())
+	} else {
+		message.Sent(time.Now())
+	}
+
+	return api.DatabasePort.SaveOutboxMessage(ctx, message)
+}
+
+func newUserEvent(subject string) domain.OutboxMessageFactory {
+	return func(user *domain.User) (*domain.OutboxMessage, error) {
+		data, err := utils.MarshalUser(user)
+		if err != nil {
+			return nil, err
+		}
+
+		return domain.NewOutboxMessage(domain.UserAggregateKey(user.ID), subject, data), nil
+	}
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+type IdempotencyStatus string
+
+const (
+	IdempotencyPending   IdempotencyStatus = "pending"
+	IdempotencyCompleted IdempotencyStatus = "completed"
+)
+
+var (
+	ErrIdempotencyKeyExists     = errors.New("idempotency key already exists")
+	ErrIdempotencyKeyInProgress = errors.New("idempotency conflict: a request with this key is still in progress")
+	ErrIdempotencyKeyReused     = errors.New("idempotency conflict: key was already used for a different request")
+	ErrIdempotencyLeaseLost     = errors.New("idempotency conflict: the key was taken over by a retry")
+)
+
+// IdempotencyLease is how long a pending request holds its key. A retry
+// after that takes over the key of a request that crashed before it
+// finished.
+const IdempotencyLease = time.Minute
+
+// IdempotencyRecord stores the reply to a request made with an idempotency
+// key, so that a retried request gets the original reply.
+type IdempotencyRecord struct {
+	ID          uint   `gorm:"primaryKey"`
+	Scope       string `gorm:"uniqueIndex:idx_idempotency_scope_key"`
+	Key         string `gorm:"uniqueIndex:idx_idempotency_scope_key"`
+	RequestHash string
+	Status      IdempotencyStatus
+	Token       string `gorm:"size:32"` // Renewed on every takeover, only the attempt holding it may finish the record
+	Response    []byte
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func NewIdempotencyRecord(scope, key string, request []byte) *IdempotencyRecord {
+	return &IdempotencyRecord{
+		Scope:       scope,
+		Key:         key,
+		RequestHash: hashRequest(request),
+		Status:      IdempotencyPending,
+		Token:       NewIdempotencyToken(),
+	}
+}
+
+// NewIdempotencyToken returns a random token identifying the attempt that
+// holds an idempotency key.
+func NewIdempotencyToken() string {
+	token := make([]byte, 16)
+	rand.Read(token)
+
+	return hex.EncodeToString(token)
+}
+
+func (r *IdempotencyRecord) Matches(request []byte) bool {
+	return r.RequestHash == hashRequest(request)
+}
+
+// LeaseExpired tells whether the record is pending past its lease, left
+// behind by a request that never finished. UpdatedAt is renewed on every
+// takeover.
+func (r *IdempotencyRecord) LeaseExpired(now time.Time) bool {
+	return r.Status == IdempotencyPending && now.Sub(r.UpdatedAt) > IdempotencyLease
+}
+
+func hashRequest(request []byte) string {
+	sum := sha256.Sum256(request)
+
+	return hex.EncodeToString(sum[:])
+}
@@ -8,7 +8,12 @@ import (
 type APIPort interface {
 	Register(ctx context.Context, firstName, lastName, email string) (*domain.User, error)
 	GetUserByID(ctx context.Context, id uint) (*domain.User, error)
+	GetUserByEmail(ctx context.Context, email string) (*domain.User, error)
 	ListUsers(ctx context.Context) ([]domain.User, error)
 	UpdateUser(ctx context.Context, id uint, firstName, lastName string) error
 	DeleteUser(ctx context.Context, id uint) error
+	BeginIdempotentRequest(ctx context.Context, scope, key string, request []byte) (*domain.IdempotencyRecord, error)
+	CompleteIdempotentRequest(ctx context.Context, record *domain.IdempotencyRecord, response []byte) error
+	AbortIdempotentRequest(ctx context.Context, record *domain.IdempotencyRecord) error
+	RelayOutbox(ctx context.Context)
 }
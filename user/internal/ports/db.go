@@ -2,13 +2,24 @@ package ports
 
 import (
 	"context"
+	"time"
 	"user/internal/application/core/domain"
 )
 
 type DatabasePort interface {
-	SaveUser(ctx context.Context, user *domain.User) error
+	SaveUser(ctx context.Context, user *domain.User, newEvent domain.OutboxMessageFactory) error
 	GetUserByID(ctx context.Context, ID uint) (*domain.User, error)
+	GetUserByEmail(ctx context.Context, email string) (*domain.User, error)
 	ListUsers(ctx context.Context) ([]domain.User, error)
-	UpdateUser(ctx context.Context, ID uint, firstName, lastName string) (*domain.User,error)
+	UpdateUser(ctx context.Context, ID uint, firstName, lastName string, newEvent domain.OutboxMessageFactory) (*domain.User,error)
 	DeleteUser(ctx context.Context, ID uint) error
+
+	CreateIdempotencyRecord(ctx context.Context, record *domain.IdempotencyRecord) error
+	GetIdempotencyRecord(ctx context.Context, scope, key string) (*domain.IdempotencyRecord, error)
+	TakeOverIdempotencyRecord(ctx context.Context, record *domain.IdempotencyRecord, now time.Time) error
+	CompleteIdempotencyRecord(ctx context.Context, record *domain.IdempotencyRecord, response []byte) error
+	DeleteIdempotencyRecord(ctx context.Context, record *domain.IdempotencyRecord) error
+
+	ClaimOutboxMessages(ctx context.Context, limit int, now time.Time) ([]domain.OutboxMessage, error)
+	SaveOutboxMessage(ctx context.Context, message *domain.OutboxMessage) error
 }
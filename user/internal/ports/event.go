@@ -6,7 +6,6 @@ import (
 )
 
 type UserEventPublisher interface {
-	PublishUserRegistered(ctx context.Context, data *domain.User) error
-	PublishUserUpdated(ctx context.Context, data *domain.User) error
+	PublishOutboxMessage(ctx context.Context, message *domain.OutboxMessage) error
 }
 
@@ -54,6 +54,8 @@ func main() {
 		userEventPublisher,
 	)
 
+	go api.RelayOutbox(ctx)
+
 	userEventResponder := nats.NewUserEventResponderAdapter(natsConn, api)
 
 	go func() {
@@ -72,6 +74,14 @@ func main() {
 		}
 	}()
 
+	go func() {
+		err := userEventResponder.ReplyToGetUserByEmail(ctx)
+
+		if err != nil {
+			log.Printf("Error replying to get user by email: %v", err)
+		}
+	}()
+
 	go func() {
 		err := userEventResponder.ReplayToCreateUser(ctx)
 